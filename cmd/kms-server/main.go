@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main implements the reference key management server for the disk encryption `kms` keys.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/talos-systems/talos/internal/pkg/kms"
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+var options struct {
+	listen        string
+	masterKeyPath string
+	certPath      string
+	keyPath       string
+	clientCAPath  string
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "kms-server",
+	Short: "Reference key management server for Talos disk encryption",
+	Long: `Reference key management server for Talos disk encryption.
+
+The server seals and unseals disk encryption keys with the master key (AES-256-GCM),
+binding them to the node UUID. Clients are authenticated with mutual TLS,
+the client certificate should be issued for the node UUID (common name or DNS SAN).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		return run(ctx)
+	},
+}
+
+func run(ctx context.Context) error {
+	masterKey, err := os.ReadFile(options.masterKeyPath)
+	if err != nil {
+		return fmt.Errorf("error reading master key: %w", err)
+	}
+
+	srv, err := kms.NewServer(masterKey)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(options.certPath, options.keyPath)
+	if err != nil {
+		return fmt.Errorf("error loading server certificate: %w", err)
+	}
+
+	clientCA, err := os.ReadFile(options.clientCAPath)
+	if err != nil {
+		return fmt.Errorf("error reading client CA: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+
+	if !clientCAs.AppendCertsFromPEM(clientCA) {
+		return fmt.Errorf("no valid certificates found in client CA")
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	})))
+
+	kmsapi.RegisterKMSServiceServer(grpcServer, srv)
+
+	lis, err := net.Listen("tcp", options.listen)
+	if err != nil {
+		return fmt.Errorf("error listening: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("serving KMS API on %s", lis.Addr())
+
+	return grpcServer.Serve(lis)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&options.listen, "listen", ":4050", "The address to listen on")
+	rootCmd.Flags().StringVar(&options.masterKeyPath, "master-key", "", "The path to the master key file (32 bytes)")
+	rootCmd.Flags().StringVar(&options.certPath, "tls-cert", "", "The path to the server certificate in PEM format")
+	rootCmd.Flags().StringVar(&options.keyPath, "tls-key", "", "The path to the server key in PEM format")
+	rootCmd.Flags().StringVar(&options.clientCAPath, "client-ca", "", "The path to the CA certificate to verify client certificates")
+
+	for _, flag := range []string{"master-key", "tls-cert", "tls-key", "client-ca"} {
+		cobra.CheckErr(rootCmd.MarkFlagRequired(flag))
+	}
+}
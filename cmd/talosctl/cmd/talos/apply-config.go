@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
@@ -30,6 +31,7 @@ var applyConfigCmdFlags struct {
 	insecure         bool
 	dryRun           bool
 	configTryTimeout time.Duration
+	confirm          bool
 }
 
 // applyConfigCmd represents the applyConfiguration command.
@@ -92,6 +94,16 @@ var applyConfigCmd = &cobra.Command{
 			return fmt.Errorf("no filename supplied for configuration")
 		}
 
+		if applyConfigCmdFlags.confirm {
+			if applyConfigCmdFlags.Mode.Mode != machineapi.ApplyConfigurationRequest_TRY {
+				return fmt.Errorf("--confirm can only be used with --mode=try")
+			}
+
+			if applyConfigCmdFlags.insecure {
+				return fmt.Errorf("--confirm can't be used with --insecure")
+			}
+		}
+
 		withClient := func(f func(context.Context, *client.Client) error) error {
 			if applyConfigCmdFlags.insecure {
 				return WithClientMaintenance(applyConfigCmdFlags.certFingerprints, f)
@@ -100,7 +112,7 @@ var applyConfigCmd = &cobra.Command{
 			return WithClient(f)
 		}
 
-		return withClient(func(ctx context.Context, c *client.Client) error {
+		err := withClient(func(ctx context.Context, c *client.Client) error {
 			if applyConfigCmdFlags.Interactive {
 				install := installer.NewInstaller()
 				node := GlobalArgs.Nodes[0]
@@ -144,6 +156,7 @@ var applyConfigCmd = &cobra.Command{
 				Immediate:      applyConfigCmdFlags.Immediate,
 				DryRun:         applyConfigCmdFlags.dryRun,
 				TryModeTimeout: durationpb.New(applyConfigCmdFlags.configTryTimeout),
+				TryModeConfirm: applyConfigCmdFlags.confirm,
 			})
 			if err != nil {
 				return fmt.Errorf("error applying new configuration: %s", err)
@@ -153,9 +166,75 @@ var applyConfigCmd = &cobra.Command{
 
 			return nil
 		})
+		if err != nil {
+			return err
+		}
+
+		if applyConfigCmdFlags.confirm && !applyConfigCmdFlags.dryRun {
+			return confirmConfig(applyConfigCmdFlags.configTryTimeout)
+		}
+
+		return nil
 	},
 }
 
+// confirmConfig confirms the configuration applied in 'try' mode.
+//
+// Each attempt uses a new connection to the API endpoints, which might proxy the request
+// to the nodes, so the confirmation only proves that the nodes are reachable via the endpoints.
+// The nodes refuse the confirmation with codes.Unavailable until the grace period has passed
+// and the network configuration has settled, so the attempts are retried until the timeout.
+// If the confirmation doesn't get through before the timeout, the nodes roll the configuration back.
+func confirmConfig(timeout time.Duration) error {
+	const attemptTimeout = 10 * time.Second
+
+	deadline := time.Now().Add(timeout)
+	confirmed := map[string]struct{}{}
+
+	for {
+		var pending []string
+
+		err := WithClient(func(ctx context.Context, c *client.Client) error {
+			for _, node := range GlobalArgs.Nodes {
+				if _, ok := confirmed[node]; ok {
+					continue
+				}
+
+				attemptCtx, cancel := context.WithTimeout(client.WithNode(ctx, node), attemptTimeout)
+				_, err := c.ConfirmConfiguration(attemptCtx)
+
+				cancel()
+
+				switch {
+				case err == nil:
+					confirmed[node] = struct{}{}
+
+					fmt.Printf("%s: configuration confirmed\n", node)
+				case client.StatusCode(err) == codes.FailedPrecondition:
+					return fmt.Errorf("%s: configuration can't be confirmed: %w", node, err)
+				default:
+					pending = append(pending, node)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("failed to confirm the configuration on %s, the configuration is going to be rolled back", strings.Join(pending, ", "))
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
 func init() {
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
 	applyConfigCmd.Flags().BoolVarP(&applyConfigCmdFlags.insecure, "insecure", "i", false, "apply the config using the insecure (encrypted with no auth) maintenance service")
@@ -163,6 +242,7 @@ func init() {
 	applyConfigCmd.Flags().StringSliceVar(&applyConfigCmdFlags.certFingerprints, "cert-fingerprint", nil, "list of server certificate fingeprints to accept (defaults to no check)")
 	applyConfigCmd.Flags().StringSliceVarP(&applyConfigCmdFlags.patches, "config-patch", "p", nil, "the list of config patches to apply to the local config file before sending it to the node")
 	applyConfigCmd.Flags().DurationVar(&applyConfigCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
+	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.confirm, "confirm", false, "in try mode, persist the config and roll it back only if the nodes can't be reached to confirm it before the timeout")
 	helpers.AddModeFlags(&applyConfigCmdFlags.Mode, applyConfigCmd)
 	addCommand(applyConfigCmd)
 }
@@ -6,12 +6,15 @@ package talos
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup/crypt"
 	"github.com/talos-systems/talos/pkg/logging"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
@@ -20,6 +23,7 @@ import (
 var bootstrapCmdFlags struct {
 	recoverFrom          string
 	recoverSkipHashCheck bool
+	recoverDecryptionKey string
 }
 
 // bootstrapCmd represents the bootstrap command.
@@ -33,7 +37,8 @@ Other control plane nodes will join etcd cluster once Kubernetes is boostrapped
 
 This command should not be used when "init" type node are used.
 
-Talos etcd cluster can be recovered from a known snapshot with '--recover-from=' flag.`,
+Talos etcd cluster can be recovered from a known snapshot with '--recover-from=' flag.
+Encrypted scheduled snapshots are decrypted locally with the key passed via '--recover-decryption-key=' flag.`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -42,9 +47,16 @@ Talos etcd cluster can be recovered from a known snapshot with '--recover-from='
 			}
 
 			if bootstrapCmdFlags.recoverFrom != "" {
+				snapshotPath, cleanup, err := decryptSnapshot(bootstrapCmdFlags.recoverFrom, bootstrapCmdFlags.recoverDecryptionKey)
+				if err != nil {
+					return err
+				}
+
+				defer cleanup()
+
 				manager := snapshot.NewV3(logging.Wrap(os.Stderr))
 
-				status, err := manager.Status(bootstrapCmdFlags.recoverFrom)
+				status, err := manager.Status(snapshotPath)
 				if err != nil {
 					return err
 				}
@@ -52,7 +64,7 @@ Talos etcd cluster can be recovered from a known snapshot with '--recover-from='
 				fmt.Printf("recovering from snapshot %q: hash %08x, revision %d, total keys %d, total size %d\n",
 					bootstrapCmdFlags.recoverFrom, status.Hash, status.Revision, status.TotalKey, status.TotalSize)
 
-				snapshot, err := os.Open(bootstrapCmdFlags.recoverFrom)
+				snapshot, err := os.Open(snapshotPath)
 				if err != nil {
 					return fmt.Errorf("error opening snapshot file: %w", err)
 				}
@@ -77,8 +89,77 @@ Talos etcd cluster can be recovered from a known snapshot with '--recover-from='
 	},
 }
 
+// decryptSnapshot decrypts the snapshot encrypted by the scheduled etcd backup into a temporary file.
+//
+// Plain snapshots are returned as is.
+func decryptSnapshot(path, encodedKey string) (string, func(), error) {
+	noop := func() {}
+
+	in, err := os.Open(path)
+	if err != nil {
+		return "", noop, fmt.Errorf("error opening snapshot file: %w", err)
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	header := make([]byte, 64)
+
+	n, err := io.ReadFull(in, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", noop, fmt.Errorf("error reading snapshot file: %w", err)
+	}
+
+	if !crypt.IsEncrypted(header[:n]) {
+		return path, noop, nil
+	}
+
+	if encodedKey == "" {
+		return "", noop, fmt.Errorf("snapshot %q is encrypted, use --recover-decryption-key to decrypt it", path)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return "", noop, fmt.Errorf("error decoding decryption key: %w", err)
+	}
+
+	if _, err = in.Seek(0, io.SeekStart); err != nil {
+		return "", noop, err
+	}
+
+	decrypted, err := crypt.NewDecryptingReader(in, key)
+	if err != nil {
+		return "", noop, err
+	}
+
+	out, err := os.CreateTemp("", "talos-etcd-snapshot")
+	if err != nil {
+		return "", noop, err
+	}
+
+	cleanup := func() {
+		os.Remove(out.Name()) //nolint:errcheck
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	if _, err = io.Copy(out, decrypted); err != nil {
+		cleanup()
+
+		return "", noop, err
+	}
+
+	if err = out.Close(); err != nil {
+		cleanup()
+
+		return "", noop, err
+	}
+
+	return out.Name(), cleanup, nil
+}
+
 func init() {
 	bootstrapCmd.Flags().StringVar(&bootstrapCmdFlags.recoverFrom, "recover-from", "", "recover etcd cluster from the snapshot")
 	bootstrapCmd.Flags().BoolVar(&bootstrapCmdFlags.recoverSkipHashCheck, "recover-skip-hash-check", false, "skip integrity check when recovering etcd (use when recovering from data directory copy)")
+	bootstrapCmd.Flags().StringVar(&bootstrapCmdFlags.recoverDecryptionKey, "recover-decryption-key", "", "base64-encoded key to decrypt the snapshot taken by the scheduled etcd backup")
 	addCommand(bootstrapCmd)
 }
@@ -9,18 +9,22 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"text/tabwriter"
 
+	humanize "github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/slices"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
@@ -208,7 +212,284 @@ var etcdSnapshotCmd = &cobra.Command{
 	},
 }
 
+var etcdStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get the status of etcd cluster member",
+	Long:  `Returns the status of the etcd member running on each of the nodes.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdStatus(ctx, &machine.EtcdStatusRequest{})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error getting status: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+			fmt.Fprintln(w, "NODE\tMEMBER\tDB SIZE\tIN USE\tQUOTA\tLEADER\tRAFT INDEX\tRAFT TERM\tRAFT APPLIED INDEX\tLEARNER\tERRORS")
+
+			for _, message := range response.Messages {
+				node := ""
+
+				if message.Metadata != nil {
+					node = message.Metadata.Hostname
+				}
+
+				status := message.GetMemberStatus()
+				if status == nil {
+					continue
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%v\t%s\n",
+					node,
+					strconv.FormatUint(status.MemberId, 16),
+					humanize.Bytes(uint64(status.DbSize)),
+					formatEtcdSizeRatio(status.DbSizeInUse, status.DbSize),
+					formatEtcdSizeRatio(status.DbSize, status.DbSizeQuota),
+					strconv.FormatUint(status.Leader, 16),
+					status.RaftIndex,
+					status.RaftTerm,
+					status.RaftAppliedIndex,
+					status.IsLearner,
+					strings.Join(status.Errors, ", "),
+				)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// formatEtcdSizeRatio formats the size and its percentage of the total.
+func formatEtcdSizeRatio(size, total int64) string {
+	if total <= 0 {
+		return humanize.Bytes(uint64(size))
+	}
+
+	return fmt.Sprintf("%s (%.2f%%)", humanize.Bytes(uint64(size)), float64(size)/float64(total)*100)
+}
+
+var etcdDefragCmdFlags struct {
+	cluster bool
+}
+
+var etcdDefragCmd = &cobra.Command{
+	Use:   "defrag",
+	Short: "Defragment etcd database on the node",
+	Long: `Defragmentation reclaims the space freed by the compacted revisions, reducing the size of the database file.
+
+Defragmentation is a resource-heavy operation which blocks reads and writes on the member while it runs,
+so the nodes are defragmented one at a time.
+
+With '--cluster' flag, all members of the etcd cluster are defragmented one at a time, the leader last.
+The member list is fetched from the first node.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := GlobalArgs.Nodes
+
+			if etcdDefragCmdFlags.cluster {
+				var err error
+
+				nodes, err = etcdDefragOrder(client.WithNode(ctx, nodes[0]), c)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, node := range nodes {
+				if err := etcdDefragNode(client.WithNode(ctx, node), c, node); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+// etcdDefragOrder returns the addresses of all etcd cluster members, the leader last.
+func etcdDefragOrder(ctx context.Context, c *client.Client) ([]string, error) {
+	// query the cluster instead of the local member list, as the local query fails on learners
+	members, err := c.EtcdMemberList(ctx, &machine.EtcdMemberListRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting members: %w", err)
+	}
+
+	status, err := c.EtcdStatus(ctx, &machine.EtcdStatusRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting status: %w", err)
+	}
+
+	if len(members.GetMessages()) != 1 || len(status.GetMessages()) != 1 {
+		return nil, fmt.Errorf("unexpected number of responses")
+	}
+
+	leader := status.GetMessages()[0].GetMemberStatus().GetLeader()
+
+	var (
+		nodes      []string
+		leaderNode string
+	)
+
+	for _, member := range members.GetMessages()[0].GetMembers() {
+		if len(member.ClientUrls) == 0 {
+			return nil, fmt.Errorf("member %q doesn't have client URLs", member.Hostname)
+		}
+
+		u, err := url.Parse(member.ClientUrls[0])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client URL of member %q: %w", member.Hostname, err)
+		}
+
+		if member.Id == leader {
+			leaderNode = u.Hostname()
+
+			continue
+		}
+
+		nodes = append(nodes, u.Hostname())
+	}
+
+	if leaderNode != "" {
+		nodes = append(nodes, leaderNode)
+	}
+
+	return nodes, nil
+}
+
+func etcdDefragNode(ctx context.Context, c *client.Client, node string) error {
+	before, err := c.EtcdStatus(ctx, &machine.EtcdStatusRequest{})
+	if err != nil {
+		return fmt.Errorf("%s: error getting status: %w", node, err)
+	}
+
+	if len(before.GetMessages()) != 1 {
+		return fmt.Errorf("%s: unexpected number of status responses", node)
+	}
+
+	fmt.Printf("%s: defragmenting etcd member %s\n", node, strconv.FormatUint(before.GetMessages()[0].GetMemberStatus().GetMemberId(), 16))
+
+	if _, err = c.EtcdDefragment(ctx, &machine.EtcdDefragmentRequest{}); err != nil {
+		return fmt.Errorf("%s: error defragmenting: %w", node, err)
+	}
+
+	after, err := c.EtcdStatus(ctx, &machine.EtcdStatusRequest{})
+	if err != nil {
+		return fmt.Errorf("%s: error getting status: %w", node, err)
+	}
+
+	if len(after.GetMessages()) != 1 {
+		return fmt.Errorf("%s: unexpected number of status responses", node)
+	}
+
+	fmt.Printf("%s: db size %s -> %s\n", node,
+		humanize.Bytes(uint64(before.GetMessages()[0].GetMemberStatus().GetDbSize())),
+		humanize.Bytes(uint64(after.GetMessages()[0].GetMemberStatus().GetDbSize())),
+	)
+
+	return nil
+}
+
+// etcdAlarmCmd represents the etcd alarm command.
+var etcdAlarmCmd = &cobra.Command{
+	Use:   "alarm",
+	Short: "Manage etcd alarms",
+	Long:  ``,
+}
+
+var etcdAlarmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the etcd alarms",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdAlarmList(ctx, &machine.EtcdAlarmListRequest{})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error getting alarms: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return printEtcdAlarms(slices.Map(response.GetMessages(), func(m *machine.EtcdAlarm) etcdNodeAlarms {
+				return etcdNodeAlarms{metadata: m.GetMetadata(), alarms: m.GetMemberAlarms()}
+			}))
+		})
+	},
+}
+
+var etcdAlarmDisarmCmd = &cobra.Command{
+	Use:   "disarm",
+	Short: "Disarm the etcd alarms",
+	Long: `Disarms all alarms raised in the etcd cluster.
+
+When the database exceeds the space quota (NOSPACE alarm), free the space first
+(compact and defragment the database), otherwise the alarm is raised again.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.EtcdAlarmDisarm(ctx, &machine.EtcdAlarmDisarmRequest{})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error disarming alarms: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			return printEtcdAlarms(slices.Map(response.GetMessages(), func(m *machine.EtcdAlarmDisarm) etcdNodeAlarms {
+				return etcdNodeAlarms{metadata: m.GetMetadata(), alarms: m.GetMemberAlarms()}
+			}))
+		})
+	},
+}
+
+type etcdNodeAlarms struct {
+	metadata *common.Metadata
+	alarms   []*machine.EtcdMemberAlarm
+}
+
+func printEtcdAlarms(messages []etcdNodeAlarms) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tMEMBER\tALARM")
+
+	for _, message := range messages {
+		node := ""
+
+		if message.metadata != nil {
+			node = message.metadata.Hostname
+		}
+
+		for _, alarm := range message.alarms {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", node, strconv.FormatUint(alarm.MemberId, 16), alarm.Alarm)
+		}
+	}
+
+	return w.Flush()
+}
+
 func init() {
-	etcdCmd.AddCommand(etcdLeaveCmd, etcdForfeitLeadershipCmd, etcdMemberListCmd, etcdMemberRemoveCmd, etcdSnapshotCmd)
+	etcdDefragCmd.Flags().BoolVar(&etcdDefragCmdFlags.cluster, "cluster", false, "defragment all members of the etcd cluster one at a time, the leader last")
+
+	etcdAlarmCmd.AddCommand(etcdAlarmListCmd, etcdAlarmDisarmCmd)
+
+	etcdCmd.AddCommand(
+		etcdLeaveCmd,
+		etcdForfeitLeadershipCmd,
+		etcdMemberListCmd,
+		etcdMemberRemoveCmd,
+		etcdSnapshotCmd,
+		etcdStatusCmd,
+		etcdDefragCmd,
+		etcdAlarmCmd,
+	)
 	addCommand(etcdCmd)
 }
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var netstatCmdFlags struct {
+	listening bool
+	all       bool
+	programs  bool
+	pid       int
+	tcp       bool
+	udp       bool
+	udplite   bool
+	raw       bool
+	unix      bool
+	ipv4      bool
+	ipv6      bool
+	netns     []string
+	allNetns  bool
+}
+
+// netstatCmd represents the netstat command.
+var netstatCmd = &cobra.Command{
+	Use:     "netstat",
+	Aliases: []string{"ss"},
+	Short:   "Show network connections and sockets",
+	Long: `Show network connections and sockets.
+
+By default connected sockets of the host network namespace are listed, use --listening
+or --all to list listening sockets as well:
+
+  talosctl netstat --listening --tcp --programs
+
+Sockets of the pods can be listed with --netns (names as in /var/run/netns) or --all-netns.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Netstat(ctx, netstatRequest(), grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting netstat output: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			if err = netstatRender(&remotePeer, resp); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	},
+}
+
+func netstatRequest() *machine.NetstatRequest {
+	req := &machine.NetstatRequest{
+		Filter: machine.NetstatRequest_CONNECTED,
+		Feature: &machine.NetstatRequest_Feature{
+			Pid: netstatCmdFlags.programs,
+		},
+		L4Proto: &machine.NetstatRequest_L4Proto{},
+		Netns: &machine.NetstatRequest_NetNS{
+			Hostnetwork: len(netstatCmdFlags.netns) == 0,
+			Netns:       netstatCmdFlags.netns,
+			Allnetns:    netstatCmdFlags.allNetns,
+		},
+		Pid: int32(netstatCmdFlags.pid),
+	}
+
+	switch {
+	case netstatCmdFlags.all:
+		req.Filter = machine.NetstatRequest_ALL
+	case netstatCmdFlags.listening:
+		req.Filter = machine.NetstatRequest_LISTENING
+	}
+
+	// if neither IPv4 nor IPv6 is selected, show both
+	ipv4 := netstatCmdFlags.ipv4 || !netstatCmdFlags.ipv6
+	ipv6 := netstatCmdFlags.ipv6 || !netstatCmdFlags.ipv4
+
+	// if no protocol is selected, show all of them
+	all := !netstatCmdFlags.tcp && !netstatCmdFlags.udp && !netstatCmdFlags.udplite && !netstatCmdFlags.raw && !netstatCmdFlags.unix
+
+	req.L4Proto.Tcp = (all || netstatCmdFlags.tcp) && ipv4
+	req.L4Proto.Tcp6 = (all || netstatCmdFlags.tcp) && ipv6
+	req.L4Proto.Udp = (all || netstatCmdFlags.udp) && ipv4
+	req.L4Proto.Udp6 = (all || netstatCmdFlags.udp) && ipv6
+	req.L4Proto.Udplite = (all || netstatCmdFlags.udplite) && ipv4
+	req.L4Proto.Udplite6 = (all || netstatCmdFlags.udplite) && ipv6
+	req.L4Proto.Raw = (all || netstatCmdFlags.raw) && ipv4
+	req.L4Proto.Raw6 = (all || netstatCmdFlags.raw) && ipv6
+	// unix sockets are not shown when the IP version is explicitly selected
+	req.L4Proto.Unix = (all && !netstatCmdFlags.ipv4 && !netstatCmdFlags.ipv6) || netstatCmdFlags.unix
+
+	return req
+}
+
+func netstatRender(remotePeer *peer.Peer, resp *machine.NetstatResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	header := "NODE\tPROTO\tRECV-Q\tSEND-Q\tLOCAL ADDRESS\tFOREIGN ADDRESS\tSTATE"
+
+	if netstatCmdFlags.programs || netstatCmdFlags.pid != 0 {
+		header += "\tPID/PROGRAM NAME"
+	}
+
+	if netstatCmdFlags.allNetns || len(netstatCmdFlags.netns) > 0 {
+		header += "\tNETNS"
+	}
+
+	fmt.Fprintln(w, header)
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, record := range msg.Connectrecord {
+			localAddress := netstatAddress(record.Localip, record.Localport)
+			remoteAddress := netstatAddress(record.Remoteip, record.Remoteport)
+			state := record.State.String()
+
+			switch record.L4Proto {
+			case "unix":
+				localAddress, remoteAddress = record.Path, ""
+			case "tcp", "tcp6":
+			default:
+				// connectionless sockets which are not connected have no state
+				if record.State == machine.ConnectRecord_CLOSE {
+					state = ""
+				}
+			}
+
+			line := fmt.Sprintf("%s\t%s\t%d\t%d\t%s\t%s\t%s",
+				node,
+				record.L4Proto,
+				record.Rxqueue,
+				record.Txqueue,
+				localAddress,
+				remoteAddress,
+				state,
+			)
+
+			if netstatCmdFlags.programs || netstatCmdFlags.pid != 0 {
+				process := "-"
+
+				if record.Process != nil {
+					process = fmt.Sprintf("%d/%s", record.Process.Pid, record.Process.Name)
+				}
+
+				line += "\t" + process
+			}
+
+			if netstatCmdFlags.allNetns || len(netstatCmdFlags.netns) > 0 {
+				line += "\t" + record.Netns
+			}
+
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	return w.Flush()
+}
+
+func netstatAddress(ip string, port uint32) string {
+	if port == 0 {
+		return net.JoinHostPort(ip, "*")
+	}
+
+	return net.JoinHostPort(ip, strconv.FormatUint(uint64(port), 10))
+}
+
+func init() {
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.listening, "listening", "l", false, "display listening server sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.all, "all", "a", false, "display all sockets states (default: connected)")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.programs, "programs", "p", false, "show process using socket")
+	netstatCmd.Flags().IntVar(&netstatCmdFlags.pid, "pid", 0, "show only sockets owned by the process with the given PID")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.tcp, "tcp", "t", false, "display only TCP sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.udp, "udp", "u", false, "display only UDP sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.udplite, "udplite", "U", false, "display only UDPLite sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.raw, "raw", "w", false, "display only RAW sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.unix, "unix", "x", false, "display only unix sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv4, "ipv4", "4", false, "display only ipv4 sockets")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv6, "ipv6", "6", false, "display only ipv6 sockets")
+	netstatCmd.Flags().StringSliceVarP(&netstatCmdFlags.netns, "netns", "N", nil, "display sockets of the named network namespaces")
+	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.allNetns, "all-netns", "A", false, "display sockets of all network namespaces")
+
+	addCommand(netstatCmd)
+}
@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+var probeCmdFlags struct {
+	count    uint32
+	interval time.Duration
+	timeout  time.Duration
+
+	size uint32
+
+	tls                   bool
+	tlsServerName         string
+	tlsInsecureSkipVerify bool
+
+	recordType string
+	server     string
+
+	maxHops uint32
+}
+
+// probeCmd represents the probe command.
+var probeCmd = &cobra.Command{
+	Use:   "probe",
+	Short: "Run network connectivity probes from the node",
+	Long: `Run network connectivity probes from the node to find out where the connectivity fails.
+
+Probes are run on each of the target nodes, and results are streamed back as they arrive:
+
+  talosctl -n 172.20.0.2,172.20.0.3 probe tcp registry.k8s.io:443 --tls`,
+}
+
+var probePingCmd = &cobra.Command{
+	Use:   "ping <host>",
+	Short: "Send ICMP echo requests to the host",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ProbePing(ctx, &machine.ProbePingRequest{
+				Host:     args[0],
+				Count:    probeCmdFlags.count,
+				Interval: probeDuration(probeCmdFlags.interval),
+				Timeout:  probeDuration(probeCmdFlags.timeout),
+				Size:     probeCmdFlags.size,
+			})
+			if err != nil {
+				return fmt.Errorf("error starting ping: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(result *machine.ProbeResult, node string, multipleNodes bool) error {
+				if result.Error != "" {
+					fmt.Printf("%s: seq=%d from=%s error: %s\n", node, result.Seq, result.Address, result.Error)
+				} else {
+					fmt.Printf("%s: seq=%d from=%s time=%s\n", node, result.Seq, result.Address, formatProbeDuration(result.Rtt))
+				}
+
+				return nil
+			})
+		})
+	},
+}
+
+var probeTCPCmd = &cobra.Command{
+	Use:   "tcp <host:port>",
+	Short: "Connect to the TCP endpoint, optionally performing TLS handshake",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ProbeTCP(ctx, &machine.ProbeTCPRequest{
+				Address:               args[0],
+				Count:                 probeCmdFlags.count,
+				Interval:              probeDuration(probeCmdFlags.interval),
+				Timeout:               probeDuration(probeCmdFlags.timeout),
+				Tls:                   probeCmdFlags.tls,
+				TlsServerName:         probeCmdFlags.tlsServerName,
+				TlsInsecureSkipVerify: probeCmdFlags.tlsInsecureSkipVerify,
+			})
+			if err != nil {
+				return fmt.Errorf("error starting TCP probe: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(result *machine.ProbeResult, node string, multipleNodes bool) error {
+				line := fmt.Sprintf("%s: seq=%d addr=%s", node, result.Seq, result.Address)
+
+				if result.Rtt != nil {
+					line += fmt.Sprintf(" connect=%s", formatProbeDuration(result.Rtt))
+				}
+
+				if result.TlsHandshake != nil {
+					line += fmt.Sprintf(" tls=%s", formatProbeDuration(result.TlsHandshake))
+				}
+
+				if result.Error != "" {
+					line += " error: " + result.Error
+				}
+
+				fmt.Println(line)
+
+				return nil
+			})
+		})
+	},
+}
+
+var probeDNSCmd = &cobra.Command{
+	Use:   "dns <name>",
+	Short: "Resolve the name via each of the node's DNS servers",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ProbeDNS(ctx, &machine.ProbeDNSRequest{
+				Name:    args[0],
+				Type:    probeCmdFlags.recordType,
+				Server:  probeCmdFlags.server,
+				Timeout: probeDuration(probeCmdFlags.timeout),
+			})
+			if err != nil {
+				return fmt.Errorf("error starting DNS probe: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(result *machine.ProbeResult, node string, multipleNodes bool) error {
+				if result.Error != "" {
+					fmt.Printf("%s: server=%s error: %s\n", node, result.Address, result.Error)
+
+					return nil
+				}
+
+				fmt.Printf("%s: server=%s time=%s\n", node, result.Address, formatProbeDuration(result.Rtt))
+
+				for _, record := range result.Records {
+					fmt.Printf("%s:     %s\n", node, strings.ReplaceAll(record, "\t", " "))
+				}
+
+				return nil
+			})
+		})
+	},
+}
+
+var probeTracerouteCmd = &cobra.Command{
+	Use:     "traceroute <host>",
+	Aliases: []string{"tracert"},
+	Short:   "Trace the route to the host",
+	Long:    ``,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.ProbeTraceroute(ctx, &machine.ProbeTracerouteRequest{
+				Host:    args[0],
+				MaxHops: probeCmdFlags.maxHops,
+				Timeout: probeDuration(probeCmdFlags.timeout),
+			})
+			if err != nil {
+				return fmt.Errorf("error starting traceroute: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(result *machine.ProbeResult, node string, multipleNodes bool) error {
+				switch {
+				case result.Address == "":
+					fmt.Printf("%s: %2d  *  %s\n", node, result.Seq, result.Error)
+				case result.Error != "":
+					fmt.Printf("%s: %2d  %s  %s  %s\n", node, result.Seq, result.Address, formatProbeDuration(result.Rtt), result.Error)
+				default:
+					fmt.Printf("%s: %2d  %s  %s\n", node, result.Seq, result.Address, formatProbeDuration(result.Rtt))
+				}
+
+				return nil
+			})
+		})
+	},
+}
+
+func probeDuration(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+
+	return durationpb.New(d)
+}
+
+func formatProbeDuration(d *durationpb.Duration) string {
+	return d.AsDuration().Round(time.Microsecond).String()
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{probePingCmd, probeTCPCmd} {
+		cmd.Flags().Uint32VarP(&probeCmdFlags.count, "count", "c", 0, "number of probes to send (default: 4 for ping, 1 for tcp)")
+		cmd.Flags().DurationVar(&probeCmdFlags.interval, "interval", 0, "interval between probes (default: 1s)")
+	}
+
+	probePingCmd.Flags().DurationVar(&probeCmdFlags.timeout, "timeout", 0, "time to wait for each reply (default: 1s)")
+	probePingCmd.Flags().Uint32VarP(&probeCmdFlags.size, "size", "s", 0, "payload size in bytes (default: 56)")
+
+	probeTCPCmd.Flags().DurationVar(&probeCmdFlags.timeout, "timeout", 0, "timeout for each connection attempt (default: 5s)")
+	probeTCPCmd.Flags().BoolVar(&probeCmdFlags.tls, "tls", false, "perform TLS handshake after connecting")
+	probeTCPCmd.Flags().StringVar(&probeCmdFlags.tlsServerName, "tls-server-name", "", "TLS server name (default: host of the endpoint)")
+	probeTCPCmd.Flags().BoolVar(&probeCmdFlags.tlsInsecureSkipVerify, "insecure-skip-verify", false, "skip TLS certificate verification")
+
+	probeDNSCmd.Flags().DurationVar(&probeCmdFlags.timeout, "timeout", 0, "timeout for each query (default: 2s)")
+	probeDNSCmd.Flags().StringVarP(&probeCmdFlags.recordType, "type", "t", "A", "DNS record type (A, AAAA, CNAME, MX, NS, PTR, SRV, TXT)")
+	probeDNSCmd.Flags().StringVar(&probeCmdFlags.server, "server", "", "DNS server to query instead of the node's DNS servers")
+
+	probeTracerouteCmd.Flags().DurationVar(&probeCmdFlags.timeout, "timeout", 0, "time to wait for each hop to reply (default: 1s)")
+	probeTracerouteCmd.Flags().Uint32VarP(&probeCmdFlags.maxHops, "max-hops", "m", 0, "maximum number of hops (default: 30)")
+
+	probeCmd.AddCommand(probePingCmd, probeTCPCmd, probeDNSCmd, probeTracerouteCmd)
+	addCommand(probeCmd)
+}
@@ -7,14 +7,16 @@ package talos
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
-	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/cluster"
 	k8s "github.com/talos-systems/talos/pkg/cluster/kubernetes"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -22,22 +24,48 @@ import (
 var upgradeK8sCmd = &cobra.Command{
 	Use:   "upgrade-k8s",
 	Short: "Upgrade Kubernetes control plane in the Talos cluster.",
-	Long:  `Command runs upgrade of Kubernetes control plane components between specified versions.`,
-	Args:  cobra.NoArgs,
+	Long: `Command runs upgrade of Kubernetes control plane components between specified versions.
+
+The upgrade progress is recorded in the state file of the cluster, so that the interrupted upgrade is resumed
+when the command is run again with the same target version.
+The state file is named after the cluster ID (the UID of the kube-system namespace) in the state directory.
+The versions of the static pods and kubelet before the upgrade are recorded as well,
+and they can be restored with the --rollback flag.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// target version is taken from the upgrade state for the rollback
+		if !upgradeK8sCmdFlags.rollback && !cmd.Flags().Changed("to") {
+			return fmt.Errorf("required flag \"to\" not set (it can be omitted only with --rollback)")
+		}
+
 		return WithClient(upgradeKubernetes)
 	},
 }
 
-var upgradeOptions k8s.UpgradeOptions
+var (
+	upgradeOptions k8s.UpgradeOptions
+
+	upgradeK8sCmdFlags struct {
+		rollback bool
+	}
+)
 
 func init() {
+	var defaultStateDir string
+
+	if talosDir, err := clientconfig.GetTalosDirectory(); err == nil {
+		defaultStateDir = filepath.Join(talosDir, "upgrade-k8s")
+	}
+
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.FromVersion, "from", "", "the Kubernetes control plane version to upgrade from")
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.ToVersion, "to", constants.DefaultKubernetesVersion, "the Kubernetes control plane version to upgrade to")
 	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.ControlPlaneEndpoint, "endpoint", "", "the cluster control plane endpoint")
 	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.DryRun, "dry-run", false, "skip the actual upgrade and show the upgrade plan instead")
 	upgradeK8sCmd.Flags().BoolVar(&upgradeOptions.UpgradeKubelet, "upgrade-kubelet", true, "upgrade kubelet service")
-	cli.Should(upgradeK8sCmd.MarkFlagRequired("to"))
+	upgradeK8sCmd.Flags().StringSliceVar(&upgradeOptions.Components, "components", nil,
+		fmt.Sprintf("upgrade (or roll back) only the listed components (%s), all components by default", strings.Join(k8s.Components, ", ")))
+	upgradeK8sCmd.Flags().StringVar(&upgradeOptions.StateDir, "state-dir", defaultStateDir, "the directory to record the upgrade progress and the previous versions of each cluster to")
+	upgradeK8sCmd.Flags().BoolVar(&upgradeK8sCmdFlags.rollback, "rollback", false, "restore the versions of the static pods and kubelet recorded in the state file before the upgrade")
 	addCommand(upgradeK8sCmd)
 }
 
@@ -62,6 +90,10 @@ func upgradeKubernetes(ctx context.Context, c *client.Client) error {
 		},
 	}
 
+	if upgradeK8sCmdFlags.rollback {
+		return k8s.RollbackTalosManaged(ctx, &state, upgradeOptions)
+	}
+
 	var err error
 
 	if upgradeOptions.FromVersion == "" {
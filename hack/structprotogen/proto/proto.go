@@ -477,8 +477,16 @@ func formatBasicTypeName(typPkg string, typ string) (importPath, fullName string
 		return enumsProto, "talos.resource.definitions.enums.NethelpersRouteType"
 	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "VLANProtocol"}:
 		return enumsProto, "talos.resource.definitions.enums.NethelpersVLANProtocol"
+	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "Protocol"}:
+		return enumsProto, "talos.resource.definitions.enums.NethelpersProtocol"
+	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "DefaultAction"}:
+		return enumsProto, "talos.resource.definitions.enums.NethelpersDefaultAction"
+	case typeData{"github.com/talos-systems/talos/pkg/machinery/nethelpers", "MACVLANMode"}:
+		return enumsProto, "talos.resource.definitions.enums.NethelpersMACVLANMode"
 	case typeData{"github.com/talos-systems/talos/pkg/machinery/resources/runtime", "MachineStage"}:
 		return enumsProto, "talos.resource.definitions.enums.RuntimeMachineStage"
+	case typeData{"github.com/talos-systems/talos/pkg/machinery/resources/runtime", "ConfigTryState"}:
+		return enumsProto, "talos.resource.definitions.enums.RuntimeConfigTryState"
 	default:
 		return "", ""
 	}
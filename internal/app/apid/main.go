@@ -129,6 +129,10 @@ func apidMain() error {
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
 		"/machine.MachineService/PacketCapture",
+		"/machine.MachineService/ProbeDNS",
+		"/machine.MachineService/ProbePing",
+		"/machine.MachineService/ProbeTCP",
+		"/machine.MachineService/ProbeTraceroute",
 		"/machine.MachineService/Read",
 		"/resource.ResourceService/List",
 		"/resource.ResourceService/Watch",
@@ -66,6 +66,7 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/install"
 	"github.com/talos-systems/talos/internal/pkg/miniprocfs"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/probe"
 	"github.com/talos-systems/talos/pkg/archiver"
 	"github.com/talos-systems/talos/pkg/chunker"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
@@ -169,7 +170,7 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 	}
 
 	if in.Mode != machine.ApplyConfigurationRequest_TRY {
-		s.Controller.Runtime().CancelConfigRollbackTimeout()
+		s.Controller.Runtime().CancelConfigRollbackTimeout(ctx)
 	}
 
 	cfgProvider, err := s.Controller.Runtime().LoadAndValidateConfig(in.GetData())
@@ -207,12 +208,28 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 	}
 
 	if in.DryRun {
-		var config interface{}
+		var (
+			config           interface{}
+			currentDocuments []byte
+		)
+
 		if s.Controller.Runtime().Config() != nil {
 			config = s.Controller.Runtime().Config().Raw()
+
+			if currentDocuments, err = v1alpha1.EncodeDocuments(s.Controller.Runtime().Config().Documents()...); err != nil {
+				return nil, err
+			}
 		}
 
-		diff := cmp.Diff(config, cfgProvider.Raw(), cmp.AllowUnexported(v1alpha1.InstallDiskSizeMatcher{}))
+		newDocuments, err := v1alpha1.EncodeDocuments(cfgProvider.Documents()...)
+		if err != nil {
+			return nil, err
+		}
+
+		// additional documents are not part of the v1alpha1 config, so they are compared separately
+		diff := cmp.Diff(config, cfgProvider.Raw(), cmp.AllowUnexported(v1alpha1.InstallDiskSizeMatcher{})) +
+			cmp.Diff(string(currentDocuments), string(newDocuments))
+
 		if diff == "" {
 			diff = "No changes."
 		}
@@ -257,12 +274,26 @@ Config diff:
 			timeout = in.TryModeTimeout.AsDuration()
 		}
 
-		modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back in %s", timeout.String())
+		if in.TryModeConfirm && timeout <= constants.ConfigTryConfirmGracePeriod {
+			return nil, status.Errorf(codes.InvalidArgument, "try mode timeout should be longer than the confirmation grace period %s", constants.ConfigTryConfirmGracePeriod)
+		}
 
-		if err := s.Controller.Runtime().RollbackToConfigAfter(oldConfig, timeout); err != nil {
+		if in.TryModeConfirm {
+			modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back unless confirmed in %s", timeout.String())
+		} else {
+			modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back in %s", timeout.String())
+		}
+
+		if err := s.Controller.Runtime().RollbackToConfigAfter(ctx, oldConfig, timeout, in.TryModeConfirm); err != nil {
 			return nil, err
 		}
 
+		if in.TryModeConfirm {
+			if err := os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+				return nil, err
+			}
+		}
+
 		fallthrough
 	// --mode=no-reboot
 	case machine.ApplyConfigurationRequest_NO_REBOOT:
@@ -294,6 +325,26 @@ Config diff:
 	}, nil
 }
 
+// ConfirmConfiguration implements the machine.MachineServer interface.
+func (s *Server) ConfirmConfiguration(ctx context.Context, in *machine.ConfirmConfigurationRequest) (*machine.ConfirmConfigurationResponse, error) {
+	if err := s.Controller.Runtime().ConfirmConfig(ctx); err != nil {
+		switch {
+		case errors.Is(err, runtime.ErrNoConfigToConfirm):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, runtime.ErrConfigNotSettled):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return &machine.ConfirmConfigurationResponse{
+		Messages: []*machine.ConfirmConfiguration{
+			{},
+		},
+	}, nil
+}
+
 // GenerateConfiguration implements the machine.MachineServer interface.
 func (s *Server) GenerateConfiguration(ctx context.Context, in *machine.GenerateConfigurationRequest) (reply *machine.GenerateConfigurationResponse, err error) {
 	if s.Controller.Runtime().Config().Machine().Type() == machinetype.TypeWorker {
@@ -418,6 +469,10 @@ func (s *Server) Bootstrap(ctx context.Context, in *machine.BootstrapRequest) (r
 		return nil, status.Error(codes.FailedPrecondition, "bootstrap can only be performed on a control plane node")
 	}
 
+	if role := s.Controller.Runtime().Config().Cluster().Etcd().Role(); role != constants.EtcdRoleVoter {
+		return nil, status.Errorf(codes.FailedPrecondition, "bootstrap can't be performed on a control plane node with etcd role %q", role)
+	}
+
 	timeCtx, timeCtxCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer timeCtxCancel()
 
@@ -1698,9 +1753,17 @@ func (s *Server) EtcdMemberList(ctx context.Context, in *machine.EtcdMemberListR
 
 	var client *etcd.Client
 
-	if in.QueryLocal {
+	etcdConfig := s.Controller.Runtime().Config().Cluster().Etcd()
+
+	switch {
+	case in.QueryLocal && etcdConfig.Role() != constants.EtcdRoleVoter:
+		return nil, status.Errorf(codes.FailedPrecondition, "etcd member list can't be queried locally with etcd role %q", etcdConfig.Role())
+	case in.QueryLocal:
 		client, err = etcd.NewLocalClient()
-	} else {
+	case etcdConfig.Role() != constants.EtcdRoleVoter:
+		// learners don't serve member list requests
+		client, err = etcd.NewVoterClient(etcdConfig)
+	default:
 		client, err = etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
 	}
 
@@ -1834,7 +1897,8 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 		return err
 	}
 
-	client, err := etcd.NewLocalClient()
+	// learners don't serve snapshots, so the snapshot is taken from the voting members on learner nodes
+	client, err := etcd.NewVoterClient(s.Controller.Runtime().Config().Cluster().Etcd())
 	if err != nil {
 		return fmt.Errorf("failed to create etcd client: %w", err)
 	}
@@ -1865,6 +1929,142 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 	return nil
 }
 
+// EtcdStatus implements the machine.MachineServer interface.
+func (s *Server) EtcdStatus(ctx context.Context, in *machine.EtcdStatusRequest) (*machine.EtcdStatusResponse, error) {
+	if err := s.checkControlplane("etcd status"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	resp, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd status: %w", err)
+	}
+
+	return &machine.EtcdStatusResponse{
+		Messages: []*machine.EtcdStatus{
+			{
+				MemberStatus: &machine.EtcdMemberStatus{
+					MemberId:         resp.Header.GetMemberId(),
+					Version:          resp.Version,
+					DbSize:           resp.DbSize,
+					DbSizeInUse:      resp.DbSizeInUse,
+					DbSizeQuota:      etcd.QuotaBackendBytes(s.Controller.Runtime().Config().Cluster().Etcd().ExtraArgs()),
+					Leader:           resp.Leader,
+					RaftIndex:        resp.RaftIndex,
+					RaftTerm:         resp.RaftTerm,
+					RaftAppliedIndex: resp.RaftAppliedIndex,
+					Errors:           resp.Errors,
+					IsLearner:        resp.IsLearner,
+				},
+			},
+		},
+	}, nil
+}
+
+// EtcdDefragment implements the machine.MachineServer interface.
+func (s *Server) EtcdDefragment(ctx context.Context, in *machine.EtcdDefragmentRequest) (*machine.EtcdDefragmentResponse, error) {
+	if err := s.checkControlplane("etcd defragment"); err != nil {
+		return nil, err
+	}
+
+	if role := s.Controller.Runtime().Config().Cluster().Etcd().Role(); role != constants.EtcdRoleVoter {
+		return nil, status.Errorf(codes.FailedPrecondition, "etcd defragment is not supported with etcd role %q", role)
+	}
+
+	client, err := etcd.NewLocalClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if _, err = client.Defragment(ctx, client.Endpoints()[0]); err != nil {
+		return nil, fmt.Errorf("failed to defragment etcd: %w", err)
+	}
+
+	return &machine.EtcdDefragmentResponse{
+		Messages: []*machine.EtcdDefragment{
+			{},
+		},
+	}, nil
+}
+
+// EtcdAlarmList implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmList(ctx context.Context, in *machine.EtcdAlarmListRequest) (*machine.EtcdAlarmListResponse, error) {
+	if err := s.checkControlplane("etcd alarm list"); err != nil {
+		return nil, err
+	}
+
+	// alarms are cluster-wide, and learners don't serve alarm requests
+	client, err := etcd.NewVoterClient(s.Controller.Runtime().Config().Cluster().Etcd())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	resp, err := client.AlarmList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etcd alarms: %w", err)
+	}
+
+	return &machine.EtcdAlarmListResponse{
+		Messages: []*machine.EtcdAlarm{
+			{
+				MemberAlarms: mapEtcdAlarms(resp.Alarms),
+			},
+		},
+	}, nil
+}
+
+// EtcdAlarmDisarm implements the machine.MachineServer interface.
+func (s *Server) EtcdAlarmDisarm(ctx context.Context, in *machine.EtcdAlarmDisarmRequest) (*machine.EtcdAlarmDisarmResponse, error) {
+	if err := s.checkControlplane("etcd alarm disarm"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewVoterClient(s.Controller.Runtime().Config().Cluster().Etcd())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	// empty alarm member disarms all alarms
+	resp, err := client.AlarmDisarm(ctx, &clientv3.AlarmMember{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to disarm etcd alarms: %w", err)
+	}
+
+	return &machine.EtcdAlarmDisarmResponse{
+		Messages: []*machine.EtcdAlarmDisarm{
+			{
+				MemberAlarms: mapEtcdAlarms(resp.Alarms),
+			},
+		},
+	}, nil
+}
+
+func mapEtcdAlarms(alarms []*etcdserverpb.AlarmMember) []*machine.EtcdMemberAlarm {
+	return slices.Map(alarms, func(alarm *etcdserverpb.AlarmMember) *machine.EtcdMemberAlarm {
+		return &machine.EtcdMemberAlarm{
+			MemberId: alarm.GetMemberID(),
+			Alarm:    machine.EtcdMemberAlarm_AlarmType(alarm.GetAlarm()),
+		}
+	})
+}
+
 // EtcdRecover implements the machine.MachineServer interface.
 //
 //nolint:gocyclo
@@ -2126,6 +2326,247 @@ func capturePackets(pw *io.PipeWriter, handle *pcapgo.EthernetHandle, snapLen ui
 	}
 }
 
+// Netstat implements the machine.MachineServer interface.
+//
+//nolint:gocyclo,cyclop
+func (s *Server) Netstat(ctx context.Context, req *machine.NetstatRequest) (*machine.NetstatResponse, error) {
+	if req == nil {
+		req = new(machine.NetstatRequest)
+	}
+
+	netstat := miniprocfs.NewNetstat()
+
+	hostInode, err := netstat.NamespaceInode(os.Getpid())
+	if err != nil {
+		return nil, fmt.Errorf("error reading host network namespace: %w", err)
+	}
+
+	namedNamespaces, err := miniprocfs.NamedNetNamespaces(constants.NetNSPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listing named network namespaces: %w", err)
+	}
+
+	namespaces, err := netstat.NetNamespaces()
+	if err != nil {
+		return nil, fmt.Errorf("error listing network namespaces: %w", err)
+	}
+
+	netns := req.GetNetns()
+	includeHost := netns.GetAllnetns() || netns.GetHostnetwork() || len(netns.GetNetns()) == 0
+
+	requestedNames := map[string]bool{}
+
+	for _, name := range netns.GetNetns() {
+		requestedNames[name] = false
+	}
+
+	type netNamespace struct {
+		name string
+		pid  int
+	}
+
+	var selected []netNamespace
+
+	for _, ns := range namespaces {
+		var name string
+
+		if ns.Inode != hostInode {
+			var ok bool
+
+			name, ok = namedNamespaces[ns.Inode]
+			if !ok {
+				name = fmt.Sprintf("net:[%d]", ns.Inode)
+			}
+		}
+
+		_, requested := requestedNames[name]
+
+		switch {
+		case netns.GetAllnetns():
+		case name == "" && includeHost:
+		case name != "" && requested:
+			requestedNames[name] = true
+		default:
+			continue
+		}
+
+		selected = append(selected, netNamespace{name: name, pid: ns.PID})
+	}
+
+	for name, found := range requestedNames {
+		if !found && !netns.GetAllnetns() {
+			return nil, status.Errorf(codes.NotFound, "network namespace %q not found", name)
+		}
+	}
+
+	var owners map[uint64]*machine.ConnectRecord_Process
+
+	if req.GetFeature().GetPid() || req.Pid != 0 {
+		owners, err = netstat.SocketOwners()
+		if err != nil {
+			return nil, fmt.Errorf("error reading socket owners: %w", err)
+		}
+	}
+
+	var records []*machine.ConnectRecord
+
+	for _, ns := range selected {
+		for _, protocol := range netstatProtocols(req.GetL4Proto()) {
+			sockets, err := netstat.Sockets(ns.pid, protocol)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					// protocol is not supported by the kernel, or the process has exited
+					continue
+				}
+
+				return nil, err
+			}
+
+			for _, record := range sockets {
+				if !netstatFilter(req.Filter, record) {
+					continue
+				}
+
+				record.Netns = ns.name
+				record.Process = owners[record.Inode]
+
+				if req.Pid != 0 && (record.Process == nil || record.Process.Pid != uint32(req.Pid)) {
+					continue
+				}
+
+				records = append(records, record)
+			}
+		}
+	}
+
+	return &machine.NetstatResponse{
+		Messages: []*machine.Netstat{
+			{
+				Connectrecord: records,
+			},
+		},
+	}, nil
+}
+
+// netstatProtocols returns the list of socket tables to read, all tables are read if none is selected.
+func netstatProtocols(l4proto *machine.NetstatRequest_L4Proto) []string {
+	all := []struct {
+		enabled  bool
+		protocol string
+	}{
+		{l4proto.GetTcp(), miniprocfs.ProtocolTCP},
+		{l4proto.GetTcp6(), miniprocfs.ProtocolTCP6},
+		{l4proto.GetUdp(), miniprocfs.ProtocolUDP},
+		{l4proto.GetUdp6(), miniprocfs.ProtocolUDP6},
+		{l4proto.GetUdplite(), miniprocfs.ProtocolUDPLite},
+		{l4proto.GetUdplite6(), miniprocfs.ProtocolUDPLite6},
+		{l4proto.GetRaw(), miniprocfs.ProtocolRaw},
+		{l4proto.GetRaw6(), miniprocfs.ProtocolRaw6},
+		{l4proto.GetUnix(), miniprocfs.ProtocolUnix},
+	}
+
+	var protocols, allProtocols []string
+
+	for _, p := range all {
+		allProtocols = append(allProtocols, p.protocol)
+
+		if p.enabled {
+			protocols = append(protocols, p.protocol)
+		}
+	}
+
+	if len(protocols) == 0 {
+		return allProtocols
+	}
+
+	return protocols
+}
+
+// netstatFilter checks whether the socket matches the filter.
+//
+// Connectionless sockets which are not connected are considered to be listening.
+func netstatFilter(filter machine.NetstatRequest_Filter, record *machine.ConnectRecord) bool {
+	listening := record.State == machine.ConnectRecord_LISTEN
+
+	switch record.L4Proto {
+	case miniprocfs.ProtocolUDP, miniprocfs.ProtocolUDP6,
+		miniprocfs.ProtocolUDPLite, miniprocfs.ProtocolUDPLite6,
+		miniprocfs.ProtocolRaw, miniprocfs.ProtocolRaw6:
+		listening = record.State == machine.ConnectRecord_CLOSE
+	}
+
+	switch filter {
+	case machine.NetstatRequest_LISTENING:
+		return listening
+	case machine.NetstatRequest_CONNECTED:
+		return !listening
+	case machine.NetstatRequest_ALL:
+	}
+
+	return true
+}
+
+// ProbePing implements the machine.MachineServer interface.
+func (s *Server) ProbePing(in *machine.ProbePingRequest, srv machine.MachineService_ProbePingServer) error {
+	if in.Host == "" {
+		return status.Error(codes.InvalidArgument, "host is required")
+	}
+
+	return probe.Ping(srv.Context(), in, srv.Send)
+}
+
+// ProbeTCP implements the machine.MachineServer interface.
+func (s *Server) ProbeTCP(in *machine.ProbeTCPRequest, srv machine.MachineService_ProbeTCPServer) error {
+	if _, _, err := net.SplitHostPort(in.Address); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid address %q: %s", in.Address, err)
+	}
+
+	return probe.TCP(srv.Context(), in, srv.Send)
+}
+
+// ProbeDNS implements the machine.MachineServer interface.
+//
+// Unless the server is specified in the request, the name is resolved via each of the node's DNS servers.
+func (s *Server) ProbeDNS(in *machine.ProbeDNSRequest, srv machine.MachineService_ProbeDNSServer) error {
+	if in.Name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if _, err := probe.DNSQueryType(in.Type); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var servers []string
+
+	if in.Server != "" {
+		servers = []string{in.Server}
+	} else {
+		resolverStatus, err := safe.StateGetResource(srv.Context(), s.Controller.Runtime().State().V1Alpha2().Resources(), network.NewResolverStatus(network.NamespaceName, network.ResolverID))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return status.Error(codes.Unavailable, "DNS servers are not configured")
+			}
+
+			return err
+		}
+
+		for _, addr := range resolverStatus.TypedSpec().DNSServers {
+			servers = append(servers, addr.String())
+		}
+	}
+
+	return probe.DNS(srv.Context(), in, servers, srv.Send)
+}
+
+// ProbeTraceroute implements the machine.MachineServer interface.
+func (s *Server) ProbeTraceroute(in *machine.ProbeTracerouteRequest, srv machine.MachineService_ProbeTracerouteServer) error {
+	if in.Host == "" {
+		return status.Error(codes.InvalidArgument, "host is required")
+	}
+
+	return probe.Traceroute(srv.Context(), in, srv.Send)
+}
+
 func upgradeMutex(c *etcd.Client) (*concurrency.Mutex, error) {
 	sess, err := concurrency.NewSession(c.Client,
 		concurrency.WithTTL(MinimumEtcdUpgradeLeaseLockSeconds),
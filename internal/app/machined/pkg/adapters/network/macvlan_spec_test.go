@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestMACVLANSpec(t *testing.T) {
+	spec := network.MACVLANSpec{
+		Mode: nethelpers.MACVLANModeVEPA,
+	}
+
+	b, err := networkadapter.MACVLANSpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decodedSpec network.MACVLANSpec
+
+	require.NoError(t, networkadapter.MACVLANSpec(&decodedSpec).Decode(b))
+
+	require.Equal(t, spec, decodedSpec)
+}
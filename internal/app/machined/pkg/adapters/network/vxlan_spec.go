@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// VXLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive,golint
+func VXLANSpec(r *network.VXLANSpec) vxlanSpec {
+	return vxlanSpec{
+		VXLANSpec: r,
+	}
+}
+
+type vxlanSpec struct {
+	*network.VXLANSpec
+}
+
+// Encode the VXLANSpec into netlink attributes.
+//
+// If linkIndex is not zero, it is used as the underlying link of the VXLAN interface.
+func (a vxlanSpec) Encode(linkIndex uint32) ([]byte, error) {
+	vxlan := a.VXLANSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_VXLAN_ID, vxlan.VNI)
+
+	if linkIndex != 0 {
+		encoder.Uint32(unix.IFLA_VXLAN_LINK, linkIndex)
+	}
+
+	if vxlan.Local.IsValid() {
+		if vxlan.Local.Is4() {
+			encoder.Bytes(unix.IFLA_VXLAN_LOCAL, vxlan.Local.AsSlice())
+		} else {
+			encoder.Bytes(unix.IFLA_VXLAN_LOCAL6, vxlan.Local.AsSlice())
+		}
+	}
+
+	if vxlan.Remote.IsValid() {
+		if vxlan.Remote.Is4() {
+			encoder.Bytes(unix.IFLA_VXLAN_GROUP, vxlan.Remote.AsSlice())
+		} else {
+			encoder.Bytes(unix.IFLA_VXLAN_GROUP6, vxlan.Remote.AsSlice())
+		}
+	}
+
+	if vxlan.Port != 0 {
+		buf := make([]byte, 2)
+		binary.BigEndian.PutUint16(buf, vxlan.Port)
+		encoder.Bytes(unix.IFLA_VXLAN_PORT, buf)
+	}
+
+	encoder.Uint8(unix.IFLA_VXLAN_TTL, vxlan.TTL)
+
+	return encoder.Encode()
+}
+
+// Decode the VXLANSpec from netlink attributes.
+func (a vxlanSpec) Decode(data []byte) error {
+	vxlan := a.VXLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_VXLAN_ID:
+			vxlan.VNI = decoder.Uint32()
+		case unix.IFLA_VXLAN_LOCAL, unix.IFLA_VXLAN_LOCAL6:
+			vxlan.Local, _ = netip.AddrFromSlice(decoder.Bytes())
+		case unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6:
+			vxlan.Remote, _ = netip.AddrFromSlice(decoder.Bytes())
+		case unix.IFLA_VXLAN_PORT:
+			vxlan.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		case unix.IFLA_VXLAN_TTL:
+			vxlan.TTL = decoder.Uint8()
+		}
+	}
+
+	return decoder.Err()
+}
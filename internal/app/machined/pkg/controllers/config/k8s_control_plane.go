@@ -170,12 +170,18 @@ func (ctrl *K8sControlPlaneController) manageAPIServerConfig(ctx context.Context
 		advertisedAddress = ""
 	}
 
+	// learners don't serve linearizable requests, so the API server talks to the voting members on learner nodes
+	etcdServers := []string{fmt.Sprintf("https://%s", nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))}
+	if cfgProvider.Cluster().Etcd().Role() != constants.EtcdRoleVoter {
+		etcdServers = cfgProvider.Cluster().Etcd().ExternalEndpoints()
+	}
+
 	return r.Modify(ctx, k8s.NewAPIServerConfig(), func(r resource.Resource) error {
 		*r.(*k8s.APIServerConfig).TypedSpec() = k8s.APIServerConfigSpec{
 			Image:                    cfgProvider.Cluster().APIServer().Image(),
 			CloudProvider:            cloudProvider,
 			ControlPlaneEndpoint:     cfgProvider.Cluster().Endpoint().String(),
-			EtcdServers:              []string{fmt.Sprintf("https://%s", nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))},
+			EtcdServers:              etcdServers,
 			LocalPort:                cfgProvider.Cluster().LocalAPIServerPort(),
 			ServiceCIDRs:             cfgProvider.Cluster().Network().ServiceCIDRs(),
 			ExtraArgs:                cfgProvider.Cluster().APIServer().ExtraArgs(),
@@ -297,6 +297,58 @@ func (suite *K8sControlPlaneSuite) TestReconcileEnvironment() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileExternalEtcd() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				EtcdConfig: &v1alpha1.EtcdConfig{
+					EtcdRole:              "none",
+					EtcdExternalEndpoints: []string{"https://10.0.0.1:2379", "https://10.0.0.2:2379"},
+				},
+			},
+		},
+	)
+
+	apiServerCfg := suite.setupMachine(cfg)
+	suite.Assert().Equal([]string{"https://10.0.0.1:2379", "https://10.0.0.2:2379"}, apiServerCfg.EtcdServers)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileEtcdLearner() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				EtcdConfig: &v1alpha1.EtcdConfig{
+					EtcdRole:              "learner",
+					EtcdExternalEndpoints: []string{"https://10.0.0.1:2379"},
+				},
+			},
+		},
+	)
+
+	apiServerCfg := suite.setupMachine(cfg)
+	suite.Assert().Equal([]string{"https://10.0.0.1:2379"}, apiServerCfg.EtcdServers)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileExternalCloudProvider() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -0,0 +1,344 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	etcdclient "github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	// backupCheckInterval is the interval to check whether the next snapshot is due.
+	backupCheckInterval = time.Minute
+
+	// backupRetryInterval is the interval to retry a failed snapshot, unless the configured interval is shorter.
+	backupRetryInterval = 5 * time.Minute
+
+	// backupTimeout limits the time to take and store a single snapshot.
+	backupTimeout = 30 * time.Minute
+)
+
+// BackupController takes scheduled etcd snapshots.
+//
+// Snapshots are taken only on the voting members, as etcd doesn't serve snapshot requests on learners.
+type BackupController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *BackupController) Name() string {
+	return "etcd.BackupController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BackupController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        pointer.To(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("etcd"),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        pointer.To(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BackupController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.BackupStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *BackupController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(backupCheckInterval)
+	defer ticker.Stop()
+
+	// pick up the schedule from the snapshots taken before the restart
+	lastBackup := lastSnapshotTime(constants.EtcdBackupPath)
+
+	var retryAt time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		machineType, err := safe.ReaderGet[*config.MachineType](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		if !machineType.MachineType().IsControlPlane() {
+			if err = teardownAll(ctx, r, etcd.NamespaceName, etcd.BackupStatusType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		machineConfig, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		backupConfig := machineConfig.Config().Cluster().Etcd().Backup()
+
+		// learners don't serve snapshot requests, and etcd-less nodes have no member to snapshot
+		if !backupConfig.Enabled() || machineConfig.Config().Cluster().Etcd().Role() != constants.EtcdRoleVoter {
+			if err = teardownAll(ctx, r, etcd.NamespaceName, etcd.BackupStatusType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		etcdService, err := safe.ReaderGet[*v1alpha1.Service](ctx, r, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting etcd service: %w", err)
+		}
+
+		if !etcdService.TypedSpec().Healthy {
+			continue
+		}
+
+		// etcd member name is the hostname
+		hostnameStatus, err := safe.ReaderGet[*network.HostnameStatus](ctx, r, resource.NewMetadata(network.NamespaceName, network.HostnameStatusType, network.HostnameID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting hostname status: %w", err)
+		}
+
+		now := time.Now()
+
+		if now.Before(lastBackup.Add(backupConfig.Interval())) || now.Before(retryAt) {
+			continue
+		}
+
+		result, backupErr := ctrl.backup(ctx, logger, backupConfig, hostnameStatus.TypedSpec().Hostname, now)
+		if backupErr != nil {
+			logger.Error("etcd snapshot failed", zap.Error(backupErr))
+
+			retryInterval := backupRetryInterval
+			if backupConfig.Interval() < retryInterval {
+				retryInterval = backupConfig.Interval()
+			}
+
+			retryAt = now.Add(retryInterval)
+		} else {
+			logger.Info("etcd snapshot saved", zap.String("snapshot", result.Snapshot), zap.Uint64("size", result.Size), zap.Bool("uploaded", result.Uploaded))
+
+			lastBackup = now
+		}
+
+		if err = safe.WriterModify(ctx, r, etcd.NewBackupStatus(etcd.NamespaceName, etcd.BackupStatusID), func(status *etcd.BackupStatus) error {
+			spec := status.TypedSpec()
+
+			spec.LastAttempt = now
+
+			if backupErr != nil {
+				spec.LastError = backupErr.Error()
+
+				return nil
+			}
+
+			*spec = result
+			spec.LastAttempt = now
+			spec.LastSuccess = now
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating backup status: %w", err)
+		}
+	}
+}
+
+// backup takes the snapshot, stores it locally and uploads it to S3 if this member is the uploader.
+//
+// Upload failures are reported in the result, as the snapshot is still available locally.
+func (ctrl *BackupController) backup(ctx context.Context, logger *zap.Logger, cfg talosconfig.EtcdBackup, memberName string, now time.Time) (etcd.BackupStatusSpec, error) {
+	var result etcd.BackupStatusSpec
+
+	ctx, cancel := context.WithTimeout(ctx, backupTimeout)
+	defer cancel()
+
+	client, err := etcdclient.NewLocalClient()
+	if err != nil {
+		return result, fmt.Errorf("error creating etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	snapshot, err := client.Snapshot(ctx)
+	if err != nil {
+		return result, fmt.Errorf("error taking etcd snapshot: %w", err)
+	}
+
+	//nolint:errcheck
+	defer snapshot.Close()
+
+	key := cfg.EncryptionKey()
+	name := backup.SnapshotName(now, key != nil)
+
+	size, err := backup.Save(constants.EtcdBackupPath, name, snapshot, key)
+	if err != nil {
+		return result, fmt.Errorf("error saving etcd snapshot: %w", err)
+	}
+
+	result.Snapshot = name
+	result.Size = uint64(size)
+	result.Encrypted = key != nil
+
+	if err = backup.Prune(constants.EtcdBackupPath, cfg.RetentionCount(), cfg.RetentionMaxAge(), now); err != nil {
+		logger.Warn("error pruning local etcd snapshots", zap.Error(err))
+	}
+
+	if !cfg.S3().Enabled() {
+		return result, nil
+	}
+
+	shouldUpload, err := isUploader(ctx, client, cfg.Uploader(), memberName)
+	if err != nil {
+		result.UploadError = err.Error()
+
+		return result, nil
+	}
+
+	if !shouldUpload {
+		return result, nil
+	}
+
+	uploaded, err := upload(ctx, logger, cfg, name, now)
+	if err != nil {
+		result.UploadError = err.Error()
+
+		return result, nil
+	}
+
+	result.Uploaded = uploaded
+
+	return result, nil
+}
+
+// upload the snapshot to S3, unless a recent snapshot was already uploaded.
+//
+// Each member keeps its own schedule, so when the uploader changes (e.g. etcd leadership moves),
+// the new uploader might be due before the interval passes since the last upload.
+// The upload is skipped if the latest snapshot in the bucket is younger than half of the interval,
+// so the uploads are never more frequent than that, and the gap between the uploads never exceeds 1.5 intervals.
+func upload(ctx context.Context, logger *zap.Logger, cfg talosconfig.EtcdBackup, name string, now time.Time) (bool, error) {
+	store, err := backup.NewS3Store(cfg.S3())
+	if err != nil {
+		return false, fmt.Errorf("error initializing S3 client: %w", err)
+	}
+
+	uploaded, err := store.List(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error listing uploaded etcd snapshots: %w", err)
+	}
+
+	if latest := backup.Latest(uploaded); now.Sub(latest) < cfg.Interval()/2 {
+		logger.Info("skipped uploading etcd snapshot, recent snapshot is already uploaded", zap.Time("latest", latest))
+
+		return false, nil
+	}
+
+	f, err := os.Open(filepath.Join(constants.EtcdBackupPath, name))
+	if err != nil {
+		return false, err
+	}
+
+	//nolint:errcheck
+	defer f.Close()
+
+	if err = store.Upload(ctx, name, f); err != nil {
+		return false, fmt.Errorf("error uploading etcd snapshot: %w", err)
+	}
+
+	if err = store.Prune(ctx, cfg.RetentionCount(), cfg.RetentionMaxAge(), now); err != nil {
+		logger.Warn("error pruning uploaded etcd snapshots", zap.Error(err))
+	}
+
+	return true, nil
+}
+
+// isUploader checks whether this member should upload snapshots.
+//
+// If the uploader is not configured explicitly, the snapshots are uploaded by the etcd leader.
+func isUploader(ctx context.Context, client *etcdclient.Client, uploader, memberName string) (bool, error) {
+	if uploader != "" {
+		return memberName == uploader, nil
+	}
+
+	return client.IsLeader(ctx)
+}
+
+func lastSnapshotTime(dir string) time.Time {
+	names, err := backup.List(dir)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return backup.Latest(names)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
 )
@@ -92,6 +93,15 @@ func (ctrl *ConfigController) Run(ctx context.Context, r controller.Runtime, log
 			return fmt.Errorf("error getting machine config: %w", err)
 		}
 
+		if machineConfig.Config().Cluster().Etcd().Role() == constants.EtcdRoleNone {
+			// etcd doesn't run on this node
+			if err = ctrl.teardownAll(ctx, r); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		if err = safe.WriterModify(ctx, r, etcd.NewConfig(etcd.NamespaceName, etcd.ConfigID), func(cfg *etcd.Config) error {
 			cfg.TypedSpec().AdvertiseValidSubnets = machineConfig.Config().Cluster().Etcd().AdvertisedSubnets()
 			cfg.TypedSpec().AdvertiseExcludeSubnets = nil
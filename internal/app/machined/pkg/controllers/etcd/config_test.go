@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -17,6 +18,7 @@ import (
 	etcdctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
 )
@@ -177,3 +179,43 @@ func (suite *ConfigSuite) TestReconcile() {
 		})
 	}
 }
+
+func (suite *ConfigSuite) TestRoleNone() {
+	machineType := config.NewMachineType()
+	machineType.SetMachineType(machine.TypeControlPlane)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineType))
+
+	machineConfig := config.NewMachineConfig(&v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			EtcdConfig: &v1alpha1.EtcdConfig{
+				ContainerImage: "foo/bar:v1.0.0",
+			},
+		},
+		MachineConfig: &v1alpha1.MachineConfig{},
+	})
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := safe.StateGet[*etcd.Config](suite.Ctx(), suite.State(), etcd.NewConfig(etcd.NamespaceName, etcd.ConfigID).Metadata())
+		assert.NoError(err)
+	}))
+
+	// switch to the external etcd, etcd config should be removed
+	newMachineConfig := config.NewMachineConfig(&v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			EtcdConfig: &v1alpha1.EtcdConfig{
+				ContainerImage:        "foo/bar:v1.0.0",
+				EtcdRole:              constants.EtcdRoleNone,
+				EtcdExternalEndpoints: []string{"https://10.0.0.1:2379"},
+			},
+		},
+		MachineConfig: &v1alpha1.MachineConfig{},
+	})
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newMachineConfig))
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := safe.StateGet[*etcd.Config](suite.Ctx(), suite.State(), etcd.NewConfig(etcd.NamespaceName, etcd.ConfigID).Metadata())
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
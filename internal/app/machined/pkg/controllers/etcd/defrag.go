@@ -0,0 +1,327 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	etcdclient "github.com/talos-systems/talos/internal/pkg/etcd"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	// defragCheckInterval is the interval to check whether the next fragmentation check is due.
+	defragCheckInterval = time.Minute
+
+	// defragTimeout limits the time to wait for the lock and defragment the member.
+	defragTimeout = 30 * time.Minute
+)
+
+// DefragController defragments the local etcd member database when it gets fragmented.
+//
+// Members coordinate via etcd lock, so that only a single member is defragmented at a time.
+// Learner members are not defragmented, as etcd doesn't serve defragmentation requests on learners.
+type DefragController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *DefragController) Name() string {
+	return "etcd.DefragController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DefragController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        pointer.To(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("etcd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DefragController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.DefragStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DefragController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(defragCheckInterval)
+	defer ticker.Stop()
+
+	var lastCheck time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		machineType, err := safe.ReaderGet[*config.MachineType](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		if !machineType.MachineType().IsControlPlane() {
+			if err = teardownAll(ctx, r, etcd.NamespaceName, etcd.DefragStatusType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		machineConfig, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		defragConfig := machineConfig.Config().Cluster().Etcd().Defragmentation()
+
+		// learners don't support defragmentation, and etcd-less nodes have nothing to defragment
+		if !defragConfig.Enabled() || machineConfig.Config().Cluster().Etcd().Role() != constants.EtcdRoleVoter {
+			if err = teardownAll(ctx, r, etcd.NamespaceName, etcd.DefragStatusType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		etcdService, err := safe.ReaderGet[*v1alpha1.Service](ctx, r, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting etcd service: %w", err)
+		}
+
+		if !etcdService.TypedSpec().Healthy {
+			continue
+		}
+
+		now := time.Now()
+
+		if now.Before(lastCheck.Add(defragConfig.Interval())) {
+			continue
+		}
+
+		lastCheck = now
+
+		result := ctrl.check(ctx, logger, machineConfig.Config().Cluster().Etcd())
+
+		if err = safe.WriterModify(ctx, r, etcd.NewDefragStatus(etcd.NamespaceName, etcd.DefragStatusID), func(status *etcd.DefragStatus) error {
+			spec := status.TypedSpec()
+
+			spec.LastCheck = now
+			spec.DBSize = result.DBSize
+			spec.DBSizeInUse = result.DBSizeInUse
+			spec.Decision = result.Decision
+			spec.LastError = result.LastError
+
+			if !result.LastDefragmentation.IsZero() {
+				spec.LastDefragmentation = result.LastDefragmentation
+				spec.DBSizeBefore = result.DBSizeBefore
+				spec.DBSizeAfter = result.DBSizeAfter
+				spec.LeadershipTransferredTo = result.LeadershipTransferredTo
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating defragmentation status: %w", err)
+		}
+	}
+}
+
+// check checks the fragmentation of the local member, and defragments it if needed.
+//
+// Errors are reported in the result, as they should be audited, but not fail the controller.
+func (ctrl *DefragController) check(ctx context.Context, logger *zap.Logger, etcdConfig talosconfig.Etcd) etcd.DefragStatusSpec {
+	var result etcd.DefragStatusSpec
+
+	cfg := etcdConfig.Defragmentation()
+
+	ctx, cancel := context.WithTimeout(ctx, defragTimeout)
+	defer cancel()
+
+	client, err := etcdclient.NewLocalClient()
+	if err != nil {
+		result.Decision = "error"
+		result.LastError = fmt.Sprintf("error creating etcd client: %s", err)
+
+		return result
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	status, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		result.Decision = "error"
+		result.LastError = fmt.Sprintf("error getting etcd status: %s", err)
+
+		return result
+	}
+
+	result.DBSize = status.DbSize
+	result.DBSizeInUse = status.DbSizeInUse
+
+	var needed bool
+
+	needed, result.Decision = defragNeeded(status.DbSize, status.DbSizeInUse, cfg)
+	if !needed {
+		return result
+	}
+
+	var skipped bool
+
+	if err = etcdclient.WithLock(ctx, etcdConfig, constants.EtcdTalosDefragMutex, logger, func() error {
+		// the lock might have been held for a long time, so re-check the fragmentation under the lock
+		current, statusErr := client.Status(ctx, client.Endpoints()[0])
+		if statusErr != nil {
+			return fmt.Errorf("error getting etcd status: %w", statusErr)
+		}
+
+		result.DBSize = current.DbSize
+		result.DBSizeInUse = current.DbSizeInUse
+
+		needed, result.Decision = defragNeeded(current.DbSize, current.DbSizeInUse, cfg)
+		if !needed {
+			skipped = true
+
+			return nil
+		}
+
+		logger.Info("defragmenting etcd member", zap.String("reason", result.Decision))
+
+		// skip the leader when possible: defragmentation blocks the member, so transfer the leadership first
+		newLeader, forfeitErr := ctrl.forfeitLeadership(ctx, client)
+		if forfeitErr != nil {
+			logger.Warn("failed to transfer etcd leadership before defragmentation", zap.Error(forfeitErr))
+		}
+
+		result.LeadershipTransferredTo = newLeader
+
+		start := time.Now()
+
+		if _, defragErr := client.Defragment(ctx, client.Endpoints()[0]); defragErr != nil {
+			return fmt.Errorf("error defragmenting: %w", defragErr)
+		}
+
+		after, statusErr := client.Status(ctx, client.Endpoints()[0])
+		if statusErr != nil {
+			return fmt.Errorf("error getting etcd status: %w", statusErr)
+		}
+
+		result.LastDefragmentation = start
+		result.DBSizeBefore = result.DBSize
+		result.DBSizeAfter = after.DbSize
+
+		logger.Info("etcd member defragmented",
+			zap.Duration("duration", time.Since(start)),
+			zap.Int64("db_size_before", result.DBSizeBefore),
+			zap.Int64("db_size_after", result.DBSizeAfter),
+		)
+
+		return nil
+	}); err != nil {
+		result.Decision = "defragmentation failed: " + result.Decision
+		result.LastError = err.Error()
+
+		return result
+	}
+
+	if skipped {
+		return result
+	}
+
+	result.Decision = "defragmented: " + result.Decision
+
+	return result
+}
+
+// forfeitLeadership transfers the leadership to another member if the local member is the leader.
+//
+// Returns the name of the new leader if the leadership was transferred.
+func (ctrl *DefragController) forfeitLeadership(ctx context.Context, client *etcdclient.Client) (string, error) {
+	leader, err := client.IsLeader(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !leader {
+		return "", nil
+	}
+
+	members, err := client.MemberList(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(members.Members) < 2 {
+		// single member cluster, nothing to transfer to
+		return "", nil
+	}
+
+	return client.ForfeitLeadership(ctx)
+}
+
+// defragNeeded decides whether the member database should be defragmented, and returns the reason.
+func defragNeeded(dbSize, dbSizeInUse int64, cfg talosconfig.EtcdDefragmentation) (bool, string) {
+	if dbSize <= 0 || uint64(dbSize) < cfg.MinDBSize() {
+		return false, fmt.Sprintf("database size %s is below the minimum %s", humanize.Bytes(uint64(dbSize)), humanize.Bytes(cfg.MinDBSize()))
+	}
+
+	fragmentation := int((dbSize - dbSizeInUse) * 100 / dbSize)
+
+	if fragmentation < cfg.Threshold() {
+		return false, fmt.Sprintf("fragmentation %d%% is below the threshold %d%%", fragmentation, cfg.Threshold())
+	}
+
+	return true, fmt.Sprintf("fragmentation %d%% reached the threshold %d%%", fragmentation, cfg.Threshold())
+}
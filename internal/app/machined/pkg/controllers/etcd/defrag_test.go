@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	etcdctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestDefragNeeded(t *testing.T) {
+	t.Parallel()
+
+	const mb = 1000 * 1000
+
+	for _, tt := range []struct {
+		name        string
+		cfg         *v1alpha1.EtcdDefragmentationConfig
+		dbSize      int64
+		dbSizeInUse int64
+
+		expected       bool
+		expectedReason string
+	}{
+		{
+			name:           "defaults, small database",
+			dbSize:         20 * mb,
+			dbSizeInUse:    1 * mb,
+			expectedReason: "database size 20 MB is below the minimum 100 MB",
+		},
+		{
+			name:           "defaults, below threshold",
+			dbSize:         200 * mb,
+			dbSizeInUse:    150 * mb,
+			expectedReason: "fragmentation 25% is below the threshold 50%",
+		},
+		{
+			name:           "defaults, threshold reached",
+			dbSize:         200 * mb,
+			dbSizeInUse:    100 * mb,
+			expected:       true,
+			expectedReason: "fragmentation 50% reached the threshold 50%",
+		},
+		{
+			name: "custom",
+			cfg: &v1alpha1.EtcdDefragmentationConfig{
+				DefragThreshold: 20,
+				DefragMinDBSize: v1alpha1.DiskSize(10 * mb),
+			},
+			dbSize:         20 * mb,
+			dbSizeInUse:    15 * mb,
+			expected:       true,
+			expectedReason: "fragmentation 25% reached the threshold 20%",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			needed, reason := etcdctrl.DefragNeeded(tt.dbSize, tt.dbSizeInUse, tt.cfg)
+
+			assert.Equal(t, tt.expected, needed)
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
@@ -4,3 +4,26 @@
 
 // Package etcd provides controllers which manage etcd resources.
 package etcd
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+)
+
+// teardownAll destroys all resources of the given type in the namespace.
+func teardownAll(ctx context.Context, r controller.Runtime, namespace resource.Namespace, resourceType resource.Type) error {
+	list, err := r.List(ctx, resource.NewMetadata(namespace, resourceType, "", resource.VersionUndefined))
+	if err != nil {
+		return err
+	}
+
+	for _, res := range list.Items {
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
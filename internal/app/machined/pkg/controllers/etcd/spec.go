@@ -81,6 +81,11 @@ func (ctrl *SpecController) Run(ctx context.Context, r controller.Runtime, logge
 		etcdConfig, err := safe.ReaderGet[*etcd.Config](ctx, r, resource.NewMetadata(etcd.NamespaceName, etcd.ConfigType, etcd.ConfigID, resource.VersionUndefined))
 		if err != nil {
 			if state.IsNotFoundError(err) {
+				// etcd is not configured to run on this node
+				if err = ctrl.teardownAll(ctx, r); err != nil {
+					return err
+				}
+
 				continue
 			}
 
@@ -205,3 +210,18 @@ func (ctrl *SpecController) Run(ctx context.Context, r controller.Runtime, logge
 		}
 	}
 }
+
+func (ctrl *SpecController) teardownAll(ctx context.Context, r controller.Runtime) error {
+	list, err := r.List(ctx, resource.NewMetadata(etcd.NamespaceName, etcd.SpecType, "", resource.VersionUndefined))
+	if err != nil {
+		return err
+	}
+
+	for _, res := range list.Items {
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
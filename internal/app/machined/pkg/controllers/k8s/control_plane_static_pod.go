@@ -7,6 +7,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -99,22 +100,32 @@ func (ctrl *ControlPlaneStaticPodController) Run(ctx context.Context, r controll
 		case <-r.EventCh():
 		}
 
-		// wait for etcd to be healthy as kube-apiserver is using local etcd instance
-		etcdResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		externalEtcd, err := ctrl.usesExternalEtcd(ctx, r)
 		if err != nil {
-			if state.IsNotFoundError(err) {
-				if err = ctrl.teardownAll(ctx, r); err != nil {
-					return fmt.Errorf("error tearing down: %w", err)
+			return err
+		}
+
+		// wait for etcd to be healthy as kube-apiserver is using local etcd instance,
+		// unless the node doesn't run etcd, and kube-apiserver uses external etcd
+		if !externalEtcd {
+			var etcdResource resource.Resource
+
+			etcdResource, err = r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					if err = ctrl.teardownAll(ctx, r); err != nil {
+						return fmt.Errorf("error tearing down: %w", err)
+					}
+
+					continue
 				}
 
-				continue
+				return err
 			}
 
-			return err
-		}
-
-		if !etcdResource.(*v1alpha1.Service).TypedSpec().Healthy {
-			continue
+			if !etcdResource.(*v1alpha1.Service).TypedSpec().Healthy {
+				continue
+			}
 		}
 
 		secretsStatusResource, err := r.Get(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.SecretsStatusType, k8s.StaticPodSecretsStaticPodID, resource.VersionUndefined))
@@ -210,6 +221,37 @@ func (ctrl *ControlPlaneStaticPodController) Run(ctx context.Context, r controll
 	}
 }
 
+// usesExternalEtcd checks whether kube-apiserver is configured to use external etcd endpoints only.
+func (ctrl *ControlPlaneStaticPodController) usesExternalEtcd(ctx context.Context, r controller.Runtime) (bool, error) {
+	res, err := r.Get(ctx, k8s.NewAPIServerConfig().Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error getting API server config: %w", err)
+	}
+
+	etcdServers := res.(*k8s.APIServerConfig).TypedSpec().EtcdServers
+
+	if len(etcdServers) == 0 {
+		return false, nil
+	}
+
+	for _, server := range etcdServers {
+		u, parseErr := url.Parse(server)
+		if parseErr != nil {
+			return false, fmt.Errorf("error parsing etcd server URL: %w", parseErr)
+		}
+
+		if u.Hostname() == "localhost" {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (ctrl *ControlPlaneStaticPodController) teardownAll(ctx context.Context, r controller.Runtime) error {
 	list, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "", resource.VersionUndefined))
 	if err != nil {
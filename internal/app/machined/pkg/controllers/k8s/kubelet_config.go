@@ -95,10 +95,17 @@ func (ctrl *KubeletConfigController) Run(ctx context.Context, r controller.Runti
 				kubeletConfig.ExtraArgs = cfgProvider.Machine().Kubelet().ExtraArgs()
 				kubeletConfig.ExtraMounts = cfgProvider.Machine().Kubelet().ExtraMounts()
 				kubeletConfig.ExtraConfig = cfgProvider.Machine().Kubelet().ExtraConfig()
+				kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
 				kubeletConfig.CloudProviderExternal = cfgProvider.Cluster().ExternalCloudProvider().Enabled()
 				kubeletConfig.DefaultRuntimeSeccompEnabled = cfgProvider.Machine().Kubelet().DefaultRuntimeSeccompProfileEnabled()
 				kubeletConfig.SkipNodeRegistration = cfgProvider.Machine().Kubelet().SkipNodeRegistration()
 
+				if cfgProvider.Machine().Swap().Enabled() {
+					kubeletConfig.SwapBehavior = cfgProvider.Machine().Swap().KubeletSwapBehavior()
+				} else {
+					kubeletConfig.SwapBehavior = ""
+				}
+
 				return nil
 			},
 		); err != nil {
@@ -285,7 +285,46 @@ func (ctrl *KubeletServiceController) writeConfig(cfgSpec *k8s.KubeletSpecSpec)
 		return err
 	}
 
-	return os.WriteFile("/etc/kubernetes/kubelet.yaml", buf.Bytes(), 0o600)
+	if err := os.WriteFile("/etc/kubernetes/kubelet.yaml", buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+
+	return ctrl.writeCredentialProviderConfig(cfgSpec)
+}
+
+func (ctrl *KubeletServiceController) writeCredentialProviderConfig(cfgSpec *k8s.KubeletSpecSpec) error {
+	if cfgSpec.CredentialProviderConfig == nil {
+		if err := os.Remove(constants.KubeletCredentialProviderConfig); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	var credentialProviderConfig kubeletconfig.CredentialProviderConfig
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cfgSpec.CredentialProviderConfig, &credentialProviderConfig); err != nil {
+		return fmt.Errorf("error converting kubelet credential provider configuration from unstructured: %w", err)
+	}
+
+	serializer := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory,
+		nil,
+		nil,
+		json.SerializerOptions{
+			Yaml:   true,
+			Pretty: true,
+			Strict: true,
+		},
+	)
+
+	var buf bytes.Buffer
+
+	if err := serializer.Encode(&credentialProviderConfig, &buf); err != nil {
+		return err
+	}
+
+	return os.WriteFile(constants.KubeletCredentialProviderConfig, buf.Bytes(), 0o600)
 }
 
 // updateKubeconfig updates the kubeconfig of kubelet with the given endpoint if it exists.
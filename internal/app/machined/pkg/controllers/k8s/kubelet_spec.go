@@ -127,6 +127,11 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 			args["cloud-provider"] = "external"
 		}
 
+		if cfgSpec.CredentialProviderConfig != nil {
+			args["image-credential-provider-bin-dir"] = constants.KubeletCredentialProviderBinDir
+			args["image-credential-provider-config"] = constants.KubeletCredentialProviderConfig
+		}
+
 		extraArgs := argsbuilder.Args(cfgSpec.ExtraArgs)
 
 		// if the user supplied a hostname override, we do not manage it anymore
@@ -161,6 +166,9 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 				"container-runtime-endpoint": argsbuilder.MergeDenied,
 				"config":                     argsbuilder.MergeDenied,
 				"cert-dir":                   argsbuilder.MergeDenied,
+
+				"image-credential-provider-bin-dir": argsbuilder.MergeDenied,
+				"image-credential-provider-config":  argsbuilder.MergeDenied,
 			},
 		)); err != nil {
 			return fmt.Errorf("error merging arguments: %w", err)
@@ -183,6 +191,12 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 			return fmt.Errorf("error converting to unstructured: %w", err)
 		}
 
+		if cfgSpec.CredentialProviderConfig != nil {
+			if err = ValidateCredentialProviderConfig(cfgSpec.CredentialProviderConfig); err != nil {
+				return fmt.Errorf("error validating kubelet credential provider configuration: %w", err)
+			}
+		}
+
 		if err = r.Modify(
 			ctx,
 			k8s.NewKubeletSpec(k8s.NamespaceName, k8s.KubeletID),
@@ -194,6 +208,7 @@ func (ctrl *KubeletSpecController) Run(ctx context.Context, r controller.Runtime
 				kubeletSpec.Args = args.Args()
 				kubeletSpec.Config = unstructuredConfig
 				kubeletSpec.ExpectedNodename = expectedNodename
+				kubeletSpec.CredentialProviderConfig = cfgSpec.CredentialProviderConfig
 
 				return nil
 			},
@@ -228,6 +243,52 @@ func prepareExtraConfig(extraConfig map[string]interface{}) (*kubeletconfig.Kube
 	return &config, nil
 }
 
+// ValidateCredentialProviderConfig validates kubelet credential provider configuration against the kubelet API types.
+func ValidateCredentialProviderConfig(credentialProviderConfig map[string]interface{}) error {
+	var config kubeletconfig.CredentialProviderConfig
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(credentialProviderConfig, &config, true); err != nil {
+		return err
+	}
+
+	var multiErr *multierror.Error
+
+	if config.Kind != kubelet.CredentialProviderConfigKind {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("unexpected kind %q", config.Kind))
+	}
+
+	if !kubelet.IsSupportedCredentialProviderConfigAPIVersion(config.APIVersion) {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("unsupported apiVersion %q", config.APIVersion))
+	}
+
+	if len(config.Providers) == 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("at least one provider is required"))
+	}
+
+	for i, provider := range config.Providers {
+		switch {
+		case provider.Name == "":
+			multiErr = multierror.Append(multiErr, fmt.Errorf("providers[%d]: name is required", i))
+		case strings.Contains(provider.Name, "/") || provider.Name == "." || provider.Name == "..":
+			multiErr = multierror.Append(multiErr, fmt.Errorf("providers[%d]: name %q should be a file name in %q", i, provider.Name, constants.KubeletCredentialProviderBinDir))
+		}
+
+		if len(provider.MatchImages) == 0 {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("providers[%d]: matchImages is required", i))
+		}
+
+		if provider.DefaultCacheDuration == nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("providers[%d]: defaultCacheDuration is required", i))
+		}
+
+		if provider.APIVersion == "" {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("providers[%d]: apiVersion is required", i))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
 // NewKubeletConfiguration builds kubelet configuration with defaults and overrides from extraConfig.
 //
 //nolint:gocyclo,cyclop
@@ -277,6 +338,21 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec) (*kubeletconfig.Kub
 		}
 	}
 
+	if cfgSpec.SwapBehavior != "" {
+		// enable swap support in the kubelet unless explicitly disabled via extraConfig
+		if config.FeatureGates == nil {
+			config.FeatureGates = map[string]bool{}
+		}
+
+		if _, overridden := config.FeatureGates["NodeSwap"]; !overridden {
+			config.FeatureGates["NodeSwap"] = true
+		}
+
+		if config.MemorySwap.SwapBehavior == "" {
+			config.MemorySwap.SwapBehavior = cfgSpec.SwapBehavior
+		}
+	}
+
 	if cfgSpec.SkipNodeRegistration {
 		config.Authentication.Webhook.Enabled = pointer.To(false)
 		config.Authorization.Mode = kubeletconfig.KubeletAuthorizationModeAlwaysAllow
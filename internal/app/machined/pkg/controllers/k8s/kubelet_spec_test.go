@@ -332,6 +332,76 @@ func (suite *KubeletSpecSuite) TestReconcileWithSkipNodeRegistration() {
 	)
 }
 
+func (suite *KubeletSpecSuite) TestReconcileWithCredentialProviderConfig() {
+	cfg := k8s.NewKubeletConfig(k8s.NamespaceName, k8s.KubeletID)
+	cfg.TypedSpec().Image = "kubelet:v1.0.0"
+	cfg.TypedSpec().ClusterDNS = []string{"10.96.0.10"}
+	cfg.TypedSpec().ClusterDomain = "cluster.local"
+	cfg.TypedSpec().ExtraArgs = map[string]string{"node-ip": "10.0.0.1"}
+	cfg.TypedSpec().CredentialProviderConfig = map[string]interface{}{
+		"apiVersion": "kubelet.config.k8s.io/v1beta1",
+		"kind":       "CredentialProviderConfig",
+		"providers": []interface{}{
+			map[string]interface{}{
+				"name":                 "ecr-credential-provider",
+				"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+				"defaultCacheDuration": "12h",
+				"matchImages":          []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "example.com"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodename))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletSpec, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletSpecType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletSpec.(*k8s.KubeletSpec).TypedSpec()
+
+				suite.Assert().Equal(
+					[]string{
+						"--bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubeconfig",
+						"--cert-dir=/var/lib/kubelet/pki",
+						"--config=/etc/kubernetes/kubelet.yaml",
+						"--container-runtime=remote",
+						"--container-runtime-endpoint=unix:///run/containerd/containerd.sock",
+						"--hostname-override=example.com",
+						"--image-credential-provider-bin-dir=/usr/local/lib/kubelet/credentialproviders",
+						"--image-credential-provider-config=/etc/kubernetes/kubelet-credentialproviderconfig.yaml",
+						"--kubeconfig=/etc/kubernetes/kubeconfig-kubelet",
+						"--node-ip=10.0.0.1",
+					}, spec.Args,
+				)
+				suite.Assert().Equal(cfg.TypedSpec().CredentialProviderConfig, spec.CredentialProviderConfig)
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletSpecSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -548,6 +618,42 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 				kc.Authorization.Mode = kubeletconfig.KubeletAuthorizationModeAlwaysAllow
 			},
 		},
+		{
+			name: "enable swap",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				SwapBehavior:  "LimitedSwap",
+			},
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.FeatureGates = map[string]bool{
+					"NodeSwap": true,
+				}
+				kc.MemorySwap.SwapBehavior = "LimitedSwap"
+			},
+		},
+		{
+			name: "enable swap with overrides",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				SwapBehavior:  "LimitedSwap",
+				ExtraConfig: map[string]interface{}{
+					"featureGates": map[string]interface{}{
+						"NodeSwap": false,
+					},
+					"memorySwap": map[string]interface{}{
+						"swapBehavior": "UnlimitedSwap",
+					},
+				},
+			},
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.FeatureGates = map[string]bool{
+					"NodeSwap": false,
+				}
+				kc.MemorySwap.SwapBehavior = "UnlimitedSwap"
+			},
+		},
 	} {
 		tt := tt
 
@@ -563,3 +669,68 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCredentialProviderConfig(t *testing.T) {
+	provider := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                 name,
+			"apiVersion":           "credentialprovider.kubelet.k8s.io/v1beta1",
+			"defaultCacheDuration": "12h",
+			"matchImages":          []interface{}{"*.azurecr.io"},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			config: map[string]interface{}{
+				"apiVersion": "kubelet.config.k8s.io/v1beta1",
+				"kind":       "CredentialProviderConfig",
+				"providers":  []interface{}{provider("acr-credential-provider")},
+			},
+		},
+		{
+			name: "unknown field",
+			config: map[string]interface{}{
+				"apiVersion": "kubelet.config.k8s.io/v1beta1",
+				"kind":       "CredentialProviderConfig",
+				"provider":   []interface{}{provider("acr-credential-provider")},
+			},
+			expectedErr: "strict decoding error: unknown field \"provider\"",
+		},
+		{
+			name: "invalid provider",
+			config: map[string]interface{}{
+				"apiVersion": "kubelet.config.k8s.io/v1",
+				"kind":       "CredentialProviderConfig",
+				"providers": []interface{}{
+					provider("../bin/sh"),
+					map[string]interface{}{
+						"name": "foo",
+					},
+				},
+			},
+			expectedErr: "5 errors occurred:\n\t* unsupported apiVersion \"kubelet.config.k8s.io/v1\"\n" +
+				"\t* providers[0]: name \"../bin/sh\" should be a file name in \"/usr/local/lib/kubelet/credentialproviders\"\n" +
+				"\t* providers[1]: matchImages is required\n\t* providers[1]: defaultCacheDuration is required\n\t* providers[1]: apiVersion is required\n\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := k8sctrl.ValidateCredentialProviderConfig(tt.config)
+
+				if tt.expectedErr == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.EqualError(t, err, tt.expectedErr)
+				}
+			},
+		)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/pkg/logging"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
 	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
@@ -50,6 +51,12 @@ func (ctrl *ManifestApplyController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *ManifestApplyController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: secrets.NamespaceName,
 			Type:      secrets.KubernetesType,
@@ -102,18 +109,34 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 
 		secrets := secretsResources.(*secrets.Kubernetes).TypedSpec()
 
-		// wait for etcd to be healthy as controller relies on etcd for locking
-		etcdResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
 		if err != nil {
 			if state.IsNotFoundError(err) {
 				continue
 			}
 
-			return err
+			return fmt.Errorf("error getting config: %w", err)
 		}
 
-		if !etcdResource.(*v1alpha1.Service).TypedSpec().Healthy {
-			continue
+		etcdConfig := cfg.(*config.MachineConfig).Config().Cluster().Etcd()
+
+		// wait for etcd to be healthy as controller relies on etcd for locking
+		// (learner and etcd-less nodes lock via external endpoints)
+		if etcdConfig.Role() == constants.EtcdRoleVoter {
+			var etcdResource resource.Resource
+
+			etcdResource, err = r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					continue
+				}
+
+				return err
+			}
+
+			if !etcdResource.(*v1alpha1.Service).TypedSpec().Healthy {
+				continue
+			}
 		}
 
 		manifests, err := r.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.ManifestType, "", resource.VersionUndefined))
@@ -155,7 +178,7 @@ func (ctrl *ManifestApplyController) Run(ctx context.Context, r controller.Runti
 				return fmt.Errorf("error building dynamic client: %w", err)
 			}
 
-			if err = etcd.WithLock(ctx, constants.EtcdTalosManifestApplyMutex, logger, func() error {
+			if err = etcd.WithLock(ctx, etcdConfig, constants.EtcdTalosManifestApplyMutex, logger, func() error {
 				return ctrl.apply(ctx, logger, mapper, dyn, manifests)
 			}); err != nil {
 				return err
@@ -22,6 +22,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/kubeaccess/serviceaccount"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/kubeaccess"
@@ -39,6 +40,12 @@ func (ctrl *CRDController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *CRDController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: config.NamespaceName,
 			Type:      kubeaccess.ConfigType,
@@ -102,6 +109,17 @@ func (ctrl *CRDController) Run(ctx context.Context, r controller.Runtime, logger
 			}
 		}
 
+		machineConfig, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error fetching machine config: %w", err)
+			}
+
+			continue
+		}
+
+		etcdConfig := machineConfig.Config().Cluster().Etcd()
+
 		kubeaccessConfig, err := safe.ReaderGet[*kubeaccess.Config](ctx, r, kubeaccess.NewConfig(config.NamespaceName, kubeaccess.ConfigID).Metadata())
 		if err != nil {
 			if !state.IsNotFoundError(err) {
@@ -174,6 +192,7 @@ func (ctrl *CRDController) Run(ctx context.Context, r controller.Runtime, logger
 				osSecretsSpec.CA,
 				kubeconfig,
 				kubeaccessConfigSpec,
+				etcdConfig,
 				logger,
 			)
 		}()
@@ -185,9 +204,10 @@ func (ctrl *CRDController) runCRDController(
 	talosCA *x509.PEMEncodedCertificateAndKey,
 	kubeconfig *rest.Config,
 	kubeaccessCfgSpec *kubeaccess.ConfigSpec,
+	etcdConfig talosconfig.Etcd,
 	logger *zap.Logger,
 ) error {
-	return etcd.WithLock(ctx, constants.EtcdTalosServiceAccountCRDControllerMutex, logger, func() error {
+	return etcd.WithLock(ctx, etcdConfig, constants.EtcdTalosServiceAccountCRDControllerMutex, logger, func() error {
 		crdCtrl, err := serviceaccount.NewCRDController(
 			talosCA,
 			kubeconfig,
@@ -114,6 +114,7 @@ func (ctrl *AddressStatusController) Run(ctx context.Context, r controller.Runti
 				status.Family = nethelpers.Family(addr.Family)
 				status.Scope = nethelpers.Scope(addr.Scope)
 				status.Flags = nethelpers.AddressFlags(addr.Attributes.Flags)
+				status.SLAAC = isSLAACAddress(addr)
 
 				return nil
 			}); err != nil {
@@ -144,3 +145,13 @@ func (ctrl *AddressStatusController) Run(ctx context.Context, r controller.Runti
 		}
 	}
 }
+
+// isSLAACAddress returns true for the IPv6 addresses autoconfigured by the kernel from router advertisements.
+//
+// Kernel creates such addresses with a finite lifetime, so they don't have the permanent flag,
+// while the addresses configured by Talos are always permanent.
+func isSLAACAddress(addr rtnetlink.AddressMessage) bool {
+	return addr.Family == unix.AF_INET6 &&
+		addr.Scope == unix.RT_SCOPE_UNIVERSE &&
+		addr.Attributes.Flags&unix.IFA_F_PERMANENT == 0
+}
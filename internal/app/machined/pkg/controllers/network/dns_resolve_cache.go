@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/dns"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// DNSResolveCacheController runs the host DNS caching resolver based on network.HostDNSConfig and network.ResolverStatus.
+type DNSResolveCacheController struct {
+	// StatusInterval is the interval to refresh upstream health status, overridden in tests.
+	StatusInterval time.Duration
+	// HealthCheckInterval is the interval to probe unhealthy upstreams, overridden in tests.
+	HealthCheckInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Name() string {
+	return "network.DNSResolveCacheController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        pointer.To(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.DNSUpstreamType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.StatusInterval == 0 {
+		ctrl.StatusInterval = 5 * time.Second
+	}
+
+	handler := dns.NewHandler(logger)
+
+	if ctrl.HealthCheckInterval != 0 {
+		handler.HealthCheckInterval = ctrl.HealthCheckInterval
+	}
+
+	var wg sync.WaitGroup
+
+	healthCtx, healthCancel := context.WithCancel(ctx)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		handler.RunHealthChecks(healthCtx)
+	}()
+
+	defer wg.Wait()
+	defer healthCancel()
+
+	var server *dns.Server
+
+	stopServer := func() {
+		if server == nil {
+			return
+		}
+
+		if err := server.Shutdown(); err != nil {
+			logger.Error("error shutting down host DNS server", zap.Error(err))
+		}
+
+		logger.Info("host DNS server stopped")
+
+		server = nil
+	}
+
+	defer stopServer()
+
+	ticker := time.NewTicker(ctrl.StatusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGet[*network.HostDNSConfig](ctx, r, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID).Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting host DNS config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil && cfg.TypedSpec().Enabled {
+			listenAddress := cfg.TypedSpec().ListenAddress
+
+			if server != nil && server.Addr() != listenAddress {
+				stopServer()
+			}
+
+			if server == nil {
+				server, err = dns.NewServer(listenAddress, handler)
+				if err != nil {
+					return fmt.Errorf("error starting host DNS server: %w", err)
+				}
+
+				logger.Info("host DNS server started", zap.Stringer("address", server.Addr()))
+			}
+
+			resolverStatus, err := safe.ReaderGet[*network.ResolverStatus](ctx, r, network.NewResolverStatus(network.NamespaceName, network.ResolverID).Metadata())
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting resolver status: %w", err)
+			}
+
+			var upstreams []string
+
+			if resolverStatus != nil {
+				for _, addr := range resolverStatus.TypedSpec().DNSServers {
+					upstreams = append(upstreams, netip.AddrPortFrom(addr, 53).String())
+				}
+			}
+
+			handler.SetUpstreams(upstreams)
+
+			for _, upstream := range handler.Upstreams() {
+				upstream := upstream
+
+				if err = r.Modify(ctx, network.NewDNSUpstream(network.NamespaceName, upstream.Addr()), func(res resource.Resource) error {
+					res.(*network.DNSUpstream).TypedSpec().Address = netip.MustParseAddrPort(upstream.Addr())
+					res.(*network.DNSUpstream).TypedSpec().Healthy = upstream.Healthy()
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying DNS upstream: %w", err)
+				}
+
+				touchedIDs[upstream.Addr()] = struct{}{}
+			}
+		} else {
+			stopServer()
+
+			handler.SetUpstreams(nil)
+		}
+
+		list, err := safe.ReaderList[*network.DNSUpstream](ctx, r, resource.NewMetadata(network.NamespaceName, network.DNSUpstreamType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing DNS upstreams: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := touchedIDs[iter.Value().Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up DNS upstreams: %w", err)
+				}
+			}
+		}
+	}
+}
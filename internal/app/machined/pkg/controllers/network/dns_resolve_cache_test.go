@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type DNSResolveCacheSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DNSResolveCacheSuite) assertUpstreams(expected map[resource.ID]network.DNSUpstreamSpec) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		list, err := safe.StateList[*network.DNSUpstream](suite.Ctx(), suite.State(), resource.NewMetadata(network.NamespaceName, network.DNSUpstreamType, "", resource.VersionUndefined))
+		require.NoError(err)
+
+		actual := map[resource.ID]network.DNSUpstreamSpec{}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			actual[iter.Value().Metadata().ID()] = *iter.Value().TypedSpec()
+		}
+
+		assert.Equal(expected, actual)
+	}))
+}
+
+func (suite *DNSResolveCacheSuite) TestReconcile() {
+	// pick a free port for the host DNS
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	listenAddress := conn.LocalAddr().(*net.UDPAddr).AddrPort()
+
+	suite.Require().NoError(conn.Close())
+
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ListenAddress = netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), listenAddress.Port())
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostDNSConfig))
+
+	// nothing listens on the upstream address
+	resolverStatus := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolverStatus.TypedSpec().DNSServers = []netip.Addr{netip.MustParseAddr("127.0.0.3")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), resolverStatus))
+
+	suite.assertUpstreams(map[resource.ID]network.DNSUpstreamSpec{
+		"127.0.0.3:53": {Address: netip.MustParseAddrPort("127.0.0.3:53"), Healthy: true},
+	})
+
+	client := &dns.Client{Net: "udp", Timeout: time.Second}
+
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		resp, _, err := client.Exchange(new(dns.Msg).SetQuestion("example.com.", dns.TypeA), hostDNSConfig.TypedSpec().ListenAddress.String())
+		require.NoError(err)
+
+		assert.Equal(dns.RcodeServerFailure, resp.Rcode)
+	}))
+
+	// failed upstream is reported as unhealthy
+	suite.assertUpstreams(map[resource.ID]network.DNSUpstreamSpec{
+		"127.0.0.3:53": {Address: netip.MustParseAddrPort("127.0.0.3:53"), Healthy: false},
+	})
+
+	// upstreams follow resolver status
+	resolverStatus.TypedSpec().DNSServers = []netip.Addr{netip.MustParseAddr("127.0.0.3"), netip.MustParseAddr("fd00::1")}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), resolverStatus))
+
+	suite.assertUpstreams(map[resource.ID]network.DNSUpstreamSpec{
+		"127.0.0.3:53": {Address: netip.MustParseAddrPort("127.0.0.3:53"), Healthy: false},
+		"[fd00::1]:53": {Address: netip.MustParseAddrPort("[fd00::1]:53"), Healthy: true},
+	})
+
+	// disabling host DNS stops the server
+	hostDNSConfig.TypedSpec().Enabled = false
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), hostDNSConfig))
+
+	suite.assertUpstreams(map[resource.ID]network.DNSUpstreamSpec{})
+
+	_, _, err = client.Exchange(new(dns.Msg).SetQuestion("example.com.", dns.TypeA), hostDNSConfig.TypedSpec().ListenAddress.String())
+	suite.Require().Error(err)
+}
+
+func TestDNSResolveCacheSuite(t *testing.T) {
+	suite.Run(t, &DNSResolveCacheSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.DNSResolveCacheController{
+					StatusInterval: 100 * time.Millisecond,
+				}))
+			},
+		},
+	})
+}
@@ -52,6 +52,12 @@ func (ctrl *EtcFileController) Inputs() []controller.Input {
 			ID:        pointer.To(network.ResolverID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.NodeAddressType,
@@ -115,6 +121,17 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 			hostnameStatus = hStatus.(*network.HostnameStatus).TypedSpec()
 		}
 
+		var hostDNSConfig *network.HostDNSConfigSpec
+
+		hDNSConfig, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSConfigID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			hostDNSConfig = hDNSConfig.(*network.HostDNSConfig).TypedSpec()
+		}
+
 		var nodeAddressStatus *network.NodeAddressSpec
 
 		naStatus, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.NodeAddressType, network.NodeAddressDefaultID, resource.VersionUndefined))
@@ -129,7 +146,7 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 		if resolverStatus != nil {
 			if err = r.Modify(ctx, files.NewEtcFileSpec(files.NamespaceName, "resolv.conf"),
 				func(r resource.Resource) error {
-					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(resolverStatus, hostnameStatus, cfgProvider)
+					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(resolverStatus, hostnameStatus, hostDNSConfig, cfgProvider)
 					r.(*files.EtcFileSpec).TypedSpec().Mode = 0o644
 
 					return nil
@@ -152,16 +169,21 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 	}
 }
 
-func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.ResolverStatusSpec, hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Provider) []byte {
+func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.ResolverStatusSpec, hostnameStatus *network.HostnameStatusSpec, hostDNSConfig *network.HostDNSConfigSpec, cfgProvider talosconfig.Provider) []byte {
 	var buf bytes.Buffer
 
-	for i, resolver := range resolverStatus.DNSServers {
-		if i >= 3 {
-			// only use firt 3 nameservers, see MAXNS in https://linux.die.net/man/5/resolv.conf
-			break
-		}
+	if hostDNSConfig != nil && hostDNSConfig.Enabled {
+		// host DNS caching resolver forwards requests to the upstream resolvers
+		fmt.Fprintf(&buf, "nameserver %s\n", hostDNSConfig.ListenAddress.Addr())
+	} else {
+		for i, resolver := range resolverStatus.DNSServers {
+			if i >= 3 {
+				// only use firt 3 nameservers, see MAXNS in https://linux.die.net/man/5/resolv.conf
+				break
+			}
 
-		fmt.Fprintf(&buf, "nameserver %s\n", resolver)
+			fmt.Fprintf(&buf, "nameserver %s\n", resolver)
+		}
 	}
 
 	var disableSearchDomain bool
@@ -276,6 +276,18 @@ func (suite *EtcFileConfigSuite) TestOnlyResolvers() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestHostDNS() {
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ListenAddress = netip.MustParseAddrPort("169.254.116.108:53")
+
+	suite.testFiles(
+		[]resource.Resource{hostDNSConfig, suite.resolverStatus, suite.hostnameStatus},
+		"nameserver 169.254.116.108\n\nsearch example.com\n",
+		"",
+	)
+}
+
 func (suite *EtcFileConfigSuite) TestOnlyHostname() {
 	suite.testFiles(
 		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus},
@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// HostDNSConfigController manages network.HostDNSConfig based on machine configuration.
+//
+// When the host DNS is enabled, the controller also assigns the host DNS link-local address to the loopback interface.
+type HostDNSConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Name() string {
+	return "network.HostDNSConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.HostDNSConfigType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.AddressSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	listenAddress := netip.AddrPortFrom(netip.MustParseAddr(constants.HostDNSAddress), constants.HostDNSPort)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		enabled := cfg != nil && cfg.(*config.MachineConfig).Config().Machine().Features().HostDNS().Enabled()
+
+		if err = r.Modify(ctx, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID), func(res resource.Resource) error {
+			res.(*network.HostDNSConfig).TypedSpec().Enabled = enabled
+			res.(*network.HostDNSConfig).TypedSpec().ListenAddress = listenAddress
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying host DNS config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if enabled {
+			address := network.AddressSpecSpec{
+				Address:     netip.PrefixFrom(listenAddress.Addr(), listenAddress.Addr().BitLen()),
+				Family:      nethelpers.FamilyInet4,
+				Scope:       nethelpers.ScopeHost,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				LinkName:    "lo",
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+
+			id := network.LayeredID(address.ConfigLayer, network.AddressID(address.LinkName, address.Address))
+
+			if err = r.Modify(ctx, network.NewAddressSpec(network.ConfigNamespaceName, id), func(res resource.Resource) error {
+				*res.(*network.AddressSpec).TypedSpec() = address
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying address spec: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up addresses: %w", err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type HostDNSConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *HostDNSConfigSuite) machineConfig(enabled bool) *config.MachineConfig {
+	return config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				HostDNSSupport: &v1alpha1.HostDNSConfig{
+					HostDNSEnabled: pointer.To(enabled),
+				},
+			},
+		},
+	})
+}
+
+func (suite *HostDNSConfigSuite) assertState(enabled bool) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		cfg, err := safe.StateGet[*network.HostDNSConfig](suite.Ctx(), suite.State(), network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID).Metadata())
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal(enabled, cfg.TypedSpec().Enabled)
+		assert.Equal(netip.MustParseAddrPort("169.254.116.108:53"), cfg.TypedSpec().ListenAddress)
+
+		list, err := safe.StateList[*network.AddressSpec](suite.Ctx(), suite.State(), resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined))
+		require.NoError(err)
+
+		if !enabled {
+			assert.Equal(0, list.Len())
+
+			return
+		}
+
+		if !assert.Equal(1, list.Len()) {
+			return
+		}
+
+		address := list.Get(0)
+
+		assert.Equal("configuration/lo/169.254.116.108/32", address.Metadata().ID())
+		assert.Equal("lo", address.TypedSpec().LinkName)
+		assert.Equal(netip.MustParsePrefix("169.254.116.108/32"), address.TypedSpec().Address)
+		assert.Equal(network.ConfigMachineConfiguration, address.TypedSpec().ConfigLayer)
+	}))
+}
+
+func (suite *HostDNSConfigSuite) TestReconcile() {
+	cfg := suite.machineConfig(true)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.assertState(true)
+
+	newCfg := suite.machineConfig(false)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertState(false)
+}
+
+func TestHostDNSConfigSuite(t *testing.T) {
+	suite.Run(t, &HostDNSConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.HostDNSConfigController{}))
+			},
+		},
+	})
+}
@@ -175,6 +175,12 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 						configuredLinks[link] = struct{}{}
 					}
 				}
+
+				if device.VRF() != nil {
+					for _, link := range device.VRF().Interfaces() {
+						configuredLinks[link] = struct{}{}
+					}
+				}
 			}
 		}
 
@@ -275,12 +281,23 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 	// scan for the bonds or bridges
 	bondedLinks := map[string]ordered.Pair[string, int]{} // mapping physical interface -> bond interface
 	bridgedLinks := map[string]string{}                   // mapping physical interface -> bridge interface
+	vrfLinks := map[string]string{}                       // mapping interface -> VRF interface
 
 	for _, device := range devices {
 		if device.Ignore() {
 			continue
 		}
 
+		if device.VRF() != nil {
+			for _, linkName := range device.VRF().Interfaces() {
+				if iface, exists := vrfLinks[linkName]; exists && iface != device.Interface() {
+					logger.Sugar().Warnf("link %q is included into more than two VRFs", linkName)
+				}
+
+				vrfLinks[linkName] = device.Interface()
+			}
+		}
+
 		if device.Bond() == nil && device.Bridge() == nil {
 			continue
 		}
@@ -355,6 +372,34 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			dummyLink(linkMap[device.Interface()])
 		}
 
+		if device.VXLAN() != nil {
+			if err := vxlanLink(linkMap[device.Interface()], device.VXLAN()); err != nil {
+				logger.Error("error parsing VXLAN config", zap.Error(err))
+			}
+		}
+
+		if device.VRF() != nil {
+			vrfLink(linkMap[device.Interface()], device.VRF())
+		}
+
+		if device.MACVLAN() != nil {
+			if err := macvlanLink(linkMap[device.Interface()], device.MACVLAN()); err != nil {
+				logger.Error("error parsing MACVLAN config", zap.Error(err))
+			}
+		}
+
+		if device.Ethtool() != nil {
+			ethtoolLink(linkMap[device.Interface()], device.Ethtool())
+		}
+
+		if device.RouterAdvertisement() != nil {
+			linkMap[device.Interface()].RouterAdvertisement = network.RouterAdvertisementSpec{
+				Configured: true,
+				Accept:     device.RouterAdvertisement().Accept(),
+				SLAAC:      device.RouterAdvertisement().SLAAC(),
+			}
+		}
+
 		for _, vlan := range device.Vlans() {
 			vlanName := fmt.Sprintf("%s.%d", device.Interface(), vlan.ID())
 
@@ -392,6 +437,18 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 		SetBridgeSlave(linkMap[slaveName], bridgeIface)
 	}
 
+	for slaveName, vrfIface := range vrfLinks {
+		if _, exists := linkMap[slaveName]; !exists {
+			linkMap[slaveName] = &network.LinkSpecSpec{
+				Name:        slaveName,
+				Up:          true,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+		}
+
+		SetVRFSlave(linkMap[slaveName], vrfIface)
+	}
+
 	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
 }
 
@@ -414,6 +471,60 @@ func vlanLink(link *network.LinkSpecSpec, linkName string, vlan vlaner) {
 	}
 }
 
+func vxlanLink(link *network.LinkSpecSpec, vxlan talosconfig.VXLAN) error {
+	link.Logical = true
+	link.Kind = network.LinkKindVXLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = vxlan.Link()
+	link.VXLAN = network.VXLANSpec{
+		VNI:  vxlan.VNI(),
+		Port: vxlan.Port(),
+		TTL:  vxlan.TTL(),
+	}
+
+	var err error
+
+	if vxlan.Local() != "" {
+		if link.VXLAN.Local, err = netip.ParseAddr(vxlan.Local()); err != nil {
+			return fmt.Errorf("error parsing VXLAN local address: %w", err)
+		}
+	}
+
+	if vxlan.Remote() != "" {
+		if link.VXLAN.Remote, err = netip.ParseAddr(vxlan.Remote()); err != nil {
+			return fmt.Errorf("error parsing VXLAN remote address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func vrfLink(link *network.LinkSpecSpec, vrf talosconfig.VRF) {
+	link.Logical = true
+	link.Kind = network.LinkKindVRF
+	link.Type = nethelpers.LinkEther
+	link.VRFMaster = network.VRFMasterSpec{
+		Table: nethelpers.RoutingTable(vrf.Table()),
+	}
+}
+
+func macvlanLink(link *network.LinkSpecSpec, macvlan talosconfig.MACVLAN) error {
+	mode, err := nethelpers.MACVLANModeByName(macvlan.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.Logical = true
+	link.Kind = network.LinkKindMACVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = macvlan.Link()
+	link.MACVLAN = network.MACVLANSpec{
+		Mode: mode,
+	}
+
+	return nil
+}
+
 func wireguardLink(link *network.LinkSpecSpec, config talosconfig.WireguardConfig) error {
 	link.Logical = true
 	link.Kind = network.LinkKindWireguard
@@ -447,6 +558,23 @@ func wireguardLink(link *network.LinkSpecSpec, config talosconfig.WireguardConfi
 	return nil
 }
 
+func ethtoolLink(link *network.LinkSpecSpec, config talosconfig.Ethtool) {
+	link.Ethtool = network.EthtoolSpec{
+		RXRingSize:       config.RXRingSize(),
+		TXRingSize:       config.TXRingSize(),
+		RXChannels:       config.RXChannels(),
+		TXChannels:       config.TXChannels(),
+		CombinedChannels: config.CombinedChannels(),
+		Features:         config.Features(),
+		Speed:            config.Speed(),
+		Duplex:           nethelpers.Full,
+	}
+
+	if config.Duplex() == "half" {
+		link.Ethtool.Duplex = nethelpers.Half
+	}
+}
+
 func dummyLink(link *network.LinkSpecSpec) {
 	link.Logical = true
 	link.Kind = "dummy"
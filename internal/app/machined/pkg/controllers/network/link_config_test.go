@@ -200,6 +200,21 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 					NetworkInterfaces: []*v1alpha1.Device{
 						{
 							DeviceInterface: "eth0",
+							DeviceEthtool: &v1alpha1.Ethtool{
+								EthtoolRings: &v1alpha1.EthtoolRings{
+									RingsRX: 4096,
+								},
+								EthtoolChannels: &v1alpha1.EthtoolChannels{
+									ChannelsCombined: 8,
+								},
+								EthtoolFeatures: map[string]bool{
+									"rx-gro": false,
+								},
+								EthtoolSpeed: 1000,
+							},
+							DeviceRouterAdvertisement: &v1alpha1.RouterAdvertisement{
+								RASLAAC: pointer.To(false),
+							},
 							DeviceVlans: []*v1alpha1.Vlan{
 								{
 									VlanID:  24,
@@ -284,6 +299,30 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 								},
 							},
 						},
+						{
+							DeviceInterface: "vxlan42",
+							DeviceVXLAN: &v1alpha1.VXLAN{
+								VXLANID:     42,
+								VXLANLink:   "eth1",
+								VXLANLocal:  "192.168.0.24",
+								VXLANRemote: "239.1.1.1",
+								VXLANTTL:    16,
+							},
+						},
+						{
+							DeviceInterface: "vrf-blue",
+							DeviceVRF: &v1alpha1.VRF{
+								VRFTable:      100,
+								VRFInterfaces: []string{"eth6"},
+							},
+						},
+						{
+							DeviceInterface: "macvlan0",
+							DeviceMACVLAN: &v1alpha1.MACVLAN{
+								MACVLANLink: "eth1",
+								MACVLANMode: "private",
+							},
+						},
 					},
 				},
 			},
@@ -314,6 +353,10 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 						"configuration/br0",
 						"configuration/dummy0",
 						"configuration/wireguard0",
+						"configuration/vxlan42",
+						"configuration/vrf-blue",
+						"configuration/eth6",
+						"configuration/macvlan0",
 					}, func(r *network.LinkSpec) error {
 						suite.Assert().Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
 
@@ -324,6 +367,18 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 
 							if r.TypedSpec().Name == "eth0" {
 								suite.Assert().EqualValues(0, r.TypedSpec().MTU)
+								suite.Assert().Equal(network.EthtoolSpec{
+									RXRingSize:       4096,
+									CombinedChannels: 8,
+									Features:         map[string]bool{"rx-gro": false},
+									Speed:            1000,
+									Duplex:           nethelpers.Full,
+								}, r.TypedSpec().Ethtool)
+								suite.Assert().Equal(network.RouterAdvertisementSpec{
+									Configured: true,
+									Accept:     true,
+									SLAAC:      false,
+								}, r.TypedSpec().RouterAdvertisement)
 							} else {
 								suite.Assert().EqualValues(9001, r.TypedSpec().MTU)
 							}
@@ -383,6 +438,38 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 									},
 								}, r.TypedSpec().Wireguard,
 							)
+						case "vxlan42":
+							suite.Assert().True(r.TypedSpec().Up)
+							suite.Assert().True(r.TypedSpec().Logical)
+							suite.Assert().Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+							suite.Assert().Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+							suite.Assert().Equal("eth1", r.TypedSpec().ParentName)
+							suite.Assert().Equal(
+								network.VXLANSpec{
+									VNI:    42,
+									Local:  netip.MustParseAddr("192.168.0.24"),
+									Remote: netip.MustParseAddr("239.1.1.1"),
+									Port:   4789,
+									TTL:    16,
+								}, r.TypedSpec().VXLAN,
+							)
+						case "vrf-blue":
+							suite.Assert().True(r.TypedSpec().Up)
+							suite.Assert().True(r.TypedSpec().Logical)
+							suite.Assert().Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+							suite.Assert().Equal(network.LinkKindVRF, r.TypedSpec().Kind)
+							suite.Assert().EqualValues(100, r.TypedSpec().VRFMaster.Table)
+						case "eth6":
+							suite.Assert().True(r.TypedSpec().Up)
+							suite.Assert().False(r.TypedSpec().Logical)
+							suite.Assert().Equal("vrf-blue", r.TypedSpec().VRFSlave.MasterName)
+						case "macvlan0":
+							suite.Assert().True(r.TypedSpec().Up)
+							suite.Assert().True(r.TypedSpec().Logical)
+							suite.Assert().Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+							suite.Assert().Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+							suite.Assert().Equal("eth1", r.TypedSpec().ParentName)
+							suite.Assert().Equal(nethelpers.MACVLANModePrivate, r.TypedSpec().MACVLAN.Mode)
 						}
 
 						return nil
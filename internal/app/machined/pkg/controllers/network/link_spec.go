@@ -6,13 +6,18 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 	"github.com/jsimonetti/rtnetlink"
+	ethtoolioctl "github.com/safchain/ethtool"
 	"github.com/siderolabs/gen/pair/ordered"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
@@ -21,6 +26,7 @@ import (
 
 	networkadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/internal/pkg/ethtool"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
@@ -80,6 +86,22 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		defer wgClient.Close() //nolint:errcheck
 	}
 
+	var ethClient ethtoolClient
+
+	ethClient.ioctl, err = ethtoolioctl.NewEthtool()
+	if err != nil {
+		logger.Warn("error dialing ethtool ioctl socket", zap.Error(err))
+	} else {
+		defer ethClient.ioctl.Close()
+	}
+
+	ethClient.rings, err = ethtool.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool ring ioctl socket", zap.Error(err))
+	} else {
+		defer ethClient.rings.Close() //nolint:errcheck
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -118,7 +140,7 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		for _, res := range list.Items {
 			link := res.(*network.LinkSpec) //nolint:forcetypeassert,errcheck
 
-			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, &links, link); err != nil {
+			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, &ethClient, &links, link); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 		}
@@ -173,7 +195,7 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 //
 // If the logical link kind or type got changed (for example, "link0" was a bond, and now it's wireguard interface), the link
 // is dropped and replaced with the new one.
-// Same replace flow is used for VLAN links, as VLAN settings can't be changed on the fly.
+// Same replace flow is used for VLAN, VXLAN, VRF and MACVLAN links, as their settings can't be changed on the fly.
 //
 // For bonded links, there are two sync steps applied:
 //
@@ -183,9 +205,14 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 //
 // For wireguard links, only settings are synced with the diff generated by the WireguardSpec.
 //
+// Ethtool settings (rings, channels, features, speed) are synced via ethtool ioctls, failures are logged, but
+// don't fail the link sync, as many drivers don't support some of the settings.
+//
+// Links enslaved to a bridge, bond or VRF get their master index synced as the last step.
+//
 //nolint:gocyclo,cyclop,dupl
 func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, wgClient *wgctrl.Client,
-	links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
+	ethClient *ethtoolClient, links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
 ) error {
 	logger = logger.With(zap.String("link", link.TypedSpec().Name))
 
@@ -255,6 +282,67 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			// sync VXLAN spec, as it can't be modified on the fly
+			if !replace && link.TypedSpec().Kind == network.LinkKindVXLAN {
+				var existingVXLAN network.VXLANSpec
+
+				if err := networkadapter.VXLANSpec(&existingVXLAN).Decode(existing.Attributes.Info.Data); err != nil {
+					return fmt.Errorf("error decoding VXLAN properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				expectedVXLAN := link.TypedSpec().VXLAN
+
+				// kernel picks default port if not set
+				if expectedVXLAN.Port == 0 {
+					expectedVXLAN.Port = existingVXLAN.Port
+				}
+
+				if existingVXLAN != expectedVXLAN {
+					logger.Info("replacing VXLAN link",
+						zap.String("old", fmt.Sprintf("%+v", existingVXLAN)),
+						zap.String("new", fmt.Sprintf("%+v", expectedVXLAN)),
+					)
+
+					replace = true
+				}
+			}
+
+			// sync VRF table, as it can't be modified on the fly
+			if !replace && link.TypedSpec().Kind == network.LinkKindVRF {
+				var existingVRF network.VRFMasterSpec
+
+				if err := networkadapter.VRFMasterSpec(&existingVRF).Decode(existing.Attributes.Info.Data); err != nil {
+					return fmt.Errorf("error decoding VRF properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				if existingVRF != link.TypedSpec().VRFMaster {
+					logger.Info("replacing VRF link",
+						zap.Stringer("old_table", existingVRF.Table),
+						zap.Stringer("new_table", link.TypedSpec().VRFMaster.Table),
+					)
+
+					replace = true
+				}
+			}
+
+			// sync MACVLAN spec, the link is re-created to change the mode
+			if !replace && link.TypedSpec().Kind == network.LinkKindMACVLAN {
+				var existingMACVLAN network.MACVLANSpec
+
+				if err := networkadapter.MACVLANSpec(&existingMACVLAN).Decode(existing.Attributes.Info.Data); err != nil {
+					return fmt.Errorf("error decoding MACVLAN properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				if existingMACVLAN != link.TypedSpec().MACVLAN {
+					logger.Info("replacing MACVLAN link",
+						zap.Stringer("old_mode", existingMACVLAN.Mode),
+						zap.Stringer("new_mode", link.TypedSpec().MACVLAN.Mode),
+					)
+
+					replace = true
+				}
+			}
+
 			if replace {
 				if err := conn.Link.Delete(existing.Index); err != nil {
 					return fmt.Errorf("error deleting link %q: %w", link.TypedSpec().Name, err)
@@ -279,7 +367,7 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				err         error
 			)
 
-			// VLAN settings should be set on interface creation (parent + VLAN settings)
+			// VLAN, VXLAN and MACVLAN settings should be set on interface creation (parent + link settings)
 			if link.TypedSpec().ParentName != "" {
 				parent := findLink(*links, link.TypedSpec().ParentName)
 				if parent == nil {
@@ -290,11 +378,30 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				parentIndex = parent.Index
 			}
 
-			if link.TypedSpec().Kind == network.LinkKindVLAN {
+			switch link.TypedSpec().Kind {
+			case network.LinkKindVLAN:
 				data, err = networkadapter.VLANSpec(&link.TypedSpec().VLAN).Encode()
 				if err != nil {
 					return fmt.Errorf("error encoding VLAN attributes for link %q: %w", link.TypedSpec().Name, err)
 				}
+			case network.LinkKindVXLAN:
+				// VXLAN link parent is passed via VXLAN attributes
+				data, err = networkadapter.VXLANSpec(&link.TypedSpec().VXLAN).Encode(parentIndex)
+				if err != nil {
+					return fmt.Errorf("error encoding VXLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+
+				parentIndex = 0
+			case network.LinkKindVRF:
+				data, err = networkadapter.VRFMasterSpec(&link.TypedSpec().VRFMaster).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding VRF attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			case network.LinkKindMACVLAN:
+				data, err = networkadapter.MACVLANSpec(&link.TypedSpec().MACVLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding MACVLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
 			}
 
 			if err = conn.Link.New(&rtnetlink.LinkMessage{
@@ -541,7 +648,19 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			logger.Info("changed MTU for the link", zap.Uint32("mtu", link.TypedSpec().MTU))
 		}
 
-		// sync master index (for links which are bridge or bond slaves)
+		// sync ethtool settings if they are set in the spec
+		if !link.TypedSpec().Ethtool.IsZero() {
+			ethClient.sync(logger, link.TypedSpec().Name, &link.TypedSpec().Ethtool)
+		}
+
+		// sync IPv6 router advertisement settings if they are set in the spec
+		if link.TypedSpec().RouterAdvertisement.Configured {
+			if err := syncRouterAdvertisement(logger, link.TypedSpec().Name, link.TypedSpec().RouterAdvertisement); err != nil {
+				logger.Warn("error syncing router advertisement settings", zap.Error(err))
+			}
+		}
+
+		// sync master index (for links which are bridge, bond or VRF slaves)
 		var masterIndex uint32
 
 		var masterName string
@@ -562,6 +681,14 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			}
 		}
 
+		vrfMasterName := link.TypedSpec().VRFSlave.MasterName
+		if vrfMasterName != "" {
+			if master := findLink(*links, vrfMasterName); master != nil {
+				masterName = vrfMasterName
+				masterIndex = master.Index
+			}
+		}
+
 		if (existing.Attributes.Master == nil && masterIndex != 0) || (existing.Attributes.Master != nil && *existing.Attributes.Master != masterIndex) {
 			if err := conn.Link.Set(&rtnetlink.LinkMessage{
 				Family: existing.Family,
@@ -583,3 +710,250 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 	return nil
 }
+
+// autonegDisable is AUTONEG_DISABLE from uapi/linux/ethtool.h.
+const autonegDisable = 0x00
+
+// ethtoolClient wraps ethtool ioctl handles to sync link settings.
+type ethtoolClient struct {
+	ioctl *ethtoolioctl.Ethtool
+	rings *ethtool.Handle
+}
+
+// syncRouterAdvertisement applies IPv6 RA settings via per-link sysctls.
+func syncRouterAdvertisement(logger *zap.Logger, linkName string, spec network.RouterAdvertisementSpec) error {
+	confPath := filepath.Join("/proc/sys/net/ipv6/conf", linkName)
+
+	if _, err := os.Stat(confPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// IPv6 is disabled
+			return nil
+		}
+
+		return err
+	}
+
+	// Talos enables forwarding by default, so RAs are accepted only with accept_ra=2
+	acceptRA, autoconf := "0", "0"
+
+	if spec.Accept {
+		acceptRA = "2"
+	}
+
+	if spec.SLAAC {
+		autoconf = "1"
+	}
+
+	for _, param := range []struct {
+		name  string
+		value string
+	}{
+		{"accept_ra", acceptRA},
+		{"autoconf", autoconf},
+	} {
+		path := filepath.Join(confPath, param.name)
+
+		current, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(string(current)) == param.value {
+			continue
+		}
+
+		if err = os.WriteFile(path, []byte(param.value), 0o644); err != nil {
+			return fmt.Errorf("error setting %q: %w", path, err)
+		}
+
+		logger.Info("updated router advertisement setting", zap.String("param", param.name), zap.String("value", param.value))
+	}
+
+	return nil
+}
+
+func (c *ethtoolClient) sync(logger *zap.Logger, linkName string, spec *network.EthtoolSpec) {
+	if c.rings != nil && (spec.RXRingSize != 0 || spec.TXRingSize != 0) {
+		if err := c.syncRings(logger, linkName, spec); err != nil {
+			logger.Warn("error syncing link ring sizes", zap.Error(err))
+		}
+	}
+
+	if c.ioctl == nil {
+		return
+	}
+
+	if spec.RXChannels != 0 || spec.TXChannels != 0 || spec.CombinedChannels != 0 {
+		if err := c.syncChannels(logger, linkName, spec); err != nil {
+			logger.Warn("error syncing link channels", zap.Error(err))
+		}
+	}
+
+	if len(spec.Features) > 0 {
+		if err := c.syncFeatures(logger, linkName, spec); err != nil {
+			logger.Warn("error syncing link features", zap.Error(err))
+		}
+	}
+
+	if spec.Speed != 0 {
+		if err := c.syncSpeed(logger, linkName, spec); err != nil {
+			logger.Warn("error syncing link speed", zap.Error(err))
+		}
+	}
+}
+
+func (c *ethtoolClient) syncRings(logger *zap.Logger, linkName string, spec *network.EthtoolSpec) error {
+	rings, err := c.rings.Rings(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting ring sizes: %w", err)
+	}
+
+	desired := rings
+
+	if spec.RXRingSize != 0 {
+		desired.RX = spec.RXRingSize
+	}
+
+	if spec.TXRingSize != 0 {
+		desired.TX = spec.TXRingSize
+	}
+
+	if desired == rings {
+		return nil
+	}
+
+	if err = c.rings.SetRings(linkName, desired); err != nil {
+		return fmt.Errorf("error setting ring sizes: %w", err)
+	}
+
+	logger.Info("changed link ring sizes", zap.Uint32("rx", desired.RX), zap.Uint32("tx", desired.TX))
+
+	return nil
+}
+
+func (c *ethtoolClient) syncChannels(logger *zap.Logger, linkName string, spec *network.EthtoolSpec) error {
+	channels, err := c.ioctl.GetChannels(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting channels: %w", err)
+	}
+
+	desired := channels
+
+	if spec.RXChannels != 0 {
+		desired.RxCount = spec.RXChannels
+	}
+
+	if spec.TXChannels != 0 {
+		desired.TxCount = spec.TXChannels
+	}
+
+	if spec.CombinedChannels != 0 {
+		desired.CombinedCount = spec.CombinedChannels
+	}
+
+	if desired == channels {
+		return nil
+	}
+
+	if _, err = c.ioctl.SetChannels(linkName, desired); err != nil {
+		return fmt.Errorf("error setting channels: %w", err)
+	}
+
+	logger.Info("changed link channels",
+		zap.Uint32("rx", desired.RxCount),
+		zap.Uint32("tx", desired.TxCount),
+		zap.Uint32("combined", desired.CombinedCount),
+	)
+
+	return nil
+}
+
+func (c *ethtoolClient) syncFeatures(logger *zap.Logger, linkName string, spec *network.EthtoolSpec) error {
+	features, err := c.ioctl.Features(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting features: %w", err)
+	}
+
+	changes := map[string]bool{}
+
+	for name, enabled := range spec.Features {
+		current, ok := features[name]
+		if !ok {
+			logger.Warn("skipping unknown link feature", zap.String("feature", name))
+
+			continue
+		}
+
+		if current != enabled {
+			changes[name] = enabled
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err = c.ioctl.Change(linkName, changes); err != nil {
+		return fmt.Errorf("error changing features: %w", err)
+	}
+
+	logger.Info("changed link features", zap.Any("features", changes))
+
+	return nil
+}
+
+func (c *ethtoolClient) syncSpeed(logger *zap.Logger, linkName string, spec *network.EthtoolSpec) error {
+	var cmd ethtoolioctl.EthtoolCmd
+
+	speed, err := c.ioctl.CmdGet(&cmd, linkName)
+	if err != nil {
+		return fmt.Errorf("error getting link settings: %w", err)
+	}
+
+	if speed == spec.Speed && cmd.Duplex == uint8(spec.Duplex) && cmd.Autoneg == autonegDisable {
+		return nil
+	}
+
+	cmd.Speed = uint16(spec.Speed & 0xffff)
+	cmd.Speed_hi = uint16(spec.Speed >> 16)
+	cmd.Duplex = uint8(spec.Duplex)
+	cmd.Autoneg = autonegDisable
+
+	if _, err = c.ioctl.CmdSet(&cmd, linkName); err != nil {
+		return fmt.Errorf("error setting link settings: %w", err)
+	}
+
+	logger.Info("forced link speed", zap.Uint32("speed", spec.Speed), zap.Stringer("duplex", spec.Duplex))
+
+	return nil
+}
+
+// status returns current ethtool settings of the link, settings not supported by the link are left zero.
+func (c *ethtoolClient) status(linkName string) network.EthtoolStatus {
+	var status network.EthtoolStatus
+
+	if c.rings != nil {
+		if rings, err := c.rings.Rings(linkName); err == nil {
+			status.RXRingSize = rings.RX
+			status.RXRingSizeMax = rings.RXMax
+			status.TXRingSize = rings.TX
+			status.TXRingSizeMax = rings.TXMax
+		}
+	}
+
+	if c.ioctl == nil {
+		return status
+	}
+
+	if channels, err := c.ioctl.GetChannels(linkName); err == nil {
+		status.RXChannels = channels.RxCount
+		status.TXChannels = channels.TxCount
+		status.CombinedChannels = channels.CombinedCount
+	}
+
+	if features, err := c.ioctl.Features(linkName); err == nil {
+		status.Features = features
+	}
+
+	return status
+}
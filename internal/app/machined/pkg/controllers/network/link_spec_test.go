@@ -345,6 +345,270 @@ func (suite *LinkSpecSuite) TestVLAN() {
 	)
 }
 
+func (suite *LinkSpecSuite) teardownLinks(resources ...resource.Resource) {
+	for _, r := range resources {
+		for {
+			ready, err := suite.state.Teardown(suite.ctx, r.Metadata())
+			suite.Require().NoError(err)
+
+			if ready {
+				break
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+}
+
+func (suite *LinkSpecSuite) TestVXLAN() {
+	dummyInterface := suite.uniqueDummyInterface()
+
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:        dummyInterface,
+		Type:        nethelpers.LinkEther,
+		Kind:        "dummy",
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	vxlanName := suite.uniqueDummyInterface()
+	vxlan := network.NewLinkSpec(network.NamespaceName, vxlanName)
+	*vxlan.TypedSpec() = network.LinkSpecSpec{
+		Name:        vxlanName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindVXLAN,
+		Up:          true,
+		Logical:     true,
+		ParentName:  dummyInterface,
+		ConfigLayer: network.ConfigDefault,
+		VXLAN: network.VXLANSpec{
+			VNI:    42,
+			Remote: netip.MustParseAddr("239.1.1.1"),
+			Port:   4789,
+		},
+	}
+
+	for _, res := range []resource.Resource{dummy, vxlan} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vxlanName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+						suite.Assert().EqualValues(42, r.TypedSpec().VXLAN.VNI)
+						suite.Assert().Equal(netip.MustParseAddr("239.1.1.1"), r.TypedSpec().VXLAN.Remote)
+						suite.Assert().EqualValues(4789, r.TypedSpec().VXLAN.Port)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// attempt to change VNI
+	_, err := suite.state.UpdateWithConflicts(
+		suite.ctx, vxlan.Metadata(), func(r resource.Resource) error {
+			r.(*network.LinkSpec).TypedSpec().VXLAN.VNI = 4242
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vxlanName}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().VXLAN.VNI != 4242 {
+							return retry.ExpectedErrorf("VNI is not 4242: %d", r.TypedSpec().VXLAN.VNI)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.teardownLinks(vxlan, dummy)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(vxlanName)
+			},
+		),
+	)
+}
+
+func (suite *LinkSpecSuite) TestMACVLAN() {
+	dummyInterface := suite.uniqueDummyInterface()
+
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:        dummyInterface,
+		Type:        nethelpers.LinkEther,
+		Kind:        "dummy",
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	macvlanName := suite.uniqueDummyInterface()
+	macvlan := network.NewLinkSpec(network.NamespaceName, macvlanName)
+	*macvlan.TypedSpec() = network.LinkSpecSpec{
+		Name:        macvlanName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindMACVLAN,
+		Up:          true,
+		Logical:     true,
+		ParentName:  dummyInterface,
+		ConfigLayer: network.ConfigDefault,
+		MACVLAN: network.MACVLANSpec{
+			Mode: nethelpers.MACVLANModeBridge,
+		},
+	}
+
+	for _, res := range []resource.Resource{dummy, macvlan} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{macvlanName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+						suite.Assert().Equal(nethelpers.MACVLANModeBridge, r.TypedSpec().MACVLAN.Mode)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// attempt to change the mode
+	_, err := suite.state.UpdateWithConflicts(
+		suite.ctx, macvlan.Metadata(), func(r resource.Resource) error {
+			r.(*network.LinkSpec).TypedSpec().MACVLAN.Mode = nethelpers.MACVLANModePrivate
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{macvlanName}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().MACVLAN.Mode != nethelpers.MACVLANModePrivate {
+							return retry.ExpectedErrorf("mode is not private: %s", r.TypedSpec().MACVLAN.Mode)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.teardownLinks(macvlan, dummy)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(macvlanName)
+			},
+		),
+	)
+}
+
+func (suite *LinkSpecSuite) TestVRF() {
+	vrfName := suite.uniqueDummyInterface()
+	vrf := network.NewLinkSpec(network.NamespaceName, vrfName)
+	*vrf.TypedSpec() = network.LinkSpecSpec{
+		Name:        vrfName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindVRF,
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+		VRFMaster: network.VRFMasterSpec{
+			Table: nethelpers.RoutingTable(1000),
+		},
+	}
+
+	dummyInterface := suite.uniqueDummyInterface()
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:        dummyInterface,
+		Type:        nethelpers.LinkEther,
+		Kind:        "dummy",
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+	}
+	netctrl.SetVRFSlave(dummy.TypedSpec(), vrfName)
+
+	for _, res := range []resource.Resource{vrf, dummy} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	var vrfIndex uint32
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vrfName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindVRF, r.TypedSpec().Kind)
+						suite.Assert().EqualValues(1000, r.TypedSpec().VRFMaster.Table)
+
+						vrfIndex = r.TypedSpec().Index
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{dummyInterface}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().MasterIndex != vrfIndex {
+							return retry.ExpectedErrorf("master index is not %d: %d", vrfIndex, r.TypedSpec().MasterIndex)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.teardownLinks(dummy, vrf)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(vrfName)
+			},
+		),
+	)
+}
+
 //nolint:gocyclo
 func (suite *LinkSpecSuite) TestBond() {
 	bondName := suite.uniqueDummyInterface()
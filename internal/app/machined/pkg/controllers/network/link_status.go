@@ -23,6 +23,7 @@ import (
 
 	networkadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	ethtoolrings "github.com/talos-systems/talos/internal/pkg/ethtool"
 	"github.com/talos-systems/talos/internal/pkg/pci"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
@@ -99,6 +100,13 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 		defer ethIoctlClient.Close() //nolint:errcheck
 	}
 
+	ethRingsClient, err := ethtoolrings.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool ring ioctl socket", zap.Error(err))
+	} else {
+		defer ethRingsClient.Close() //nolint:errcheck
+	}
+
 	wgClient, err := wgctrl.New()
 	if err != nil {
 		logger.Warn("error creating wireguard client", zap.Error(err))
@@ -113,7 +121,7 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 		case <-r.EventCh():
 		}
 
-		if err = ctrl.reconcile(ctx, r, logger, conn, ethClient, ethIoctlClient, wgClient); err != nil {
+		if err = ctrl.reconcile(ctx, r, logger, conn, ethClient, &ethtoolClient{ioctl: ethIoctlClient, rings: ethRingsClient}, wgClient); err != nil {
 			return err
 		}
 	}
@@ -128,7 +136,7 @@ func (ctrl *LinkStatusController) reconcile(
 	logger *zap.Logger,
 	conn *rtnetlink.Conn,
 	ethClient *ethtool.Client,
-	ethtoolIoctlClient *ethtoolioctl.Ethtool,
+	ethtoolIoctlClient *ethtoolClient,
 	wgClient *wgctrl.Client,
 ) error {
 	// list the existing LinkStatus resources and mark them all to be deleted, as the actual link is discovered via netlink, resource ID is removed from the list
@@ -190,12 +198,12 @@ func (ctrl *LinkStatusController) reconcile(
 			}
 		}
 
-		if ethtoolIoctlClient != nil {
-			driverInfo, _ = ethtoolIoctlClient.DriverInfo(link.Attributes.Name) //nolint:errcheck
+		if ethtoolIoctlClient.ioctl != nil {
+			driverInfo, _ = ethtoolIoctlClient.ioctl.DriverInfo(link.Attributes.Name) //nolint:errcheck
 
 			var permAddr string
 
-			permAddr, err = ethtoolIoctlClient.PermAddr(link.Attributes.Name)
+			permAddr, err = ethtoolIoctlClient.ioctl.PermAddr(link.Attributes.Name)
 			if err == nil && permAddr != "" {
 				permanentAddr, _ = net.ParseMAC(permAddr) //nolint:errcheck
 			}
@@ -288,6 +296,8 @@ func (ctrl *LinkStatusController) reconcile(
 			status.DriverVersion = driverInfo.Version
 			status.FirmwareVersion = driverInfo.FwVersion
 
+			status.Ethtool = ethtoolIoctlClient.status(link.Attributes.Name)
+
 			switch status.Kind {
 			case network.LinkKindVLAN:
 				if err = networkadapter.VLANSpec(&status.VLAN).Decode(link.Attributes.Info.Data); err != nil {
@@ -301,6 +311,18 @@ func (ctrl *LinkStatusController) reconcile(
 				if err = networkadapter.BridgeMasterSpec(&status.BridgeMaster).Decode(link.Attributes.Info.Data); err != nil {
 					logger.Warn("failure decoding bridge attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
 				}
+			case network.LinkKindVXLAN:
+				if err = networkadapter.VXLANSpec(&status.VXLAN).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding VXLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindVRF:
+				if err = networkadapter.VRFMasterSpec(&status.VRFMaster).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding VRF attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindMACVLAN:
+				if err = networkadapter.MACVLANSpec(&status.MACVLAN).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding MACVLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
 			case network.LinkKindWireguard:
 				if wgClient == nil {
 					return fmt.Errorf("wireguard client not available, but wireguard interface was discovered: %q", link.Attributes.Name)
@@ -126,6 +126,13 @@ func SetBridgeSlave(link *network.LinkSpecSpec, bridge string) {
 	}
 }
 
+// SetVRFSlave sets the VRF slave spec.
+func SetVRFSlave(link *network.LinkSpecSpec, vrf string) {
+	link.VRFSlave = network.VRFSlave{
+		MasterName: vrf,
+	}
+}
+
 // SetBridgeMaster sets the bridge master spec.
 //
 //nolint:gocyclo
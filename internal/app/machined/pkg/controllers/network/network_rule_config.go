@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// NetworkRuleConfigController manages network.NetworkRuleSpec and network.NetworkDefaultActionSpec based on machine configuration.
+type NetworkRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NetworkRuleConfigController) Name() string {
+	return "network.NetworkRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NetworkRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NetworkRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NetworkRuleSpecType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.NetworkDefaultActionSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NetworkRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil && cfg.(*config.MachineConfig).Config().NetworkRules().Enabled() {
+			c := cfg.(*config.MachineConfig).Config()
+
+			if err = r.Modify(ctx, network.NewNetworkDefaultActionSpec(network.NamespaceName, network.NetworkDefaultActionID), func(res resource.Resource) error {
+				res.(*network.NetworkDefaultActionSpec).TypedSpec().Ingress = c.NetworkRules().DefaultAction()
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying default action spec: %w", err)
+			}
+
+			touchedIDs[network.NetworkDefaultActionID] = struct{}{}
+
+			rules := map[string]network.NetworkRuleSpecSpec{}
+
+			if c.NetworkRules().DefaultAction() == nethelpers.DefaultActionBlock {
+				for _, rule := range defaultNetworkRules(c) {
+					rules[rule.Name] = rule
+				}
+			}
+
+			// rules from the machine configuration override default rules with the same name
+			for _, rule := range c.NetworkRules().Rules() {
+				rules[rule.Name()] = network.NetworkRuleSpecSpec{
+					Name: rule.Name(),
+					PortSelector: network.RulePortSelector{
+						Ports:    slices.Map(rule.PortRanges(), func(r [2]uint16) network.PortRange { return network.PortRange{Lo: r[0], Hi: r[1]} }),
+						Protocol: rule.Protocol(),
+					},
+					Ingress: slices.Map(rule.Ingress(), func(ingress talosconfig.NetworkRuleIngress) network.IngressRule {
+						return network.IngressRule{
+							Subnet: ingress.Subnet(),
+							Except: ingress.Except(),
+						}
+					}),
+				}
+			}
+
+			for id, rule := range rules {
+				rule := rule
+
+				if err = r.Modify(ctx, network.NewNetworkRuleSpec(network.NamespaceName, id), func(res resource.Resource) error {
+					*res.(*network.NetworkRuleSpec).TypedSpec() = rule
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error modifying network rule spec: %w", err)
+				}
+
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		for _, resourceType := range []resource.Type{network.NetworkRuleSpecType, network.NetworkDefaultActionSpecType} {
+			list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, resourceType, "", resource.VersionUndefined))
+			if err != nil {
+				return fmt.Errorf("error listing resources: %w", err)
+			}
+
+			for _, res := range list.Items {
+				if res.Metadata().Owner() != ctrl.Name() {
+					continue
+				}
+
+				if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+					if err = r.Destroy(ctx, res.Metadata()); err != nil {
+						return fmt.Errorf("error cleaning up specs: %w", err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// defaultNetworkRules returns the rules which keep the cluster operational with the default block action.
+//
+// Rules are accepting traffic from any source, and they can be overridden in the machine configuration by
+// the rules with the same name.
+func defaultNetworkRules(c talosconfig.Provider) []network.NetworkRuleSpecSpec {
+	anySource := []network.IngressRule{
+		{Subnet: netip.MustParsePrefix("0.0.0.0/0")},
+		{Subnet: netip.MustParsePrefix("::/0")},
+	}
+
+	rule := func(name string, protocol nethelpers.Protocol, lo, hi uint16) network.NetworkRuleSpecSpec {
+		return network.NetworkRuleSpecSpec{
+			Name: name,
+			PortSelector: network.RulePortSelector{
+				Ports:    []network.PortRange{{Lo: lo, Hi: hi}},
+				Protocol: protocol,
+			},
+			Ingress: anySource,
+		}
+	}
+
+	rules := []network.NetworkRuleSpecSpec{
+		rule("kubelet", nethelpers.ProtocolTCP, constants.KubeletPort, constants.KubeletPort),
+	}
+
+	if c.Machine().Type().IsControlPlane() {
+		apiServerPort := uint16(c.Cluster().LocalAPIServerPort())
+
+		rules = append(rules,
+			rule("trustd", nethelpers.ProtocolTCP, constants.TrustdPort, constants.TrustdPort),
+			rule("kubernetes-api", nethelpers.ProtocolTCP, apiServerPort, apiServerPort),
+			rule("etcd", nethelpers.ProtocolTCP, constants.EtcdClientPort, constants.EtcdPeerPort),
+		)
+	}
+
+	if c.Cluster().Network().CNI().Name() == constants.FlannelCNI {
+		rules = append(rules, rule("flannel-vxlan", nethelpers.ProtocolUDP, constants.FlannelVXLANPort, constants.FlannelVXLANPort))
+	}
+
+	if c.Machine().Network().KubeSpan().Enabled() {
+		rules = append(rules, rule("kubespan", nethelpers.ProtocolUDP, constants.KubeSpanDefaultPort, constants.KubeSpanDefaultPort))
+	}
+
+	return rules
+}
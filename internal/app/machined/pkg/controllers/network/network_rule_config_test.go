@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	networkcfg "github.com/talos-systems/talos/pkg/machinery/config/types/network"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type NetworkRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NetworkRuleConfigSuite) machineConfig(machineType machine.Type, documents ...talosconfig.Document) *config.MachineConfig {
+	return config.NewMachineConfig(v1alpha1.WrapReadonly(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: machineType.String(),
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	}, nil, documents...))
+}
+
+func (suite *NetworkRuleConfigSuite) assertRules(expected map[resource.ID]network.NetworkRuleSpecSpec) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		list, err := safe.StateList[*network.NetworkRuleSpec](suite.Ctx(), suite.State(), resource.NewMetadata(network.NamespaceName, network.NetworkRuleSpecType, "", resource.VersionUndefined))
+		require.NoError(err)
+
+		actual := map[resource.ID]network.NetworkRuleSpecSpec{}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			actual[iter.Value().Metadata().ID()] = *iter.Value().TypedSpec()
+		}
+
+		assert.Equal(expected, actual)
+	}))
+}
+
+func (suite *NetworkRuleConfigSuite) assertDefaultAction(expected *nethelpers.DefaultAction) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		list, err := safe.StateList[*network.NetworkDefaultActionSpec](suite.Ctx(), suite.State(), resource.NewMetadata(network.NamespaceName, network.NetworkDefaultActionSpecType, "", resource.VersionUndefined))
+		require.NoError(err)
+
+		if expected == nil {
+			assert.Equal(0, list.Len())
+
+			return
+		}
+
+		if !assert.Equal(1, list.Len()) {
+			return
+		}
+
+		assert.Equal(*expected, list.Get(0).TypedSpec().Ingress)
+	}))
+}
+
+func anySourceRule(name string, protocol nethelpers.Protocol, lo, hi uint16) network.NetworkRuleSpecSpec {
+	return network.NetworkRuleSpecSpec{
+		Name: name,
+		PortSelector: network.RulePortSelector{
+			Ports:    []network.PortRange{{Lo: lo, Hi: hi}},
+			Protocol: protocol,
+		},
+		Ingress: []network.IngressRule{
+			{Subnet: netip.MustParsePrefix("0.0.0.0/0")},
+			{Subnet: netip.MustParsePrefix("::/0")},
+		},
+	}
+}
+
+func (suite *NetworkRuleConfigSuite) TestNoFirewall() {
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), suite.machineConfig(machine.TypeControlPlane)))
+
+	suite.assertDefaultAction(nil)
+	suite.assertRules(map[resource.ID]network.NetworkRuleSpecSpec{})
+}
+
+func (suite *NetworkRuleConfigSuite) TestReconcile() {
+	etcdRule := networkcfg.NewRuleConfigV1Alpha1()
+	etcdRule.RuleName = "etcd"
+	etcdRule.RulePortSelector.Protocol = nethelpers.ProtocolTCP
+	etcdRule.RulePortSelector.Ports = []networkcfg.PortRange{{2379, 2380}}
+	etcdRule.RuleIngress = []networkcfg.IngressRule{
+		{
+			IngressSubnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/24")},
+			IngressExcept: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.13/32")},
+		},
+	}
+
+	expectedEtcdRule := network.NetworkRuleSpecSpec{
+		Name: "etcd",
+		PortSelector: network.RulePortSelector{
+			Ports:    []network.PortRange{{Lo: 2379, Hi: 2380}},
+			Protocol: nethelpers.ProtocolTCP,
+		},
+		Ingress: []network.IngressRule{
+			{
+				Subnet: netip.MustParsePrefix("10.0.0.0/24"),
+				Except: netip.MustParsePrefix("10.0.0.13/32"),
+			},
+		},
+	}
+
+	// default action accept: only explicitly configured rules
+	cfg := suite.machineConfig(machine.TypeControlPlane, etcdRule)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.assertDefaultAction(pointer.To(nethelpers.DefaultActionAccept))
+	suite.assertRules(map[resource.ID]network.NetworkRuleSpecSpec{
+		"etcd": expectedEtcdRule,
+	})
+
+	// default action block: default rules for the control plane, etcd rule overrides the default one
+	blockAction := networkcfg.NewDefaultActionConfigV1Alpha1()
+	blockAction.Ingress = nethelpers.DefaultActionBlock
+
+	newCfg := suite.machineConfig(machine.TypeControlPlane, blockAction, etcdRule)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertDefaultAction(pointer.To(nethelpers.DefaultActionBlock))
+	suite.assertRules(map[resource.ID]network.NetworkRuleSpecSpec{
+		"etcd":           expectedEtcdRule,
+		"flannel-vxlan":  anySourceRule("flannel-vxlan", nethelpers.ProtocolUDP, 4789, 4789),
+		"kubelet":        anySourceRule("kubelet", nethelpers.ProtocolTCP, 10250, 10250),
+		"kubernetes-api": anySourceRule("kubernetes-api", nethelpers.ProtocolTCP, 6443, 6443),
+		"trustd":         anySourceRule("trustd", nethelpers.ProtocolTCP, 50001, 50001),
+	})
+
+	// worker doesn't get control plane rules
+	cfg = newCfg
+	newCfg = suite.machineConfig(machine.TypeWorker, blockAction)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertDefaultAction(pointer.To(nethelpers.DefaultActionBlock))
+	suite.assertRules(map[resource.ID]network.NetworkRuleSpecSpec{
+		"flannel-vxlan": anySourceRule("flannel-vxlan", nethelpers.ProtocolUDP, 4789, 4789),
+		"kubelet":       anySourceRule("kubelet", nethelpers.ProtocolTCP, 10250, 10250),
+	})
+
+	// firewall config removed
+	cfg = newCfg
+	newCfg = suite.machineConfig(machine.TypeWorker)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertDefaultAction(nil)
+	suite.assertRules(map[resource.ID]network.NetworkRuleSpecSpec{})
+}
+
+func TestNetworkRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &NetworkRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.NetworkRuleConfigController{}))
+			},
+		},
+	})
+}
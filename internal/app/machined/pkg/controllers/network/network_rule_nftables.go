@@ -0,0 +1,340 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// NfTablesManager manages host firewall nftables ruleset outside of controllers/resources scope.
+type NfTablesManager interface {
+	Update(defaultAction nethelpers.DefaultAction, rules []network.NetworkRuleSpecSpec, hostDNS netip.AddrPort) error
+	Cleanup() error
+}
+
+// NewNfTablesManager initializes NfTablesManager.
+func NewNfTablesManager() NfTablesManager {
+	return &nfTablesManager{
+		nfTable: &nftables.Table{
+			Family: nftables.TableFamilyINet,
+			Name:   "talos_firewall",
+		},
+	}
+}
+
+type nfTablesManager struct {
+	// nfTable is a handle for the host firewall root table
+	nfTable *nftables.Table
+
+	applied        bool
+	appliedAction  nethelpers.DefaultAction
+	appliedRuleset []network.NetworkRuleSpecSpec
+	appliedHostDNS netip.AddrPort
+}
+
+// Update replaces the host firewall ruleset atomically.
+//
+// If hostDNS is valid, DNS traffic to the host DNS listen address is accepted.
+func (m *nfTablesManager) Update(defaultAction nethelpers.DefaultAction, rules []network.NetworkRuleSpecSpec, hostDNS netip.AddrPort) error {
+	if m.applied && m.appliedAction == defaultAction && m.appliedHostDNS == hostDNS && reflect.DeepEqual(m.appliedRuleset, rules) {
+		return nil
+	}
+
+	c := &nftables.Conn{}
+
+	// NB: all messages are sent as a single netlink batch, so the kernel applies the ruleset atomically:
+	// the table is created (if missing) to make the deletion succeed, then it is deleted and re-created from scratch.
+	c.AddTable(m.nfTable)
+	c.DelTable(m.nfTable)
+	c.AddTable(m.nfTable)
+
+	policy := nftables.ChainPolicyAccept
+
+	if defaultAction == nethelpers.DefaultActionBlock {
+		policy = nftables.ChainPolicyDrop
+	}
+
+	chain := c.AddChain(&nftables.Chain{
+		Name:     "ingress",
+		Table:    m.nfTable,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookInput,
+		Priority: nftables.ChainPriorityFilter,
+		Policy:   &policy,
+	})
+
+	for _, exprs := range buildRuleset(rules, hostDNS) {
+		c.AddRule(&nftables.Rule{
+			Table: m.nfTable,
+			Chain: chain,
+			Exprs: exprs,
+		})
+	}
+
+	if err := c.Flush(); err != nil {
+		return fmt.Errorf("failed to apply host firewall ruleset: %w", err)
+	}
+
+	m.applied = true
+	m.appliedAction = defaultAction
+	m.appliedRuleset = rules
+	m.appliedHostDNS = hostDNS
+
+	return nil
+}
+
+// Cleanup removes the host firewall ruleset.
+func (m *nfTablesManager) Cleanup() error {
+	c := &nftables.Conn{}
+
+	c.AddTable(m.nfTable)
+	c.DelTable(m.nfTable)
+
+	if err := c.Flush(); err != nil {
+		return fmt.Errorf("failed to cleanup host firewall ruleset: %w", err)
+	}
+
+	m.applied = false
+	m.appliedRuleset = nil
+	m.appliedHostDNS = netip.AddrPort{}
+
+	return nil
+}
+
+// buildRuleset builds the list of nftables rules for the ingress chain.
+//
+// The chain always accepts loopback, established, ICMP, DHCP client and Talos API traffic, so that
+// the node can't be locked out with the firewall configuration.
+//
+// If the host DNS is enabled, the chain accepts DNS queries to the host DNS listen address, as the
+// pods (e.g. CoreDNS) forward queries to it from the non-loopback interfaces.
+//
+// Each network rule accepts traffic to the selected ports from the listed subnets and drops the rest of the
+// traffic to the selected ports.
+func buildRuleset(rules []network.NetworkRuleSpecSpec, hostDNS netip.AddrPort) [][]expr.Any {
+	ruleset := [][]expr.Any{
+		// iifname lo accept
+		append(matchIfName("lo"), accept()),
+		// ct state established,related accept
+		append(matchCtState(expr.CtStateBitESTABLISHED|expr.CtStateBitRELATED), accept()),
+		// ct state invalid drop
+		append(matchCtState(expr.CtStateBitINVALID), drop()),
+		// meta l4proto icmp accept
+		append(matchL4Proto(nethelpers.ProtocolICMP), accept()),
+		// meta l4proto icmpv6 accept
+		append(matchL4Proto(nethelpers.ProtocolICMPv6), accept()),
+		// udp dport 68 accept (DHCPv4 client)
+		append(matchPorts(nethelpers.ProtocolUDP, network.PortRange{Lo: 68, Hi: 68}), accept()),
+		// udp dport 546 accept (DHCPv6 client)
+		append(matchPorts(nethelpers.ProtocolUDP, network.PortRange{Lo: 546, Hi: 546}), accept()),
+		// tcp dport 50000 accept (Talos API)
+		append(matchPorts(nethelpers.ProtocolTCP, network.PortRange{Lo: constants.ApidPort, Hi: constants.ApidPort}), accept()),
+	}
+
+	if hostDNS.IsValid() {
+		dnsPort := network.PortRange{Lo: hostDNS.Port(), Hi: hostDNS.Port()}
+		dnsAddr := netip.PrefixFrom(hostDNS.Addr(), hostDNS.Addr().BitLen())
+
+		for _, protocol := range []nethelpers.Protocol{nethelpers.ProtocolUDP, nethelpers.ProtocolTCP} {
+			// ip daddr 169.254.116.108 udp/tcp dport 53 accept (host DNS)
+			ruleset = append(ruleset, append(append(matchPorts(protocol, dnsPort), matchPrefix(dnsAddr, expr.CmpOpEq, false)...), accept()))
+		}
+	}
+
+	for _, rule := range rules {
+		for _, portRange := range rule.PortSelector.Ports {
+			for _, ingress := range rule.Ingress {
+				exprs := matchPorts(rule.PortSelector.Protocol, portRange)
+				exprs = append(exprs, matchPrefix(ingress.Subnet, expr.CmpOpEq, true)...)
+
+				if ingress.Except.IsValid() {
+					exprs = append(exprs, matchPrefix(ingress.Except, expr.CmpOpNeq, true)...)
+				}
+
+				ruleset = append(ruleset, append(exprs, accept()))
+			}
+
+			ruleset = append(ruleset, append(matchPorts(rule.PortSelector.Protocol, portRange), drop()))
+		}
+	}
+
+	return ruleset
+}
+
+func accept() expr.Any {
+	return &expr.Verdict{
+		Kind: expr.VerdictAccept,
+	}
+}
+
+func drop() expr.Any {
+	return &expr.Verdict{
+		Kind: expr.VerdictDrop,
+	}
+}
+
+func matchIfName(name string) []expr.Any {
+	ifname := make([]byte, unix.IFNAMSIZ)
+	copy(ifname, name)
+
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyIIFNAME,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     ifname,
+		},
+	}
+}
+
+func matchCtState(mask uint32) []expr.Any {
+	return []expr.Any{
+		&expr.Ct{
+			Key:      expr.CtKeySTATE,
+			Register: 1,
+		},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(mask),
+			Xor:            binaryutil.NativeEndian.PutUint32(0),
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpNeq,
+			Register: 1,
+			Data:     binaryutil.NativeEndian.PutUint32(0),
+		},
+	}
+}
+
+func matchL4Proto(protocol nethelpers.Protocol) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyL4PROTO,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{byte(protocol)},
+		},
+	}
+}
+
+func matchPorts(protocol nethelpers.Protocol, portRange network.PortRange) []expr.Any {
+	exprs := matchL4Proto(protocol)
+
+	// load destination port
+	exprs = append(exprs, &expr.Payload{
+		DestRegister: 1,
+		Base:         expr.PayloadBaseTransportHeader,
+		Offset:       2,
+		Len:          2,
+	})
+
+	if portRange.Lo == portRange.Hi {
+		return append(exprs, &expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     binaryutil.BigEndian.PutUint16(portRange.Lo),
+		})
+	}
+
+	return append(exprs, &expr.Range{
+		Op:       expr.CmpOpEq,
+		Register: 1,
+		FromData: binaryutil.BigEndian.PutUint16(portRange.Lo),
+		ToData:   binaryutil.BigEndian.PutUint16(portRange.Hi),
+	})
+}
+
+// matchPrefix matches the source (or destination) address of the packet against the prefix.
+func matchPrefix(prefix netip.Prefix, op expr.CmpOp, source bool) []expr.Any {
+	var (
+		nfproto byte
+		offset  uint32
+	)
+
+	switch {
+	case prefix.Addr().Is4() && source:
+		nfproto, offset = unix.NFPROTO_IPV4, 12
+	case prefix.Addr().Is4():
+		nfproto, offset = unix.NFPROTO_IPV4, 16
+	case source:
+		nfproto, offset = unix.NFPROTO_IPV6, 8
+	default:
+		nfproto, offset = unix.NFPROTO_IPV6, 24
+	}
+
+	addrLen := uint32(prefix.Addr().BitLen() / 8)
+
+	exprs := []expr.Any{
+		&expr.Meta{
+			Key:      expr.MetaKeyNFPROTO,
+			Register: 1,
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{nfproto},
+		},
+	}
+
+	if prefix.Bits() == 0 {
+		if op == expr.CmpOpNeq {
+			// excluding the whole address space never matches
+			return append(exprs, &expr.Cmp{
+				Op:       expr.CmpOpNeq,
+				Register: 1,
+				Data:     []byte{nfproto},
+			})
+		}
+
+		return exprs
+	}
+
+	mask := make([]byte, addrLen)
+
+	for i := 0; i < prefix.Bits(); i++ {
+		mask[i/8] |= 0x80 >> (i % 8)
+	}
+
+	return append(exprs,
+		// load source (destination) address
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          addrLen,
+		},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            addrLen,
+			Mask:           mask,
+			Xor:            make([]byte, addrLen),
+		},
+		&expr.Cmp{
+			Op:       op,
+			Register: 1,
+			Data:     prefix.Masked().Addr().AsSlice(),
+		},
+	)
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// NetworkRuleSpecController applies network.NetworkRuleSpec and network.NetworkDefaultActionSpec as nftables ruleset.
+type NetworkRuleSpecController struct {
+	// NfTablesManager allows mocking nftables in tests.
+	NfTablesManager NfTablesManager
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NetworkRuleSpecController) Name() string {
+	return "network.NetworkRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NetworkRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NetworkRuleSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NetworkDefaultActionSpecType,
+			ID:        pointer.To(network.NetworkDefaultActionID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NetworkRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NetworkRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.NfTablesManager == nil {
+		ctrl.NfTablesManager = NewNfTablesManager()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		defaultAction, err := safe.ReaderGet[*network.NetworkDefaultActionSpec](ctx, r, network.NewNetworkDefaultActionSpec(network.NamespaceName, network.NetworkDefaultActionID).Metadata())
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting default action: %w", err)
+			}
+
+			// firewall is not configured
+			if err = ctrl.NfTablesManager.Cleanup(); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		list, err := safe.ReaderList[*network.NetworkRuleSpec](ctx, r, resource.NewMetadata(network.NamespaceName, network.NetworkRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing network rules: %w", err)
+		}
+
+		rules := make([]network.NetworkRuleSpecSpec, 0, list.Len())
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			rules = append(rules, *iter.Value().TypedSpec())
+		}
+
+		var hostDNS netip.AddrPort
+
+		hostDNSConfig, err := safe.ReaderGet[*network.HostDNSConfig](ctx, r, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID).Metadata())
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else if hostDNSConfig.TypedSpec().Enabled {
+			hostDNS = hostDNSConfig.TypedSpec().ListenAddress
+		}
+
+		if err = ctrl.NfTablesManager.Update(defaultAction.TypedSpec().Ingress, rules, hostDNS); err != nil {
+			return err
+		}
+
+		logger.Debug("applied host firewall ruleset", zap.Stringer("default_action", defaultAction.TypedSpec().Ingress), zap.Int("rules", len(rules)))
+	}
+}
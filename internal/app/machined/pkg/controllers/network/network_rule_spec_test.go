@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type mockNfTablesManager struct {
+	mu sync.Mutex
+
+	enabled       bool
+	defaultAction nethelpers.DefaultAction
+	rules         []network.NetworkRuleSpecSpec
+	hostDNS       netip.AddrPort
+}
+
+func (m *mockNfTablesManager) Update(defaultAction nethelpers.DefaultAction, rules []network.NetworkRuleSpecSpec, hostDNS netip.AddrPort) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.enabled = true
+	m.defaultAction = defaultAction
+	m.rules = rules
+	m.hostDNS = hostDNS
+
+	return nil
+}
+
+func (m *mockNfTablesManager) Cleanup() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.enabled = false
+	m.rules = nil
+	m.hostDNS = netip.AddrPort{}
+
+	return nil
+}
+
+func (m *mockNfTablesManager) State() (bool, nethelpers.DefaultAction, []network.NetworkRuleSpecSpec) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.enabled, m.defaultAction, m.rules
+}
+
+func (m *mockNfTablesManager) HostDNS() netip.AddrPort {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.hostDNS
+}
+
+type NetworkRuleSpecSuite struct {
+	ctest.DefaultSuite
+
+	nfTables *mockNfTablesManager
+}
+
+func (suite *NetworkRuleSpecSuite) assertState(enabled bool, defaultAction nethelpers.DefaultAction, ruleNames []string) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		actualEnabled, actualAction, actualRules := suite.nfTables.State()
+
+		assert.Equal(enabled, actualEnabled)
+
+		if !enabled {
+			return
+		}
+
+		assert.Equal(defaultAction, actualAction)
+
+		names := make([]string, 0, len(actualRules))
+
+		for _, rule := range actualRules {
+			names = append(names, rule.Name)
+		}
+
+		assert.Equal(ruleNames, names)
+	}))
+}
+
+func (suite *NetworkRuleSpecSuite) assertHostDNS(expected netip.AddrPort) {
+	suite.AssertWithin(3*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		assert.Equal(expected, suite.nfTables.HostDNS())
+	}))
+}
+
+func (suite *NetworkRuleSpecSuite) TestReconcile() {
+	suite.assertState(false, 0, nil)
+
+	for _, name := range []string{"kubelet", "etcd"} {
+		rule := network.NewNetworkRuleSpec(network.NamespaceName, name)
+		*rule.TypedSpec() = anySourceRule(name, nethelpers.ProtocolTCP, 10250, 10250)
+
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), rule))
+	}
+
+	// rules are not applied until the default action is set
+	suite.assertState(false, 0, nil)
+
+	defaultAction := network.NewNetworkDefaultActionSpec(network.NamespaceName, network.NetworkDefaultActionID)
+	defaultAction.TypedSpec().Ingress = nethelpers.DefaultActionBlock
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), defaultAction))
+
+	suite.assertState(true, nethelpers.DefaultActionBlock, []string{"etcd", "kubelet"})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), network.NewNetworkRuleSpec(network.NamespaceName, "etcd").Metadata()))
+
+	suite.assertState(true, nethelpers.DefaultActionBlock, []string{"kubelet"})
+
+	// host DNS traffic is accepted only when host DNS is enabled
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().ListenAddress = netip.MustParseAddrPort("169.254.116.108:53")
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostDNSConfig))
+
+	suite.assertHostDNS(netip.AddrPort{})
+
+	hostDNSConfig.TypedSpec().Enabled = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), hostDNSConfig))
+
+	suite.assertHostDNS(netip.MustParseAddrPort("169.254.116.108:53"))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), defaultAction.Metadata()))
+
+	suite.assertState(false, 0, nil)
+}
+
+func TestNetworkRuleSpecSuite(t *testing.T) {
+	nfTables := &mockNfTablesManager{}
+
+	suite.Run(t, &NetworkRuleSpecSuite{
+		nfTables: nfTables,
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.NetworkRuleSpecController{
+					NfTablesManager: nfTables,
+				}))
+			},
+		},
+	})
+}
+
+func TestNfTablesManager(t *testing.T) {
+	mgr := netctrl.NewNfTablesManager()
+
+	// cleanup should be fine if nothing is installed
+	assert.NoError(t, mgr.Cleanup())
+
+	defer mgr.Cleanup() //nolint:errcheck
+
+	rules := []network.NetworkRuleSpecSpec{
+		{
+			Name: "etcd",
+			PortSelector: network.RulePortSelector{
+				Ports:    []network.PortRange{{Lo: 2379, Hi: 2380}},
+				Protocol: nethelpers.ProtocolTCP,
+			},
+			Ingress: []network.IngressRule{
+				{
+					Subnet: netip.MustParsePrefix("10.0.0.0/24"),
+					Except: netip.MustParsePrefix("10.0.0.13/32"),
+				},
+				{
+					Subnet: netip.MustParsePrefix("fd00::/64"),
+				},
+			},
+		},
+		anySourceRule("flannel-vxlan", nethelpers.ProtocolUDP, 4789, 4789),
+	}
+
+	countRules := func() int {
+		table := &nftables.Table{Family: nftables.TableFamilyINet, Name: "talos_firewall"}
+
+		nfRules, err := (&nftables.Conn{}).GetRules(table, &nftables.Chain{Name: "ingress", Table: table})
+		require.NoError(t, err)
+
+		return len(nfRules)
+	}
+
+	require.NoError(t, mgr.Update(nethelpers.DefaultActionAccept, rules, netip.AddrPort{}))
+
+	// 8 base rules + (2 ingress + 1 drop) per rule
+	assert.Equal(t, 14, countRules())
+
+	// update replaces the ruleset
+	require.NoError(t, mgr.Update(nethelpers.DefaultActionBlock, rules[:1], netip.AddrPort{}))
+
+	assert.Equal(t, 11, countRules())
+
+	// host DNS adds UDP and TCP rules
+	require.NoError(t, mgr.Update(nethelpers.DefaultActionBlock, rules[:1], netip.MustParseAddrPort("169.254.116.108:53")))
+
+	assert.Equal(t, 13, countRules())
+
+	assert.NoError(t, mgr.Cleanup())
+}
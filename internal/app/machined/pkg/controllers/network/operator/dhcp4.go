@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv4/nclient4"
 	"github.com/siderolabs/gen/slices"
@@ -34,6 +36,14 @@ type DHCP4 struct {
 	skipHostnameRequest bool
 	requestMTU          bool
 
+	sendHostname     string
+	vendorClass      string
+	clientIdentifier []byte
+	userClasses      []string
+	requestOptions   []dhcpv4.OptionCode
+
+	leaseStore *dhcp4LeaseStore
+
 	offer *dhcpv4.DHCPv4
 
 	mu          sync.Mutex
@@ -46,7 +56,11 @@ type DHCP4 struct {
 }
 
 // NewDHCP4 creates DHCPv4 operator.
-func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform) *DHCP4 {
+//
+// The lease is persisted under the statePath once the STATE partition is mounted.
+func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform, st state.State, statePath string) *DHCP4 {
+	clientIdentifier, _ := hex.DecodeString(config.ClientIdentifier) //nolint:errcheck
+
 	return &DHCP4{
 		logger:              logger,
 		linkName:            linkName,
@@ -55,7 +69,15 @@ func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorS
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
-		requestMTU: platform.Name() != "azure",
+		requestMTU:       platform.Name() != "azure",
+		sendHostname:     config.Hostname,
+		vendorClass:      config.VendorClass,
+		clientIdentifier: clientIdentifier,
+		userClasses:      config.UserClasses,
+		requestOptions: slices.Map(config.RequestOptions, func(code uint32) dhcpv4.OptionCode {
+			return dhcpv4.GenericOptionCode(code)
+		}),
+		leaseStore: newDHCP4LeaseStore(statePath, linkName, platform.Mode(), st),
 	}
 }
 
@@ -72,6 +94,9 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 
 	renewInterval := minRenewDuration
 
+	// the persisted lease is checked as soon as STATE is mounted, before the services bind to the address
+	mountedCh := d.leaseStore.Mounted(ctx)
+
 	for {
 		leaseTime, err := d.renew(ctx)
 		if err != nil && !errors.Is(err, context.Canceled) {
@@ -96,10 +121,31 @@ func (d *DHCP4) Run(ctx context.Context, notifyCh chan<- struct{}) {
 			renewInterval = minRenewDuration
 		}
 
+		if !d.waitRenew(ctx, renewInterval, &mountedCh) {
+			return
+		}
+	}
+}
+
+// waitRenew waits for the next renewal, returns false if the context is canceled.
+//
+// If the STATE is mounted while waiting, and the persisted lease address differs, the renewal happens immediately.
+func (d *DHCP4) waitRenew(ctx context.Context, renewInterval time.Duration, mountedCh *<-chan struct{}) bool {
+	timer := time.NewTimer(renewInterval)
+	defer timer.Stop()
+
+	for {
 		select {
 		case <-ctx.Done():
-			return
-		case <-time.After(renewInterval):
+			return false
+		case <-timer.C:
+			return true
+		case <-*mountedCh:
+			*mountedCh = nil
+
+			if d.reloadLease(ctx) {
+				return true
+			}
 		}
 	}
 }
@@ -152,6 +198,11 @@ func (d *DHCP4) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP4) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	return nil
+}
+
 //nolint:gocyclo
 func (d *DHCP4) parseAck(ack *dhcpv4.DHCPv4) {
 	d.mu.Lock()
@@ -321,7 +372,9 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 		opts = append(opts, dhcpv4.OptionInterfaceMTU)
 	}
 
-	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...)}
+	opts = append(opts, d.requestOptions...)
+
+	mods := append([]dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...)}, d.clientOptions()...)
 	clientOpts := []nclient4.ClientOpt{}
 
 	if d.offer != nil {
@@ -350,7 +403,7 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 	if d.offer != nil {
 		lease, err = cli.RequestFromOffer(ctx, d.offer, mods...)
 	} else {
-		lease, err = cli.Request(ctx, mods...)
+		lease, err = d.discoverAndRequest(ctx, cli, mods)
 	}
 
 	if err != nil {
@@ -365,9 +418,92 @@ func (d *DHCP4) renew(ctx context.Context) (time.Duration, error) {
 	d.offer = lease.Offer
 	d.parseAck(lease.ACK)
 
+	if err = d.leaseStore.Store(ctx, lease.ACK); err != nil {
+		d.logger.Warn("failed to persist DHCP lease", zap.String("link", d.linkName), zap.Error(err))
+	}
+
 	return lease.ACK.IPAddressLeaseTime(time.Minute * 30), nil
 }
 
+// clientOptions returns the options which identify the client to the DHCP server.
+func (d *DHCP4) clientOptions() []dhcpv4.Modifier {
+	var mods []dhcpv4.Modifier
+
+	if d.sendHostname != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptHostName(d.sendHostname)))
+	}
+
+	if d.vendorClass != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClassIdentifier(d.vendorClass)))
+	}
+
+	if len(d.clientIdentifier) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(d.clientIdentifier)))
+	}
+
+	if len(d.userClasses) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptRFC3004UserClass(d.userClasses)))
+	}
+
+	return mods
+}
+
+// reloadLease checks the persisted lease if it wasn't read on discovery as the STATE was not mounted yet.
+//
+// It is called once the STATE is mounted, before the services are started.
+// If the persisted lease has a different address, the offer is dropped to run the discovery
+// requesting the persisted address, and true is returned.
+//
+// The address is never switched on the regular renewals, as the services might be already bound to it.
+func (d *DHCP4) reloadLease(ctx context.Context) bool {
+	if d.offer == nil || d.leaseStore.Loaded() {
+		return false
+	}
+
+	previous, err := d.leaseStore.Load(ctx)
+	if err != nil {
+		d.logger.Warn("failed to load persisted DHCP lease", zap.String("link", d.linkName), zap.Error(err))
+
+		return false
+	}
+
+	if previous == nil || previous.YourIPAddr == nil || previous.YourIPAddr.IsUnspecified() || previous.YourIPAddr.Equal(d.offer.YourIPAddr) {
+		return false
+	}
+
+	d.logger.Info("persisted DHCP lease address differs, restarting discovery",
+		zap.String("link", d.linkName), zap.Stringer("address", d.offer.YourIPAddr), zap.Stringer("persisted", previous.YourIPAddr))
+
+	d.offer = nil
+
+	return true
+}
+
+// discoverAndRequest runs the full discover sequence.
+//
+// If there is a persisted lease, the address from the lease is requested in the discover message.
+func (d *DHCP4) discoverAndRequest(ctx context.Context, cli *nclient4.Client, mods []dhcpv4.Modifier) (*nclient4.Lease, error) {
+	discoverMods := mods
+
+	previous, err := d.leaseStore.Load(ctx)
+	if err != nil {
+		d.logger.Warn("failed to load persisted DHCP lease", zap.String("link", d.linkName), zap.Error(err))
+	}
+
+	if previous != nil && previous.YourIPAddr != nil && !previous.YourIPAddr.IsUnspecified() {
+		d.logger.Debug("requesting address from the persisted lease", zap.String("link", d.linkName), zap.Stringer("address", previous.YourIPAddr))
+
+		discoverMods = append(append([]dhcpv4.Modifier(nil), mods...), dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(previous.YourIPAddr)))
+	}
+
+	offer, err := cli.DiscoverOffer(ctx, discoverMods...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to receive an offer: %w", err)
+	}
+
+	return cli.RequestFromOffer(ctx, offer, mods...)
+}
+
 func collapseSummary(summary string) string {
 	lines := strings.Split(summary, "\n")[1:]
 
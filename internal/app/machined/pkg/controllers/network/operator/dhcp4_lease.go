@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/insomniacslk/dhcp/dhcpv4"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// dhcp4LeaseStore persists the last DHCPv4 ACK in the STATE partition.
+//
+// The address from the persisted lease is requested on the next discovery,
+// so that the node gets the same address after a reboot.
+//
+// The first discovery usually happens before the STATE is mounted, so the store
+// tracks whether the persisted lease was read, and the lease is not stored
+// until then to keep the persisted lease from being overwritten.
+type dhcp4LeaseStore struct {
+	path  string
+	mode  runtime.Mode
+	state state.State
+
+	loaded bool
+}
+
+func newDHCP4LeaseStore(statePath, linkName string, mode runtime.Mode, st state.State) *dhcp4LeaseStore {
+	return &dhcp4LeaseStore{
+		path:  filepath.Join(statePath, fmt.Sprintf(constants.DHCP4LeaseFilenameFormat, linkName)),
+		mode:  mode,
+		state: st,
+		// in container mode STATE is always mounted, so the persisted lease is read on the first discovery
+		loaded: mode == runtime.ModeContainer,
+	}
+}
+
+// available returns true if the STATE partition is mounted.
+func (s *dhcp4LeaseStore) available(ctx context.Context) bool {
+	// in container mode STATE is always mounted
+	if s.mode == runtime.ModeContainer {
+		return true
+	}
+
+	if s.state == nil {
+		return false
+	}
+
+	_, err := s.state.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, runtimeres.MountStatusType, constants.StatePartitionLabel, resource.VersionUndefined))
+
+	return err == nil
+}
+
+// Mounted returns a channel which is closed once the STATE partition is mounted.
+func (s *dhcp4LeaseStore) Mounted(ctx context.Context) <-chan struct{} {
+	mountedCh := make(chan struct{})
+
+	if s.mode == runtime.ModeContainer {
+		close(mountedCh)
+
+		return mountedCh
+	}
+
+	if s.state == nil {
+		return mountedCh
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	watchCh := make(chan state.Event)
+
+	if err := s.state.Watch(ctx, resource.NewMetadata(v1alpha1.NamespaceName, runtimeres.MountStatusType, constants.StatePartitionLabel, resource.VersionUndefined), watchCh); err != nil {
+		cancel()
+
+		return mountedCh
+	}
+
+	go func() {
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-watchCh:
+				if event.Type == state.Created || event.Type == state.Updated {
+					close(mountedCh)
+
+					return
+				}
+			}
+		}
+	}()
+
+	return mountedCh
+}
+
+// Loaded returns true if the persisted lease was read with the STATE mounted.
+func (s *dhcp4LeaseStore) Loaded() bool {
+	return s.loaded
+}
+
+// Load the persisted lease, returns nil if there is no lease stored.
+func (s *dhcp4LeaseStore) Load(ctx context.Context) (*dhcpv4.DHCPv4, error) {
+	if !s.available(ctx) {
+		return nil, nil
+	}
+
+	s.loaded = true
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return dhcpv4.FromBytes(data)
+}
+
+// Store the lease, it is skipped if the persisted lease wasn't read yet.
+func (s *dhcp4LeaseStore) Store(ctx context.Context, ack *dhcpv4.DHCPv4) error {
+	if !s.loaded {
+		return nil
+	}
+
+	return os.WriteFile(s.path, ack.ToBytes(), 0o600)
+}
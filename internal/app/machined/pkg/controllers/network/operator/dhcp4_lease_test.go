@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+func TestDHCP4LeaseStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	store := newDHCP4LeaseStore(dir, "eth0", runtime.ModeMetal, st)
+
+	ack, err := dhcpv4.New(
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+		dhcpv4.WithYourIP(net.ParseIP("10.5.0.42")),
+		dhcpv4.WithNetmask(net.CIDRMask(24, 32)),
+	)
+	require.NoError(t, err)
+
+	// STATE is not mounted yet, lease is not persisted
+	require.NoError(t, store.Store(ctx, ack))
+
+	_, err = os.Stat(filepath.Join(dir, "dhcp4-eth0.lease"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	lease, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	require.NoError(t, st.Create(ctx, runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.StatePartitionLabel)))
+
+	lease, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	require.NoError(t, store.Store(ctx, ack))
+
+	lease, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+	assert.Equal(t, "10.5.0.42", lease.YourIPAddr.String())
+	assert.Equal(t, dhcpv4.MessageTypeAck, lease.MessageType())
+}
+
+func TestDHCP4LeaseStoreContainer(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// in container mode STATE is always available
+	store := newDHCP4LeaseStore(dir, "eth0", runtime.ModeContainer, nil)
+
+	ack, err := dhcpv4.New(dhcpv4.WithYourIP(net.ParseIP("172.20.0.2")))
+	require.NoError(t, err)
+
+	require.NoError(t, store.Store(ctx, ack))
+
+	lease, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+	assert.Equal(t, "172.20.0.2", lease.YourIPAddr.String())
+}
+
+func TestDHCP4LeaseReload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	persisted, err := dhcpv4.New(dhcpv4.WithYourIP(net.ParseIP("10.5.0.42")))
+	require.NoError(t, err)
+
+	// lease persisted on the previous boot
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dhcp4-eth0.lease"), persisted.ToBytes(), 0o600))
+
+	offer, err := dhcpv4.New(dhcpv4.WithYourIP(net.ParseIP("10.5.0.43")))
+	require.NoError(t, err)
+
+	d := &DHCP4{
+		logger:     zaptest.NewLogger(t),
+		linkName:   "eth0",
+		leaseStore: newDHCP4LeaseStore(dir, "eth0", runtime.ModeMetal, st),
+		offer:      offer,
+	}
+
+	// discovery happened before STATE is mounted, so the new lease is not persisted
+	require.NoError(t, d.leaseStore.Store(ctx, offer))
+
+	assert.False(t, d.reloadLease(ctx))
+	assert.NotNil(t, d.offer)
+
+	lease, err := os.ReadFile(filepath.Join(dir, "dhcp4-eth0.lease"))
+	require.NoError(t, err)
+	assert.Equal(t, persisted.ToBytes(), lease)
+
+	require.NoError(t, st.Create(ctx, runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.StatePartitionLabel)))
+
+	// once STATE is mounted, the persisted lease is read, and the discovery is restarted requesting the persisted address
+	assert.True(t, d.reloadLease(ctx))
+	assert.Nil(t, d.offer)
+	assert.True(t, d.leaseStore.Loaded())
+
+	// the persisted lease is read only once, so the address is never switched on the later renewals
+	d.offer = offer
+
+	assert.False(t, d.reloadLease(ctx))
+	assert.NotNil(t, d.offer)
+}
+
+func TestDHCP4WaitRenewMounted(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	persisted, err := dhcpv4.New(dhcpv4.WithYourIP(net.ParseIP("10.5.0.42")))
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dhcp4-eth0.lease"), persisted.ToBytes(), 0o600))
+
+	offer, err := dhcpv4.New(dhcpv4.WithYourIP(net.ParseIP("10.5.0.43")))
+	require.NoError(t, err)
+
+	d := &DHCP4{
+		logger:     zaptest.NewLogger(t),
+		linkName:   "eth0",
+		leaseStore: newDHCP4LeaseStore(dir, "eth0", runtime.ModeMetal, st),
+		offer:      offer,
+	}
+
+	mountedCh := d.leaseStore.Mounted(ctx)
+
+	// STATE is not mounted, so the renewal happens on the interval
+	assert.True(t, d.waitRenew(ctx, 10*time.Millisecond, &mountedCh))
+	assert.NotNil(t, d.offer)
+	assert.NotNil(t, mountedCh)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		st.Create(ctx, runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.StatePartitionLabel)) //nolint:errcheck
+	}()
+
+	// STATE is mounted, the renewal happens immediately requesting the persisted address
+	assert.True(t, d.waitRenew(ctx, time.Hour, &mountedCh))
+	assert.Nil(t, d.offer)
+	assert.Nil(t, mountedCh)
+}
@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -36,11 +37,16 @@ type DHCP6 struct {
 	duid                []byte
 	skipHostnameRequest bool
 
-	mu          sync.Mutex
-	addresses   []network.AddressSpecSpec
-	hostname    []network.HostnameSpecSpec
-	resolvers   []network.ResolverSpecSpec
-	timeservers []network.TimeServerSpecSpec
+	prefixDelegation bool
+	prefixLength     uint32
+	delegatedSubnets []network.DHCP6DelegatedSubnetSpec
+
+	mu                sync.Mutex
+	addresses         []network.AddressSpecSpec
+	hostname          []network.HostnameSpecSpec
+	resolvers         []network.ResolverSpecSpec
+	timeservers       []network.TimeServerSpecSpec
+	delegatedPrefixes []network.DelegatedPrefixSpec
 }
 
 // NewDHCP6 creates DHCPv6 operator.
@@ -52,6 +58,9 @@ func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorS
 		linkName:            linkName,
 		duid:                duidBin,
 		skipHostnameRequest: config.SkipHostnameRequest,
+		prefixDelegation:    config.PrefixDelegation,
+		prefixLength:        config.PrefixLength,
+		delegatedSubnets:    config.DelegatedSubnets,
 	}
 }
 
@@ -149,6 +158,15 @@ func (d *DHCP6) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP6) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.delegatedPrefixes
+}
+
+//nolint:gocyclo,cyclop
 func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -175,6 +193,53 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 		d.addresses = nil
 	}
 
+	d.delegatedPrefixes = nil
+
+	if iapd := reply.Options.OneIAPD(); iapd != nil {
+		for _, iaPrefix := range iapd.Options.Prefixes() {
+			if iaPrefix.Prefix == nil {
+				continue
+			}
+
+			prefix, ok := netipx.FromStdIPNet(iaPrefix.Prefix)
+			if !ok {
+				continue
+			}
+
+			d.delegatedPrefixes = append(d.delegatedPrefixes, network.DelegatedPrefixSpec{
+				LinkName:          d.linkName,
+				Prefix:            prefix.Masked(),
+				PreferredLifetime: iaPrefix.PreferredLifetime,
+				ValidLifetime:     iaPrefix.ValidLifetime,
+			})
+
+			if leaseTime == 0 || iaPrefix.ValidLifetime < leaseTime {
+				leaseTime = iaPrefix.ValidLifetime
+			}
+		}
+	}
+
+	// assign subnets from the first delegated prefix to the downstream links
+	if len(d.delegatedPrefixes) > 0 {
+		for _, subnet := range d.delegatedSubnets {
+			addr, err := delegatedSubnetAddress(d.delegatedPrefixes[0].Prefix, subnet.SubnetID)
+			if err != nil {
+				d.logger.Warn("failed to assign delegated subnet", zap.String("link", subnet.LinkName), zap.Error(err))
+
+				continue
+			}
+
+			d.addresses = append(d.addresses, network.AddressSpecSpec{
+				Address:     addr,
+				LinkName:    subnet.LinkName,
+				Family:      nethelpers.FamilyInet6,
+				Scope:       nethelpers.ScopeGlobal,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				ConfigLayer: network.ConfigOperator,
+			})
+		}
+	}
+
 	if len(reply.Options.DNS()) > 0 {
 		convertIP := func(ip net.IP) netip.Addr {
 			result, _ := netipx.FromStdIP(ip)
@@ -243,6 +308,31 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 		}
 	}
 
+	if d.prefixDelegation {
+		iface, ierr := net.InterfaceByName(d.linkName)
+		if ierr != nil {
+			return 0, ierr
+		}
+
+		// IAID should be unique for the client, so use the link index
+		var iaid [4]byte
+
+		binary.BigEndian.PutUint32(iaid[:], uint32(iface.Index))
+
+		var hints []*dhcpv6.OptIAPrefix
+
+		if d.prefixLength > 0 {
+			hints = append(hints, &dhcpv6.OptIAPrefix{
+				Prefix: &net.IPNet{
+					IP:   net.IPv6zero,
+					Mask: net.CIDRMask(int(d.prefixLength), 128),
+				},
+			})
+		}
+
+		modifiers = append(modifiers, dhcpv6.WithIAPD(iaid, hints...))
+	}
+
 	reply, err := cli.RapidSolicit(ctx, modifiers...)
 	if err != nil {
 		return 0, err
@@ -253,6 +343,26 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 	return d.parseReply(reply), nil
 }
 
+// delegatedSubnetAddress returns the address (::1) in the /64 subnet with the specified ID carved from the delegated prefix.
+func delegatedSubnetAddress(prefix netip.Prefix, subnetID uint32) (netip.Prefix, error) {
+	const subnetBits = 64
+
+	if !prefix.Addr().Is6() || prefix.Bits() > subnetBits {
+		return netip.Prefix{}, fmt.Errorf("prefix %s can't be split into /%d subnets", prefix, subnetBits)
+	}
+
+	if uint64(subnetID) >= uint64(1)<<(subnetBits-prefix.Bits()) {
+		return netip.Prefix{}, fmt.Errorf("subnet ID %d doesn't fit into the prefix %s", subnetID, prefix)
+	}
+
+	addr := prefix.Masked().Addr().As16()
+
+	binary.BigEndian.PutUint64(addr[:8], binary.BigEndian.Uint64(addr[:8])|uint64(subnetID))
+	addr[15] = 1
+
+	return netip.PrefixFrom(netip.AddrFrom16(addr), subnetBits), nil
+}
+
 func (d *DHCP6) waitIPv6LinkReady(ctx context.Context, iface *net.Interface) error {
 	conn, err := rtnetlink.Dial(nil)
 	if err != nil {
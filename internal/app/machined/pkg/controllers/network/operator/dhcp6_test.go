@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+func TestDelegatedSubnetAddress(t *testing.T) {
+	for _, tt := range []struct {
+		prefix   string
+		subnetID uint32
+
+		expected      string
+		expectedError string
+	}{
+		{
+			prefix:   "2001:db8:1200::/56",
+			subnetID: 0,
+			expected: "2001:db8:1200::1/64",
+		},
+		{
+			prefix:   "2001:db8:1200::/56",
+			subnetID: 0xff,
+			expected: "2001:db8:1200:ff::1/64",
+		},
+		{
+			prefix:   "2001:db8:abcd:10::/60",
+			subnetID: 5,
+			expected: "2001:db8:abcd:15::1/64",
+		},
+		{
+			prefix:   "2001:db8:abcd:12::/64",
+			subnetID: 0,
+			expected: "2001:db8:abcd:12::1/64",
+		},
+		{
+			prefix:        "2001:db8:abcd:10::/60",
+			subnetID:      16,
+			expectedError: "subnet ID 16 doesn't fit into the prefix 2001:db8:abcd:10::/60",
+		},
+		{
+			prefix:        "2001:db8::/80",
+			subnetID:      0,
+			expectedError: "prefix 2001:db8::/80 can't be split into /64 subnets",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.prefix, func(t *testing.T) {
+			addr, err := delegatedSubnetAddress(netip.MustParsePrefix(tt.prefix), tt.subnetID)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, addr.String())
+		})
+	}
+}
+
+func TestDHCP6ParseReplyPrefixDelegation(t *testing.T) {
+	d := NewDHCP6(zaptest.NewLogger(t), "eth0", network.DHCP6OperatorSpec{
+		PrefixDelegation: true,
+		PrefixLength:     56,
+		DelegatedSubnets: []network.DHCP6DelegatedSubnetSpec{
+			{
+				LinkName: "eth1",
+				SubnetID: 1,
+			},
+			{
+				LinkName: "eth2",
+				SubnetID: 0x100, // doesn't fit, skipped
+			},
+		},
+	})
+
+	reply, err := dhcpv6.NewMessage()
+	require.NoError(t, err)
+
+	reply.MessageType = dhcpv6.MessageTypeReply
+
+	_, prefix, err := net.ParseCIDR("2001:db8:1200::/56")
+	require.NoError(t, err)
+
+	iapd := &dhcpv6.OptIAPD{}
+	iapd.Options.Add(&dhcpv6.OptIAPrefix{
+		PreferredLifetime: time.Hour,
+		ValidLifetime:     2 * time.Hour,
+		Prefix:            prefix,
+	})
+
+	reply.AddOption(iapd)
+
+	leaseTime := d.parseReply(reply)
+
+	assert.Equal(t, 2*time.Hour, leaseTime)
+
+	assert.Equal(t, []network.DelegatedPrefixSpec{
+		{
+			LinkName:          "eth0",
+			Prefix:            netip.MustParsePrefix("2001:db8:1200::/56"),
+			PreferredLifetime: time.Hour,
+			ValidLifetime:     2 * time.Hour,
+		},
+	}, d.DelegatedPrefixes())
+
+	assert.Equal(t, []network.AddressSpecSpec{
+		{
+			Address:     netip.MustParsePrefix("2001:db8:1200:1::1/64"),
+			LinkName:    "eth1",
+			Family:      nethelpers.FamilyInet6,
+			Scope:       nethelpers.ScopeGlobal,
+			Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+			ConfigLayer: network.ConfigOperator,
+		},
+	}, d.AddressSpecs())
+}
@@ -15,14 +15,17 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.uber.org/zap"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator/vip"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
@@ -37,6 +40,7 @@ type VIP struct {
 	linkName      string
 	sharedIP      netip.Addr
 	gratuitousARP bool
+	lease         network.VIPKubernetesLeaseSpec
 
 	state state.State
 
@@ -55,6 +59,8 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		handler = vip.NewEquinixMetalHandler(logger, spec.IP.String(), spec.EquinixMetal)
 	case spec.HCloud != network.VIPHCloudSpec{}:
 		handler = vip.NewHCloudHandler(logger, spec.IP.String(), spec.HCloud)
+	case len(spec.BGP.Peers) > 0:
+		handler = vip.NewBGPHandler(logger, spec.IP, spec.BGP)
 	default:
 		handler = vip.NopHandler{}
 	}
@@ -64,6 +70,7 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		linkName:      linkName,
 		sharedIP:      spec.IP,
 		gratuitousARP: spec.GratuitousARP,
+		lease:         spec.KubernetesLease,
 		state:         state,
 		handler:       handler,
 	}
@@ -76,8 +83,14 @@ func (vip *VIP) Prefix() string {
 
 // Run the operator loop.
 func (vip *VIP) Run(ctx context.Context, notifyCh chan<- struct{}) {
+	campaign := vip.campaign
+
+	if vip.lease.Namespace != "" {
+		campaign = vip.campaignKubernetesLease
+	}
+
 	for {
-		err := vip.campaign(ctx, notifyCh)
+		err := campaign(ctx, notifyCh)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) {
 				vip.logger.Warn("campaign failure", zap.Error(err), zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
@@ -147,11 +160,33 @@ func (vip *VIP) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return nil
 }
 
+// DelegatedPrefixes implements Operator interface.
+func (vip *VIP) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	return nil
+}
+
 func (vip *VIP) etcdElectionKey() string {
 	return fmt.Sprintf("%s:vip:election:%s", constants.EtcdRootTalosKey, vip.sharedIP.String())
 }
 
-func (vip *VIP) waitForPreconditions(ctx context.Context) error {
+// etcdConfig returns the etcd configuration of the node.
+func (vip *VIP) etcdConfig(ctx context.Context) (talosconfig.Etcd, error) {
+	cfg, err := safe.StateGet[*config.MachineConfig](ctx, vip.state, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	return cfg.Config().Cluster().Etcd(), nil
+}
+
+// waitForPreconditions waits for the local etcd (only on voters) and the kubelet lifecycle.
+//
+// Non-voter nodes campaign via the external etcd endpoints, so the local etcd is not required.
+func (vip *VIP) waitForPreconditions(ctx context.Context, voter bool) error {
+	if !voter {
+		return vip.waitForKubeletLifecycle(ctx)
+	}
+
 	//  wait for the etcd to be up
 	_, err := vip.state.WatchFor(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined),
 		state.WithCondition(func(r resource.Resource) (bool, error) {
@@ -167,8 +202,12 @@ func (vip *VIP) waitForPreconditions(ctx context.Context) error {
 		return fmt.Errorf("etcd health wait failure: %w", err)
 	}
 
+	return vip.waitForKubeletLifecycle(ctx)
+}
+
+func (vip *VIP) waitForKubeletLifecycle(ctx context.Context) error {
 	// wait for the kubelet lifecycle to be up, and not being torn down
-	_, err = vip.state.WatchFor(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined),
+	_, err := vip.state.WatchFor(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined),
 		state.WithCondition(func(r resource.Resource) (bool, error) {
 			if resource.IsTombstone(r) {
 				return false, nil
@@ -192,13 +231,20 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	if err := vip.waitForPreconditions(ctx); err != nil {
+	etcdConfig, err := vip.etcdConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	voter := etcdConfig.Role() == constants.EtcdRoleVoter
+
+	if err = vip.waitForPreconditions(ctx, voter); err != nil {
 		return fmt.Errorf("error waiting for preconditions: %w", err)
 	}
 
 	// put a finalizer on the kubelet lifecycle and remove once the campaign is done
 	kubeletLifecycle := resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined)
-	if err := vip.state.AddFinalizer(ctx, kubeletLifecycle, vip.Prefix()); err != nil {
+	if err = vip.state.AddFinalizer(ctx, kubeletLifecycle, vip.Prefix()); err != nil {
 		return fmt.Errorf("error adding kubelet lifecycle finalizer: %w", err)
 	}
 
@@ -211,9 +257,10 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 		return fmt.Errorf("refusing to join election without a hostname")
 	}
 
-	ec, err := etcd.NewLocalClient()
+	// learners reject lease and txn requests, so non-voters campaign via the voting members
+	ec, err := etcd.NewVoterClient(etcdConfig)
 	if err != nil {
-		return fmt.Errorf("failed to create local etcd client: %w", err)
+		return fmt.Errorf("failed to create etcd client: %w", err)
 	}
 
 	defer ec.Close() //nolint:errcheck
@@ -250,8 +297,10 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 
 	watchCh := make(chan state.Event)
 
-	if err = vip.state.Watch(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined), watchCh); err != nil {
-		return fmt.Errorf("error setting up etcd watch: %w", err)
+	if voter {
+		if err = vip.state.Watch(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined), watchCh); err != nil {
+			return fmt.Errorf("error setting up etcd watch: %w", err)
+		}
 	}
 
 	if err = vip.state.Watch(ctx, kubeletLifecycle, watchCh); err != nil {
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vip
+
+import (
+	"context"
+	"net/netip"
+
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// BGPHandler announces Virtual IP to the BGP peers while the node holds the VIP.
+type BGPHandler struct {
+	logger *zap.Logger
+
+	cfg bgp.Config
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewBGPHandler creates new BGPHandler.
+func NewBGPHandler(logger *zap.Logger, vip netip.Addr, spec network.VIPBGPSpec) *BGPHandler {
+	cfg := bgp.Config{
+		LocalASN: spec.LocalASN,
+		RouterID: spec.RouterID,
+		HoldTime: spec.HoldTime,
+		Prefixes: []netip.Prefix{netip.PrefixFrom(vip, vip.BitLen())},
+	}
+
+	for _, peer := range spec.Peers {
+		cfg.Peers = append(cfg.Peers, bgp.PeerConfig{
+			Address: peer.Address,
+			Port:    uint16(peer.Port),
+			ASN:     peer.ASN,
+		})
+	}
+
+	return &BGPHandler{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// Acquire implements Handler interface.
+//
+// BGP sessions are established in the background, and the VIP is announced to each peer
+// as soon as the session is up.
+func (handler *BGPHandler) Acquire(ctx context.Context) error {
+	if handler.cancel != nil {
+		return nil
+	}
+
+	speakerCtx, cancel := context.WithCancel(context.Background())
+
+	handler.cancel = cancel
+	handler.doneCh = make(chan struct{})
+
+	speaker := bgp.NewSpeaker(handler.cfg, handler.logger.With(zap.String("vip_mode", "bgp")))
+
+	go func() {
+		defer close(handler.doneCh)
+
+		speaker.Run(speakerCtx)
+	}()
+
+	handler.logger.Info("announcing VIP via BGP", zap.Stringers("prefixes", handler.cfg.Prefixes))
+
+	return nil
+}
+
+// Release implements Handler interface.
+//
+// The VIP is withdrawn and BGP sessions are closed.
+func (handler *BGPHandler) Release(ctx context.Context) error {
+	if handler.cancel == nil {
+		return nil
+	}
+
+	handler.cancel()
+	handler.cancel = nil
+
+	select {
+	case <-handler.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	handler.logger.Info("withdrawn VIP from BGP peers", zap.Stringers("prefixes", handler.cfg.Prefixes))
+
+	return nil
+}
@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// Kubernetes Lease election timings, same as the defaults of kube-controller-manager.
+const (
+	leaseDuration      = 15 * time.Second
+	leaseRenewDeadline = 10 * time.Second
+	leaseRetryPeriod   = 2 * time.Second
+)
+
+func (vip *VIP) leaseName() string {
+	// lease name should be a valid DNS subdomain, so replace colons in IPv6 addresses
+	return "talos-vip-" + strings.ReplaceAll(vip.sharedIP.String(), ":", "-")
+}
+
+// kubernetesClient builds the Kubernetes client to manage the lease.
+//
+// The API server endpoint is taken from the kubelet kubeconfig, while the credentials come from the service account token,
+// as kubelet credentials are not allowed to manage leases.
+func (vip *VIP) kubernetesClient() (*kubernetes.Client, error) {
+	config, err := clientcmd.BuildConfigFromFlags("", constants.KubeletKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config = restclient.AnonymousClientConfig(config)
+	config.BearerToken = vip.lease.ServiceAccountToken
+
+	config.Timeout = leaseRenewDeadline
+
+	return kubernetes.NewForConfig(config)
+}
+
+// campaignKubernetesLease runs the election campaign via Kubernetes Lease.
+//
+// It follows the same flow as the etcd-based campaign, but it doesn't depend on etcd, so it can be used on worker nodes.
+//
+//nolint:gocyclo,cyclop
+func (vip *VIP) campaignKubernetesLease(ctx context.Context, notifyCh chan<- struct{}) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := vip.waitForKubeletLifecycle(ctx); err != nil {
+		return fmt.Errorf("error waiting for preconditions: %w", err)
+	}
+
+	if err := conditions.WaitForKubeconfigReady(constants.KubeletKubeconfig).Wait(ctx); err != nil {
+		return fmt.Errorf("error waiting for kubelet kubeconfig: %w", err)
+	}
+
+	// put a finalizer on the kubelet lifecycle and remove once the campaign is done
+	kubeletLifecycle := resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined)
+	if err := vip.state.AddFinalizer(ctx, kubeletLifecycle, vip.Prefix()); err != nil {
+		return fmt.Errorf("error adding kubelet lifecycle finalizer: %w", err)
+	}
+
+	defer func() {
+		vip.state.RemoveFinalizer(ctx, kubeletLifecycle, vip.Prefix()) //nolint:errcheck
+	}()
+
+	// use the Kubernetes node name as the lease holder identity
+	nodename, err := vip.state.WatchFor(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined),
+		state.WithEventTypes(state.Created, state.Updated),
+	)
+	if err != nil {
+		return fmt.Errorf("error waiting for the node name: %w", err)
+	}
+
+	client, err := vip.kubernetesClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	electedCh := make(chan struct{})
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Namespace: vip.lease.Namespace,
+				Name:      vip.leaseName(),
+			},
+			Client: client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: nodename.(*k8s.Nodename).TypedSpec().Nodename,
+			},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   leaseRenewDeadline,
+		RetryPeriod:     leaseRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            vip.leaseName(),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				close(electedCh)
+			},
+			OnStoppedLeading: func() {},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create leader elector: %w", err)
+	}
+
+	electionCtx, electionCancel := context.WithCancel(ctx)
+	electionDoneCh := make(chan struct{})
+
+	go func() {
+		defer close(electionDoneCh)
+
+		elector.Run(electionCtx)
+	}()
+
+	defer func() {
+		// canceling the election context releases the lease
+		electionCancel()
+
+		<-electionDoneCh
+	}()
+
+	watchCh := make(chan state.Event)
+
+	if err = vip.state.Watch(ctx, kubeletLifecycle, watchCh); err != nil {
+		return fmt.Errorf("error setting up kubelet lifecycle watch: %w", err)
+	}
+
+	// wait for the lease to be acquired
+	// while waiting, also observe the kubelet lifecycle object (if the node is shutting down)
+campaignLoop:
+	for {
+		select {
+		case <-electedCh:
+			// node acquired the lease!
+			break campaignLoop
+		case <-electionDoneCh:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case event := <-watchCh:
+			// break the loop if the kubelet lifecycle is entering teardown phase
+			if event.Resource.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+		}
+	}
+
+	if err = vip.markAsLeader(ctx, notifyCh, true); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err = vip.markAsLeader(ctx, notifyCh, false); err != nil && !errors.Is(err, context.Canceled) {
+			vip.logger.Info("failed disabling shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP), zap.Error(err))
+		}
+
+		vip.logger.Info("removing shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
+	}()
+
+	vip.logger.Info("enabled shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP), zap.String("lease", vip.lease.Namespace+"/"+vip.leaseName()))
+
+observeLoop:
+	for {
+		select {
+		case <-electionDoneCh:
+			vip.logger.Info("lost Kubernetes lease", zap.String("leader", elector.GetLeader()))
+
+			break observeLoop
+		case <-ctx.Done():
+			break observeLoop
+		case event := <-watchCh:
+			// break the loop if the kubelet lifecycle is entering teardown phase
+			if event.Resource.Metadata().Phase() == resource.PhaseTearingDown {
+				break observeLoop
+			}
+		}
+	}
+
+	return nil
+}
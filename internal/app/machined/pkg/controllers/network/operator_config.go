@@ -123,12 +123,10 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 					}
 
 					specs = append(specs, network.OperatorSpecSpec{
-						Operator:  network.OperatorDHCP4,
-						LinkName:  device.Interface(),
-						RequireUp: true,
-						DHCP4: network.DHCP4OperatorSpec{
-							RouteMetric: routeMetric,
-						},
+						Operator:    network.OperatorDHCP4,
+						LinkName:    device.Interface(),
+						RequireUp:   true,
+						DHCP4:       dhcp4OperatorSpec(routeMetric, device.DHCPOptions()),
 						ConfigLayer: network.ConfigMachineConfiguration,
 					})
 				}
@@ -140,13 +138,10 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 					}
 
 					specs = append(specs, network.OperatorSpecSpec{
-						Operator:  network.OperatorDHCP6,
-						LinkName:  device.Interface(),
-						RequireUp: true,
-						DHCP6: network.DHCP6OperatorSpec{
-							RouteMetric: routeMetric,
-							DUID:        device.DHCPOptions().DUIDv6(),
-						},
+						Operator:    network.OperatorDHCP6,
+						LinkName:    device.Interface(),
+						RequireUp:   true,
+						DHCP6:       dhcp6OperatorSpec(routeMetric, device.DHCPOptions()),
 						ConfigLayer: network.ConfigMachineConfiguration,
 					})
 				}
@@ -159,12 +154,10 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						}
 
 						specs = append(specs, network.OperatorSpecSpec{
-							Operator:  network.OperatorDHCP4,
-							LinkName:  fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
-							RequireUp: true,
-							DHCP4: network.DHCP4OperatorSpec{
-								RouteMetric: routeMetric,
-							},
+							Operator:    network.OperatorDHCP4,
+							LinkName:    fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
+							RequireUp:   true,
+							DHCP4:       dhcp4OperatorSpec(routeMetric, vlan.DHCPOptions()),
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
 					}
@@ -176,13 +169,10 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						}
 
 						specs = append(specs, network.OperatorSpecSpec{
-							Operator:  network.OperatorDHCP6,
-							LinkName:  fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
-							RequireUp: true,
-							DHCP6: network.DHCP6OperatorSpec{
-								RouteMetric: routeMetric,
-								DUID:        vlan.DHCPOptions().DUIDv6(),
-							},
+							Operator:    network.OperatorDHCP6,
+							LinkName:    fmt.Sprintf("%s.%d", device.Interface(), vlan.ID()),
+							RequireUp:   true,
+							DHCP6:       dhcp6OperatorSpec(routeMetric, vlan.DHCPOptions()),
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
 					}
@@ -302,3 +292,38 @@ func (ctrl *OperatorConfigController) apply(ctx context.Context, r controller.Ru
 
 	return ids, nil
 }
+
+// dhcp4OperatorSpec builds DHCPv4 operator settings from the machine configuration DHCP options.
+func dhcp4OperatorSpec(routeMetric uint32, options talosconfig.DHCPOptions) network.DHCP4OperatorSpec {
+	return network.DHCP4OperatorSpec{
+		RouteMetric:      routeMetric,
+		Hostname:         options.Hostname(),
+		VendorClass:      options.VendorClass(),
+		ClientIdentifier: options.ClientIdentifier(),
+		UserClasses:      options.UserClasses(),
+		RequestOptions: slices.Map(options.RequestOptions(), func(code int) uint32 {
+			return uint32(code)
+		}),
+	}
+}
+
+// dhcp6OperatorSpec builds DHCPv6 operator settings from the machine configuration DHCP options.
+func dhcp6OperatorSpec(routeMetric uint32, options talosconfig.DHCPOptions) network.DHCP6OperatorSpec {
+	spec := network.DHCP6OperatorSpec{
+		RouteMetric: routeMetric,
+		DUID:        options.DUIDv6(),
+	}
+
+	if pd := options.PrefixDelegation(); pd != nil {
+		spec.PrefixDelegation = true
+		spec.PrefixLength = uint32(pd.PrefixLength())
+		spec.DelegatedSubnets = slices.Map(pd.Subnets(), func(subnet talosconfig.PrefixDelegationSubnet) network.DHCP6DelegatedSubnetSpec {
+			return network.DHCP6DelegatedSubnetSpec{
+				LinkName: subnet.Interface(),
+				SubnetID: subnet.ID(),
+			}
+		})
+	}
+
+	return spec
+}
@@ -291,8 +291,13 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 							DeviceInterface: "eth3",
 							DeviceDHCP:      pointer.To(true),
 							DeviceDHCPOptions: &v1alpha1.DHCPOptions{
-								DHCPIPv4:        pointer.To(true),
-								DHCPRouteMetric: 256,
+								DHCPIPv4:             pointer.To(true),
+								DHCPRouteMetric:      256,
+								DHCPHostname:         "worker-1",
+								DHCPVendorClass:      "talos",
+								DHCPClientIdentifier: "01aabbccddeeff",
+								DHCPUserClasses:      []string{"rack-1"},
+								DHCPRequestOptions:   []int{42},
 							},
 						},
 						{
@@ -350,7 +355,14 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP4() {
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
 						case "configuration/dhcp4/eth3":
 							suite.Assert().Equal("eth3", r.TypedSpec().LinkName)
-							suite.Assert().EqualValues(256, r.TypedSpec().DHCP4.RouteMetric)
+							suite.Assert().Equal(network.DHCP4OperatorSpec{
+								RouteMetric:      256,
+								Hostname:         "worker-1",
+								VendorClass:      "talos",
+								ClientIdentifier: "01aabbccddeeff",
+								UserClasses:      []string{"rack-1"},
+								RequestOptions:   []uint32{42},
+							}, r.TypedSpec().DHCP4)
 						case "configuration/dhcp4/eth4.25":
 							suite.Assert().Equal("eth4.25", r.TypedSpec().LinkName)
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP4.RouteMetric)
@@ -420,6 +432,15 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 							DeviceDHCPOptions: &v1alpha1.DHCPOptions{
 								DHCPIPv6:        pointer.To(true),
 								DHCPRouteMetric: 512,
+								DHCPPrefixDelegation: &v1alpha1.DHCPPrefixDelegation{
+									PrefixDelegationPrefixLength: 56,
+									PrefixDelegationSubnets: []*v1alpha1.DHCPPrefixDelegationSubnet{
+										{
+											SubnetInterface: "eth4",
+											SubnetID:        1,
+										},
+									},
+								},
 							},
 						},
 					},
@@ -452,9 +473,20 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 						case "configuration/dhcp6/eth2":
 							suite.Assert().Equal("eth2", r.TypedSpec().LinkName)
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().DHCP6.RouteMetric)
+							suite.Assert().False(r.TypedSpec().DHCP6.PrefixDelegation)
 						case "configuration/dhcp6/eth3":
 							suite.Assert().Equal("eth3", r.TypedSpec().LinkName)
-							suite.Assert().EqualValues(512, r.TypedSpec().DHCP6.RouteMetric)
+							suite.Assert().Equal(network.DHCP6OperatorSpec{
+								RouteMetric:      512,
+								PrefixDelegation: true,
+								PrefixLength:     56,
+								DelegatedSubnets: []network.DHCP6DelegatedSubnetSpec{
+									{
+										LinkName: "eth4",
+										SubnetID: 1,
+									},
+								},
+							}, r.TypedSpec().DHCP6)
 						}
 
 						return nil
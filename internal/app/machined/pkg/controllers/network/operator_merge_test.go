@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -291,7 +292,7 @@ func (suite *OperatorMergeSuite) TestMergeFlapping() {
 							return retry.ExpectedErrorf("resource phase is %s", r.Metadata().Phase())
 						}
 
-						if *override.TypedSpec() != *r.TypedSpec() {
+						if !reflect.DeepEqual(*override.TypedSpec(), *r.TypedSpec()) {
 							// using retry here, as it might not be reconciled immediately
 							return retry.ExpectedError(fmt.Errorf("not equal yet"))
 						}
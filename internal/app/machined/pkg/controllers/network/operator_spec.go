@@ -7,6 +7,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator"
 	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
@@ -26,6 +28,7 @@ import (
 type OperatorSpecController struct {
 	V1alpha1Platform v1alpha1runtime.Platform
 	State            state.State
+	StatePath        string
 
 	// Factory can be overridden for unit-testing.
 	Factory OperatorFactory
@@ -81,6 +84,10 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.DelegatedPrefixType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -151,6 +158,10 @@ func (state *operatorRunState) Stop() {
 
 // Run implements controller.Controller interface.
 func (ctrl *OperatorSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.StatePath == "" {
+		ctrl.StatePath = constants.StateMountPoint
+	}
+
 	notifyCh := make(chan struct{})
 
 	ctrl.operators = make(map[string]*operatorRunState)
@@ -232,7 +243,7 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 			// stop operator
 			ctrl.operators[id].Stop()
 			delete(ctrl.operators, id)
-		} else if *shouldRun[id] != ctrl.operators[id].Spec {
+		} else if !reflect.DeepEqual(*shouldRun[id], ctrl.operators[id].Spec) {
 			logger.Debug("replacing operator", zap.String("operator", id))
 
 			// stop operator
@@ -373,18 +384,41 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		// delegated prefixes are not merged, so they go directly to the network namespace
+		for _, delegatedPrefix := range op.Operator.DelegatedPrefixes() {
+			delegatedPrefix := delegatedPrefix
+
+			if err := apply(
+				network.NewDelegatedPrefix(
+					network.NamespaceName,
+					fmt.Sprintf("%s/%s", op.Operator.Prefix(), delegatedPrefix.Prefix),
+				),
+				func(r resource.Resource) {
+					*r.(*network.DelegatedPrefix).TypedSpec() = delegatedPrefix
+				},
+			); err != nil {
+				return fmt.Errorf("error applying delegated prefix: %w", err)
+			}
+		}
 	}
 
 	// clean up not touched specs
-	for _, resourceType := range []resource.Type{
-		network.AddressSpecType,
-		network.LinkSpecType,
-		network.RouteSpecType,
-		network.HostnameSpecType,
-		network.ResolverSpecType,
-		network.TimeServerSpecType,
+	for _, output := range []struct {
+		namespace    resource.Namespace
+		resourceType resource.Type
+	}{
+		{network.ConfigNamespaceName, network.AddressSpecType},
+		{network.ConfigNamespaceName, network.LinkSpecType},
+		{network.ConfigNamespaceName, network.RouteSpecType},
+		{network.ConfigNamespaceName, network.HostnameSpecType},
+		{network.ConfigNamespaceName, network.ResolverSpecType},
+		{network.ConfigNamespaceName, network.TimeServerSpecType},
+		{network.NamespaceName, network.DelegatedPrefixType},
 	} {
-		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, resourceType, "", resource.VersionUndefined))
+		resourceType := output.resourceType
+
+		list, err := r.List(ctx, resource.NewMetadata(output.namespace, resourceType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing specs: %w", err)
 		}
@@ -421,7 +455,7 @@ func (ctrl *OperatorSpecController) newOperator(logger *zap.Logger, spec *networ
 	case network.OperatorDHCP4:
 		logger = logger.With(zap.String("operator", "dhcp4"))
 
-		return operator.NewDHCP4(logger, spec.LinkName, spec.DHCP4, ctrl.V1alpha1Platform)
+		return operator.NewDHCP4(logger, spec.LinkName, spec.DHCP4, ctrl.V1alpha1Platform, ctrl.State, ctrl.StatePath)
 	case network.OperatorDHCP6:
 		logger = logger.With(zap.String("operator", "dhcp6"))
 
@@ -46,13 +46,14 @@ type mockOperator struct {
 	notifyCh chan<- struct{}
 	panicked bool
 
-	mu          sync.Mutex
-	addresses   []network.AddressSpecSpec
-	links       []network.LinkSpecSpec
-	routes      []network.RouteSpecSpec
-	hostname    []network.HostnameSpecSpec
-	resolvers   []network.ResolverSpecSpec
-	timeservers []network.TimeServerSpecSpec
+	mu                sync.Mutex
+	addresses         []network.AddressSpecSpec
+	links             []network.LinkSpecSpec
+	routes            []network.RouteSpecSpec
+	hostname          []network.HostnameSpecSpec
+	resolvers         []network.ResolverSpecSpec
+	timeservers       []network.TimeServerSpecSpec
+	delegatedPrefixes []network.DelegatedPrefixSpec
 }
 
 var (
@@ -137,6 +138,13 @@ func (mock *mockOperator) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return mock.timeservers
 }
 
+func (mock *mockOperator) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.delegatedPrefixes
+}
+
 func (suite *OperatorSpecSuite) newOperator(logger *zap.Logger, spec *network.OperatorSpecSpec) operator.Operator {
 	return &mockOperator{
 		spec: *spec,
@@ -496,6 +504,12 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 			ConfigLayer: network.ConfigOperator,
 		},
 	}
+	dhcpMock.delegatedPrefixes = []network.DelegatedPrefixSpec{
+		{
+			LinkName: "eth0",
+			Prefix:   netip.MustParsePrefix("2001:db8:1200::/56"),
+		},
+	}
 	dhcpMock.mu.Unlock()
 
 	dhcpMock.notify()
@@ -521,6 +535,21 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 			},
 		),
 	)
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				_, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(network.NamespaceName, network.DelegatedPrefixType, "dhcp4/eth0/2001:db8:1200::/56", resource.VersionUndefined),
+				)
+				if state.IsNotFoundError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			},
+		),
+	)
 
 	// update specs
 	dhcpMock.mu.Lock()
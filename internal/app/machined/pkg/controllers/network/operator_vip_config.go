@@ -14,11 +14,15 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
 	"github.com/talos-systems/go-procfs/procfs"
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/operator/vip"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
 
@@ -40,6 +44,12 @@ func (ctrl *OperatorVIPConfigController) Inputs() []controller.Input {
 			Type:      network.DeviceConfigSpecType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        pointer.To(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -77,6 +87,17 @@ func (ctrl *OperatorVIPConfigController) Run(ctx context.Context, r controller.R
 			return item.(*network.DeviceConfigSpec).TypedSpec().Device
 		})
 
+		machineType := machine.TypeUnknown
+
+		machineTypeRes, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine type: %w", err)
+			}
+		} else {
+			machineType = machineTypeRes.(*config.MachineType).MachineType()
+		}
+
 		ignoredInterfaces := map[string]struct{}{}
 
 		if ctrl.Cmdline != nil {
@@ -109,7 +130,7 @@ func (ctrl *OperatorVIPConfigController) Run(ctx context.Context, r controller.R
 				}
 
 				if device.VIPConfig() != nil {
-					if spec, specErr := handleVIP(ctx, device.VIPConfig(), device.Interface(), logger); specErr != nil {
+					if spec, specErr := handleVIP(ctx, device.VIPConfig(), device.Interface(), machineType, logger); specErr != nil {
 						specErrors = multierror.Append(specErrors, specErr)
 					} else {
 						specs = append(specs, spec)
@@ -119,7 +140,7 @@ func (ctrl *OperatorVIPConfigController) Run(ctx context.Context, r controller.R
 				for _, vlan := range device.Vlans() {
 					if vlan.VIPConfig() != nil {
 						linkName := fmt.Sprintf("%s.%d", device.Interface(), vlan.ID())
-						if spec, specErr := handleVIP(ctx, vlan.VIPConfig(), linkName, logger); specErr != nil {
+						if spec, specErr := handleVIP(ctx, vlan.VIPConfig(), linkName, machineType, logger); specErr != nil {
 							specErrors = multierror.Append(specErrors, specErr)
 						} else {
 							specs = append(specs, spec)
@@ -192,7 +213,8 @@ func (ctrl *OperatorVIPConfigController) apply(ctx context.Context, r controller
 	return ids, nil
 }
 
-func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName string, logger *zap.Logger) (network.OperatorSpecSpec, error) {
+//nolint:gocyclo
+func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName string, machineType machine.Type, logger *zap.Logger) (network.OperatorSpecSpec, error) {
 	var sharedIP netip.Addr
 
 	sharedIP, err := netip.ParseAddr(vlanConfig.IP())
@@ -230,9 +252,60 @@ func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName
 		if err = vip.GetNetworkAndDeviceIDs(ctx, &spec.VIP.HCloud, sharedIP); err != nil {
 			return network.OperatorSpecSpec{}, err
 		}
+	// BGP-announced VIP
+	case vlanConfig.BGP() != nil:
+		spec.VIP.GratuitousARP = false
+
+		if spec.VIP.BGP, err = vipBGPSpec(vlanConfig.BGP()); err != nil {
+			return network.OperatorSpecSpec{}, err
+		}
 	// Regular layer 2 VIP
 	default:
 	}
 
+	// worker nodes don't run etcd, so they always use Kubernetes Lease for the election
+	if leaseConfig := vlanConfig.KubernetesLease(); leaseConfig != nil || machineType == machine.TypeWorker {
+		spec.VIP.KubernetesLease.Namespace = metav1.NamespaceSystem
+
+		if leaseConfig != nil {
+			if leaseConfig.Namespace() != "" {
+				spec.VIP.KubernetesLease.Namespace = leaseConfig.Namespace()
+			}
+
+			spec.VIP.KubernetesLease.ServiceAccountToken = leaseConfig.ServiceAccountToken()
+		}
+	}
+
+	return spec, nil
+}
+
+func vipBGPSpec(bgpConfig talosconfig.VIPBGP) (network.VIPBGPSpec, error) {
+	spec := network.VIPBGPSpec{
+		LocalASN: bgpConfig.LocalASN(),
+		HoldTime: bgpConfig.HoldTime(),
+	}
+
+	if bgpConfig.RouterID() != "" {
+		routerID, err := netip.ParseAddr(bgpConfig.RouterID())
+		if err != nil {
+			return spec, fmt.Errorf("error parsing BGP router ID: %w", err)
+		}
+
+		spec.RouterID = routerID
+	}
+
+	for _, peer := range bgpConfig.Peers() {
+		addr, err := netip.ParseAddr(peer.Address())
+		if err != nil {
+			return spec, fmt.Errorf("error parsing BGP peer address: %w", err)
+		}
+
+		spec.Peers = append(spec.Peers, network.VIPBGPPeerSpec{
+			Address: addr,
+			ASN:     peer.ASN(),
+			Port:    uint32(peer.Port()),
+		})
+	}
+
 	return spec, nil
 }
@@ -27,6 +27,7 @@ import (
 	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/talos-systems/talos/pkg/logging"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
@@ -194,6 +195,176 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 	)
 }
 
+func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIPBGP() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.OperatorVIPConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkInterfaces: []*v1alpha1.Device{
+						{
+							DeviceInterface: "eth1",
+							DeviceDHCP:      pointer.To(true),
+							DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+								SharedIP: "10.5.0.1",
+								BGPConfig: &v1alpha1.VIPBGPConfig{
+									BGPLocalASN: 65000,
+									BGPRouterID: "10.0.0.10",
+									BGPHoldTime: 30 * time.Second,
+									BGPPeers: []*v1alpha1.VIPBGPPeer{
+										{
+											BGPPeerAddress: "10.0.0.1",
+											BGPPeerASN:     65001,
+										},
+										{
+											BGPPeerAddress: "10.0.1.1",
+											BGPPeerASN:     65002,
+											BGPPeerPort:    1179,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertOperators(
+					[]string{
+						"configuration/vip/eth1",
+					}, func(r *network.OperatorSpec) error {
+						suite.Assert().Equal(network.OperatorVIP, r.TypedSpec().Operator)
+						suite.Assert().EqualValues(netip.MustParseAddr("10.5.0.1"), r.TypedSpec().VIP.IP)
+						suite.Assert().False(r.TypedSpec().VIP.GratuitousARP)
+						suite.Assert().Equal(network.VIPBGPSpec{
+							LocalASN: 65000,
+							RouterID: netip.MustParseAddr("10.0.0.10"),
+							HoldTime: 30 * time.Second,
+							Peers: []network.VIPBGPPeerSpec{
+								{
+									Address: netip.MustParseAddr("10.0.0.1"),
+									ASN:     65001,
+								},
+								{
+									Address: netip.MustParseAddr("10.0.1.1"),
+									ASN:     65002,
+									Port:    1179,
+								},
+							},
+						}, r.TypedSpec().VIP.BGP)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
+func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIPKubernetesLease() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.OperatorVIPConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	machineType := config.NewMachineType()
+	machineType.SetMachineType(machine.TypeWorker)
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineType))
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkInterfaces: []*v1alpha1.Device{
+						{
+							DeviceInterface: "eth1",
+							DeviceDHCP:      pointer.To(true),
+							DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+								SharedIP: "10.5.0.1",
+							},
+						},
+						{
+							DeviceInterface: "eth2",
+							DeviceDHCP:      pointer.To(true),
+							DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+								SharedIP: "10.5.0.2",
+								KubernetesLeaseConfig: &v1alpha1.VIPKubernetesLeaseConfig{
+									LeaseNamespace:           "ingress",
+									LeaseServiceAccountToken: "token",
+								},
+							},
+						},
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertOperators(
+					[]string{
+						"configuration/vip/eth1",
+						"configuration/vip/eth2",
+					}, func(r *network.OperatorSpec) error {
+						suite.Assert().Equal(network.OperatorVIP, r.TypedSpec().Operator)
+
+						switch r.Metadata().ID() {
+						case "configuration/vip/eth1":
+							suite.Assert().EqualValues(netip.MustParseAddr("10.5.0.1"), r.TypedSpec().VIP.IP)
+							suite.Assert().Equal(network.VIPKubernetesLeaseSpec{
+								Namespace: "kube-system",
+							}, r.TypedSpec().VIP.KubernetesLease)
+						case "configuration/vip/eth2":
+							suite.Assert().EqualValues(netip.MustParseAddr("10.5.0.2"), r.TypedSpec().VIP.IP)
+							suite.Assert().Equal(network.VIPKubernetesLeaseSpec{
+								Namespace:           "ingress",
+								ServiceAccountToken: "token",
+							}, r.TypedSpec().VIP.KubernetesLease)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *OperatorVIPConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -253,6 +253,10 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 		}
 
 		route.Table = nethelpers.TableMain
+		if in.Table() != 0 {
+			route.Table = nethelpers.RoutingTable(in.Table())
+		}
+
 		route.Protocol = nethelpers.ProtocolStatic
 		route.OutLinkName = linkName
 		route.ConfigLayer = network.ConfigMachineConfiguration
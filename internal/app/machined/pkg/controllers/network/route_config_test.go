@@ -208,6 +208,11 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 									RouteGateway: "192.244.0.1",
 									RouteSource:  "192.244.0.10",
 								},
+								{
+									RouteNetwork: "10.100.0.0/16",
+									RouteGateway: "192.244.0.1",
+									RouteTable:   100,
+								},
 							},
 						},
 					},
@@ -235,6 +240,7 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 						"configuration/inet4/192.168.0.25/192.168.0.0/18/25",
 						"configuration/inet4/192.244.0.1/192.244.0.0/24/1024",
 						"configuration/inet4//169.254.254.254/32/1024",
+						"configuration/RoutingTable(100)/inet4/192.244.0.1/10.100.0.0/16/1024",
 					}, func(r *network.RouteSpec) error {
 						switch r.Metadata().ID() {
 						case "configuration/inet6/2001:470:6d:30e:8ed2:b60c:9d2f:803b//1024":
@@ -260,6 +266,10 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 							suite.Assert().EqualValues(netctrl.DefaultRouteMetric, r.TypedSpec().Priority)
 							suite.Assert().Equal(nethelpers.ScopeLink, r.TypedSpec().Scope)
 							suite.Assert().Equal("169.254.254.254/32", r.TypedSpec().Destination.String())
+						case "configuration/RoutingTable(100)/inet4/192.244.0.1/10.100.0.0/16/1024":
+							suite.Assert().Equal("eth1", r.TypedSpec().OutLinkName)
+							suite.Assert().Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+							suite.Assert().EqualValues(100, r.TypedSpec().Table)
 						}
 
 						suite.Assert().Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
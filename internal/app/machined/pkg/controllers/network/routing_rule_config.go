@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleConfigController manages network.RoutingRuleSpec based on machine configuration.
+type RoutingRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Name() string {
+	return "network.RoutingRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RoutingRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		var cfgProvider talosconfig.Provider
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.(*config.MachineConfig).Config()
+		}
+
+		// parse machine configuration for routing rules
+		if cfgProvider != nil {
+			rules := ctrl.processMachineConfiguration(logger, cfgProvider.Machine().Network().RoutingRules())
+
+			var ids []string
+
+			ids, err = ctrl.apply(ctx, r, rules)
+			if err != nil {
+				return fmt.Errorf("error applying machine configuration routing rules: %w", err)
+			}
+
+			for _, id := range ids {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		// list routing rules for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up routing rules: %w", err)
+				}
+			}
+		}
+	}
+}
+
+func (ctrl *RoutingRuleConfigController) apply(ctx context.Context, r controller.Runtime, rules []network.RoutingRuleSpecSpec) ([]resource.ID, error) {
+	ids := make([]string, 0, len(rules))
+
+	for _, rule := range rules {
+		rule := rule
+		id := network.LayeredID(rule.ConfigLayer, network.RoutingRuleID(rule.Family, rule.Priority))
+
+		if err := r.Modify(
+			ctx,
+			network.NewRoutingRuleSpec(network.ConfigNamespaceName, id),
+			func(r resource.Resource) error {
+				*r.(*network.RoutingRuleSpec).TypedSpec() = rule
+
+				return nil
+			},
+		); err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (ctrl *RoutingRuleConfigController) processMachineConfiguration(logger *zap.Logger, configRules []talosconfig.RoutingRule) (rules []network.RoutingRuleSpecSpec) {
+	convert := func(in talosconfig.RoutingRule) (rule network.RoutingRuleSpecSpec, err error) {
+		if in.Source() != "" {
+			rule.Source, err = netip.ParsePrefix(in.Source())
+			if err != nil {
+				return rule, fmt.Errorf("error parsing rule source: %w", err)
+			}
+		}
+
+		if in.Destination() != "" {
+			rule.Destination, err = netip.ParsePrefix(in.Destination())
+			if err != nil {
+				return rule, fmt.Errorf("error parsing rule destination: %w", err)
+			}
+		}
+
+		rule.IIFName = in.IIF()
+		rule.FwMark = in.FwMark()
+		rule.FwMask = in.FwMask()
+		rule.Table = nethelpers.RoutingTable(in.Table())
+		rule.Priority = in.Priority()
+		rule.Protocol = nethelpers.ProtocolStatic
+		rule.ConfigLayer = network.ConfigMachineConfiguration
+
+		return rule, nil
+	}
+
+	for _, configRule := range configRules {
+		rule, err := convert(configRule)
+		if err != nil {
+			logger.Sugar().Infof("skipping routing rule with priority %d: %s", configRule.Priority(), err)
+
+			continue
+		}
+
+		switch {
+		case rule.Source.IsValid():
+			rule.Family = familyOf(rule.Source.Addr())
+		case rule.Destination.IsValid():
+			rule.Family = familyOf(rule.Destination.Addr())
+		default:
+			// rule without source and destination applies to both address families
+			rule.Family = nethelpers.FamilyInet6
+			rules = append(rules, rule)
+
+			rule.Family = nethelpers.FamilyInet4
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func familyOf(addr netip.Addr) nethelpers.Family {
+	if addr.Is6() {
+		return nethelpers.FamilyInet6
+	}
+
+	return nethelpers.FamilyInet4
+}
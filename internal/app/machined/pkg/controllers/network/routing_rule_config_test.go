@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleConfigSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *RoutingRuleConfigSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RoutingRuleConfigController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *RoutingRuleConfigSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *RoutingRuleConfigSuite) assertRules(requiredIDs []string, check func(*network.RoutingRuleSpec) error) error {
+	missingIDs := make(map[string]struct{}, len(requiredIDs))
+
+	for _, id := range requiredIDs {
+		missingIDs[id] = struct{}{}
+	}
+
+	resources, err := suite.state.List(
+		suite.ctx,
+		resource.NewMetadata(network.ConfigNamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources.Items {
+		_, required := missingIDs[res.Metadata().ID()]
+		if !required {
+			continue
+		}
+
+		delete(missingIDs, res.Metadata().ID())
+
+		if err = check(res.(*network.RoutingRuleSpec)); err != nil {
+			return retry.ExpectedError(err)
+		}
+	}
+
+	if len(missingIDs) > 0 {
+		return retry.ExpectedError(fmt.Errorf("some resources are missing: %q", missingIDs))
+	}
+
+	return nil
+}
+
+func (suite *RoutingRuleConfigSuite) TestMachineConfiguration() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkRoutingRules: []*v1alpha1.RoutingRule{
+						{
+							RoutingRulePriority: 1000,
+							RoutingRuleSource:   "10.10.0.0/24",
+							RoutingRuleTable:    100,
+						},
+						{
+							RoutingRulePriority:    1001,
+							RoutingRuleDestination: "2001:db8::/32",
+							RoutingRuleTable:       101,
+						},
+						{
+							RoutingRulePriority: 1002,
+							RoutingRuleIIF:      "eth1",
+							RoutingRuleFwMark:   0x10,
+							RoutingRuleFwMask:   0xff,
+							RoutingRuleTable:    102,
+						},
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRules(
+					[]string{
+						"configuration/inet4/01000",
+						"configuration/inet6/01001",
+						"configuration/inet4/01002",
+						"configuration/inet6/01002",
+					}, func(r *network.RoutingRuleSpec) error {
+						switch r.Metadata().ID() {
+						case "configuration/inet4/01000":
+							suite.Assert().Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+							suite.Assert().Equal(netip.MustParsePrefix("10.10.0.0/24"), r.TypedSpec().Source)
+							suite.Assert().EqualValues(100, r.TypedSpec().Table)
+						case "configuration/inet6/01001":
+							suite.Assert().Equal(nethelpers.FamilyInet6, r.TypedSpec().Family)
+							suite.Assert().Equal(netip.MustParsePrefix("2001:db8::/32"), r.TypedSpec().Destination)
+							suite.Assert().EqualValues(101, r.TypedSpec().Table)
+						case "configuration/inet4/01002", "configuration/inet6/01002":
+							suite.Assert().Equal("eth1", r.TypedSpec().IIFName)
+							suite.Assert().EqualValues(0x10, r.TypedSpec().FwMark)
+							suite.Assert().EqualValues(0xff, r.TypedSpec().FwMask)
+							suite.Assert().EqualValues(102, r.TypedSpec().Table)
+						}
+
+						suite.Assert().Equal(nethelpers.ProtocolStatic, r.TypedSpec().Protocol)
+						suite.Assert().Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
+func (suite *RoutingRuleConfigSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	err := suite.state.Create(
+		context.Background(), config.NewMachineConfig(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{},
+			},
+		),
+	)
+	if state.IsConflictError(err) {
+		err = suite.state.Destroy(context.Background(), config.NewMachineConfig(nil).Metadata())
+	}
+
+	suite.Require().NoError(err)
+}
+
+func TestRoutingRuleConfigSuite(t *testing.T) {
+	suite.Run(t, new(RoutingRuleConfigSuite))
+}
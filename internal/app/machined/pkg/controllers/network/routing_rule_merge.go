@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleMergeController merges network.RoutingRuleSpec in network.ConfigNamespace and produces final network.RoutingRuleSpec in network.Namespace.
+type RoutingRuleMergeController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleMergeController) Name() string {
+	return "network.RoutingRuleMergeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleMergeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.ConfigNamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleMergeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RoutingRuleMergeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source network routing rules: %w", err)
+		}
+
+		// rule is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
+		rules := map[string]*network.RoutingRuleSpec{}
+
+		for _, res := range list.Items {
+			rule := res.(*network.RoutingRuleSpec) //nolint:errcheck,forcetypeassert
+			id := network.RoutingRuleID(rule.TypedSpec().Family, rule.TypedSpec().Priority)
+
+			existing, ok := rules[id]
+			if ok && existing.TypedSpec().ConfigLayer > rule.TypedSpec().ConfigLayer {
+				// skip this rule, as existing one is higher layer
+				continue
+			}
+
+			rules[id] = rule
+		}
+
+		conflictsDetected := 0
+
+		for id, rule := range rules {
+			rule := rule
+
+			if err = r.Modify(ctx, network.NewRoutingRuleSpec(network.NamespaceName, id), func(res resource.Resource) error {
+				rt := res.(*network.RoutingRuleSpec) //nolint:errcheck,forcetypeassert
+
+				*rt.TypedSpec() = *rule.TypedSpec()
+
+				return nil
+			}); err != nil {
+				if state.IsPhaseConflictError(err) {
+					// phase conflict, resource is being torn down, skip updating it and trigger reconcile
+					// later by failing the
+					conflictsDetected++
+
+					delete(rules, id)
+				} else {
+					return fmt.Errorf("error updating resource: %w", err)
+				}
+			}
+		}
+
+		// list routing rules for cleanup
+		list, err = r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := rules[res.Metadata().ID()]; !ok {
+				var okToDestroy bool
+
+				okToDestroy, err = r.Teardown(ctx, res.Metadata())
+				if err != nil {
+					return fmt.Errorf("error cleaning up routing rules: %w", err)
+				}
+
+				if okToDestroy {
+					if err = r.Destroy(ctx, res.Metadata()); err != nil {
+						return fmt.Errorf("error cleaning up routing rules: %w", err)
+					}
+				}
+			}
+		}
+
+		if conflictsDetected > 0 {
+			return fmt.Errorf("%d conflict(s) detected", conflictsDetected)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleMergeSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *RoutingRuleMergeSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RoutingRuleMergeController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *RoutingRuleMergeSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *RoutingRuleMergeSuite) assertRules(requiredIDs []string, check func(*network.RoutingRuleSpec) error) error {
+	missingIDs := make(map[string]struct{}, len(requiredIDs))
+
+	for _, id := range requiredIDs {
+		missingIDs[id] = struct{}{}
+	}
+
+	resources, err := suite.state.List(
+		suite.ctx,
+		resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources.Items {
+		_, required := missingIDs[res.Metadata().ID()]
+		if !required {
+			continue
+		}
+
+		delete(missingIDs, res.Metadata().ID())
+
+		if err = check(res.(*network.RoutingRuleSpec)); err != nil {
+			return retry.ExpectedError(err)
+		}
+	}
+
+	if len(missingIDs) > 0 {
+		return retry.ExpectedError(fmt.Errorf("some resources are missing: %q", missingIDs))
+	}
+
+	return nil
+}
+
+func (suite *RoutingRuleMergeSuite) assertNoRule(id string) error {
+	resources, err := suite.state.List(
+		suite.ctx,
+		resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range resources.Items {
+		if res.Metadata().ID() == id {
+			return retry.ExpectedError(fmt.Errorf("rule %q is still there", id))
+		}
+	}
+
+	return nil
+}
+
+func (suite *RoutingRuleMergeSuite) TestMerge() {
+	operator := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "operator/inet4/01000")
+	*operator.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.10.0.0/24"),
+		Table:       100,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigOperator,
+	}
+
+	static := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "configuration/inet4/01000")
+	*static.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.20.0.0/24"),
+		Table:       200,
+		Priority:    1000,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	static6 := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "configuration/inet6/01001")
+	*static6.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		IIFName:     "eth1",
+		Table:       201,
+		Priority:    1001,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{operator, static, static6} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRules(
+					[]string{
+						"inet4/01000",
+						"inet6/01001",
+					}, func(r *network.RoutingRuleSpec) error {
+						suite.Assert().Equal(resource.PhaseRunning, r.Metadata().Phase())
+
+						switch r.Metadata().ID() {
+						case "inet4/01000":
+							suite.Assert().Equal(*static.TypedSpec(), *r.TypedSpec())
+						case "inet6/01001":
+							suite.Assert().Equal(*static6.TypedSpec(), *r.TypedSpec())
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, static.Metadata()))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRules(
+					[]string{
+						"inet4/01000",
+					}, func(r *network.RoutingRuleSpec) error {
+						if *operator.TypedSpec() != *r.TypedSpec() {
+							// using retry here, as it might not be reconciled immediately
+							return retry.ExpectedError(fmt.Errorf("not equal yet"))
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, static6.Metadata()))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRule("inet6/01001")
+			},
+		),
+	)
+}
+
+func (suite *RoutingRuleMergeSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	suite.Assert().NoError(
+		suite.state.Create(
+			context.Background(),
+			network.NewRoutingRuleSpec(network.ConfigNamespaceName, "bar"),
+		),
+	)
+}
+
+func TestRoutingRuleMergeSuite(t *testing.T) {
+	suite.Run(t, new(RoutingRuleMergeSuite))
+}
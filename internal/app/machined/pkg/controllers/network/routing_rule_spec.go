@@ -0,0 +1,369 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleSpecController applies network.RoutingRuleSpec to the kernel routing policy database.
+type RoutingRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Name() string {
+	return "network.RoutingRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to re-apply rules removed outside of Talos
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|1<<(unix.RTNLGRP_IPV6_RULE-1))
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source routing rules: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for _, res := range list.Items {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		// list rtnetlink rules
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		// loop over rules and make reconcile decision
+		for _, res := range list.Items {
+			rule := res.(*network.RoutingRuleSpec) //nolint:forcetypeassert,errcheck
+
+			if err = ctrl.syncRule(ctx, r, logger, conn, rules, rule); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+}
+
+// findRules returns the rules with the specified family and priority.
+func findRules(rules []rtnetlink.RuleMessage, family nethelpers.Family, priority uint32) []*rtnetlink.RuleMessage {
+	var result []*rtnetlink.RuleMessage //nolint:prealloc
+
+	for i, rule := range rules {
+		if rule.Family != uint8(family) {
+			continue
+		}
+
+		if ruleAttributes(&rule).priority() != priority {
+			continue
+		}
+
+		result = append(result, &rules[i])
+	}
+
+	return result
+}
+
+// ruleAttributesWrapper provides access to the optional rule attributes.
+type ruleAttributesWrapper struct {
+	rtnetlink.RuleMessage
+}
+
+func ruleAttributes(msg *rtnetlink.RuleMessage) ruleAttributesWrapper {
+	w := ruleAttributesWrapper{*msg}
+
+	if w.Attributes == nil {
+		w.Attributes = &rtnetlink.RuleAttributes{}
+	}
+
+	return w
+}
+
+func optionalUint32(v *uint32) uint32 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func optionalPrefix(ip *net.IP, bits uint8) netip.Prefix {
+	if ip == nil {
+		return netip.Prefix{}
+	}
+
+	addr, _ := netip.AddrFromSlice(*ip)
+
+	return netip.PrefixFrom(addr.Unmap(), int(bits))
+}
+
+func (w ruleAttributesWrapper) priority() uint32 {
+	return optionalUint32(w.Attributes.Priority)
+}
+
+func (w ruleAttributesWrapper) source() netip.Prefix {
+	return optionalPrefix(w.Attributes.Src, w.SrcLength)
+}
+
+func (w ruleAttributesWrapper) destination() netip.Prefix {
+	return optionalPrefix(w.Attributes.Dst, w.DstLength)
+}
+
+func (w ruleAttributesWrapper) iifName() string {
+	if w.Attributes.IIFName == nil {
+		return ""
+	}
+
+	return *w.Attributes.IIFName
+}
+
+func (w ruleAttributesWrapper) fwMark() uint32 {
+	return optionalUint32(w.Attributes.FwMark)
+}
+
+func (w ruleAttributesWrapper) fwMask() uint32 {
+	return optionalUint32(w.Attributes.FwMask)
+}
+
+func (w ruleAttributesWrapper) table() nethelpers.RoutingTable {
+	if w.Attributes.Table != nil {
+		return nethelpers.RoutingTable(*w.Attributes.Table)
+	}
+
+	return nethelpers.RoutingTable(w.Table)
+}
+
+func (w ruleAttributesWrapper) protocol() nethelpers.RouteProtocol {
+	if w.Attributes.Protocol == nil {
+		return nethelpers.ProtocolUnspec
+	}
+
+	return nethelpers.RouteProtocol(*w.Attributes.Protocol)
+}
+
+// effectiveFwMask returns the firewall mark mask as the kernel reports it.
+//
+// If the mark is set without a mask, the kernel uses the full mask.
+func effectiveFwMask(fwMark, fwMask uint32) uint32 {
+	if fwMark != 0 && fwMask == 0 {
+		return 0xffffffff
+	}
+
+	return fwMask
+}
+
+func ruleMatchesSpec(existing *rtnetlink.RuleMessage, spec *network.RoutingRuleSpecSpec) bool {
+	attrs := ruleAttributes(existing)
+
+	return existing.Action == unix.FR_ACT_TO_TBL &&
+		attrs.source() == spec.Source &&
+		attrs.destination() == spec.Destination &&
+		attrs.iifName() == spec.IIFName &&
+		attrs.fwMark() == spec.FwMark &&
+		attrs.fwMask() == effectiveFwMask(spec.FwMark, spec.FwMask) &&
+		attrs.table() == spec.Table &&
+		attrs.protocol() == spec.Protocol
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *RoutingRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	rules []rtnetlink.RuleMessage, rule *network.RoutingRuleSpec,
+) error {
+	spec := rule.TypedSpec()
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for _, existing := range findRules(rules, spec.Family, spec.Priority) {
+			// only remove rules created by Talos, other rules might be managed by someone else
+			if ruleAttributes(existing).protocol() != spec.Protocol {
+				continue
+			}
+
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Info("deleted routing rule",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("table", spec.Table),
+			)
+		}
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		for _, existing := range findRules(rules, spec.Family, spec.Priority) {
+			if ruleMatchesSpec(existing, spec) {
+				matchFound = true
+
+				continue
+			}
+
+			if ruleAttributes(existing).protocol() != spec.Protocol {
+				// rule with the same priority, but not managed by Talos, leave it alone
+				continue
+			}
+
+			// delete the rule, it doesn't match the spec
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Debug("removed routing rule due to mismatch",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("old_source", ruleAttributes(existing).source()),
+				zap.Stringer("new_source", spec.Source),
+				zap.Stringer("old_destination", ruleAttributes(existing).destination()),
+				zap.Stringer("new_destination", spec.Destination),
+				zap.Stringer("old_table", ruleAttributes(existing).table()),
+				zap.Stringer("new_table", spec.Table),
+			)
+		}
+
+		if matchFound {
+			return nil
+		}
+
+		msg := buildRuleMessage(spec)
+
+		if err := conn.Rule.Add(msg); err != nil {
+			return fmt.Errorf("error adding rule: %w, message %+v", err, *msg)
+		}
+
+		logger.Info("created routing rule",
+			zap.Stringer("family", spec.Family),
+			zap.Uint32("priority", spec.Priority),
+			zap.Stringer("source", spec.Source),
+			zap.Stringer("destination", spec.Destination),
+			zap.String("iif", spec.IIFName),
+			zap.Uint32("fwmark", spec.FwMark),
+			zap.Stringer("table", spec.Table),
+		)
+	}
+
+	return nil
+}
+
+func buildRuleMessage(spec *network.RoutingRuleSpecSpec) *rtnetlink.RuleMessage {
+	priority := spec.Priority
+	table := uint32(spec.Table)
+	protocol := uint8(spec.Protocol)
+
+	msg := &rtnetlink.RuleMessage{
+		Family: uint8(spec.Family),
+		Action: unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: &priority,
+			Table:    &table,
+			Protocol: &protocol,
+		},
+	}
+
+	// tables above 255 don't fit into the header, and are passed only as an attribute
+	if table > 255 {
+		msg.Table = unix.RT_TABLE_COMPAT
+	} else {
+		msg.Table = uint8(table)
+	}
+
+	if spec.Source.IsValid() {
+		src := net.IP(spec.Source.Addr().AsSlice())
+
+		msg.SrcLength = uint8(spec.Source.Bits())
+		msg.Attributes.Src = &src
+	}
+
+	if spec.Destination.IsValid() {
+		dst := net.IP(spec.Destination.Addr().AsSlice())
+
+		msg.DstLength = uint8(spec.Destination.Bits())
+		msg.Attributes.Dst = &dst
+	}
+
+	if spec.IIFName != "" {
+		iifName := spec.IIFName
+
+		msg.Attributes.IIFName = &iifName
+	}
+
+	if spec.FwMark != 0 {
+		fwMark := spec.FwMark
+		fwMask := effectiveFwMask(spec.FwMark, spec.FwMask)
+
+		msg.Attributes.FwMark = &fwMark
+		msg.Attributes.FwMask = &fwMask
+	}
+
+	return msg
+}
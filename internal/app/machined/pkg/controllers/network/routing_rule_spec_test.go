@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"golang.org/x/sys/unix"
+
+	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleSpecSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *RoutingRuleSpecSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RoutingRuleSpecController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *RoutingRuleSpecSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *RoutingRuleSpecSuite) listRules(family uint8, priority uint32) []rtnetlink.RuleMessage {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	suite.Require().NoError(err)
+
+	var result []rtnetlink.RuleMessage
+
+	for _, rule := range rules {
+		if rule.Family == family && rule.Attributes != nil && rule.Attributes.Priority != nil && *rule.Attributes.Priority == priority {
+			result = append(result, rule)
+		}
+	}
+
+	return result
+}
+
+func (suite *RoutingRuleSpecSuite) assertRule(family uint8, priority uint32, check func(rtnetlink.RuleMessage) error) error {
+	rules := suite.listRules(family, priority)
+
+	switch len(rules) {
+	case 1:
+		return check(rules[0])
+	case 0:
+		return retry.ExpectedError(fmt.Errorf("rule with priority %d not found", priority))
+	default:
+		return retry.ExpectedError(fmt.Errorf("rule with priority %d found %d matches", priority, len(rules)))
+	}
+}
+
+func (suite *RoutingRuleSpecSuite) TestRule() {
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, "inet4/32011")
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.99.0.0/24"),
+		Table:       99,
+		Priority:    32011,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRule(unix.AF_INET, 32011, func(msg rtnetlink.RuleMessage) error {
+					suite.Assert().EqualValues(24, msg.SrcLength)
+					suite.Assert().Equal("10.99.0.0", msg.Attributes.Src.String())
+					suite.Assert().EqualValues(99, msg.Table)
+					suite.Assert().EqualValues(nethelpers.ProtocolStatic, pointer.SafeDeref(msg.Attributes.Protocol))
+
+					return nil
+				})
+			},
+		),
+	)
+
+	// update the rule, it should be replaced
+	_, err := suite.state.UpdateWithConflicts(suite.ctx, rule.Metadata(), func(r resource.Resource) error {
+		r.(*network.RoutingRuleSpec).TypedSpec().Table = 1000
+		r.(*network.RoutingRuleSpec).TypedSpec().FwMark = 0x20
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRule(unix.AF_INET, 32011, func(msg rtnetlink.RuleMessage) error {
+					if pointer.SafeDeref(msg.Attributes.Table) != 1000 {
+						return retry.ExpectedErrorf("table is %d", pointer.SafeDeref(msg.Attributes.Table))
+					}
+
+					suite.Assert().EqualValues(unix.RT_TABLE_COMPAT, msg.Table)
+					suite.Assert().EqualValues(0x20, pointer.SafeDeref(msg.Attributes.FwMark))
+					suite.Assert().EqualValues(0xffffffff, pointer.SafeDeref(msg.Attributes.FwMask))
+
+					return nil
+				})
+			},
+		),
+	)
+
+	// teardown the rule
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// torn down rule should be removed immediately
+	suite.Assert().Empty(suite.listRules(unix.AF_INET, 32011))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, rule.Metadata()))
+}
+
+func (suite *RoutingRuleSpecSuite) TestForeignRule() {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	// rule with the same priority, but not created by Talos
+	src := net.ParseIP("10.98.0.0").To4()
+
+	foreign := &rtnetlink.RuleMessage{
+		Family:    unix.AF_INET,
+		SrcLength: 16,
+		Table:     98,
+		Action:    unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Src:      &src,
+			Priority: pointer.To[uint32](32012),
+		},
+	}
+
+	suite.Require().NoError(conn.Rule.Add(foreign))
+
+	defer conn.Rule.Delete(foreign) //nolint:errcheck
+
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, "inet4/32012")
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		IIFName:     "lo",
+		Table:       97,
+		Priority:    32012,
+		Protocol:    nethelpers.ProtocolStatic,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if rules := suite.listRules(unix.AF_INET, 32012); len(rules) != 2 {
+					return retry.ExpectedErrorf("expected 2 rules, got %d", len(rules))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	// teardown the rule
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// foreign rule should be left intact
+	suite.Assert().NoError(
+		suite.assertRule(unix.AF_INET, 32012, func(msg rtnetlink.RuleMessage) error {
+			suite.Assert().EqualValues(98, msg.Table)
+
+			return nil
+		}),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, rule.Metadata()))
+}
+
+func (suite *RoutingRuleSpecSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+
+	// trigger updates in resources to stop watch loops
+	suite.Assert().NoError(suite.state.Create(context.Background(), network.NewRoutingRuleSpec(network.NamespaceName, "bar")))
+}
+
+func TestRoutingRuleSpecSuite(t *testing.T) {
+	suite.Run(t, new(RoutingRuleSpecSuite))
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/jsimonetti/rtnetlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleStatusController manages network.RoutingRuleStatus based on the kernel routing policy database.
+type RoutingRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Name() string {
+	return "network.RoutingRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RoutingRuleStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|1<<(unix.RTNLGRP_IPV6_RULE-1))
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list resources for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		itemsToDelete := map[resource.ID]struct{}{}
+
+		for _, r := range list.Items {
+			itemsToDelete[r.Metadata().ID()] = struct{}{}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		for i := range rules {
+			rule := ruleAttributes(&rules[i])
+
+			// several rules might share the same priority, the last one wins
+			id := network.RoutingRuleID(nethelpers.Family(rule.Family), rule.priority())
+
+			if err = r.Modify(ctx, network.NewRoutingRuleStatus(network.NamespaceName, id), func(r resource.Resource) error {
+				status := r.(*network.RoutingRuleStatus).TypedSpec()
+
+				status.Family = nethelpers.Family(rule.Family)
+				status.Source = rule.source()
+				status.Destination = rule.destination()
+				status.IIFName = rule.iifName()
+				status.FwMark = rule.fwMark()
+				status.FwMask = rule.fwMask()
+				status.Table = rule.table()
+				status.Priority = rule.priority()
+				status.Protocol = rule.protocol()
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+
+			delete(itemsToDelete, id)
+		}
+
+		for id := range itemsToDelete {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, id, resource.VersionUndefined)); err != nil {
+				return fmt.Errorf("error deleting routing rule status %q: %w", id, err)
+			}
+		}
+	}
+}
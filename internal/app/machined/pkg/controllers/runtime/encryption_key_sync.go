@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/encryption"
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// encryptionKeySyncRetryInterval is the interval to retry the failed keys sync.
+const encryptionKeySyncRetryInterval = time.Minute
+
+// EncryptionKeySyncController reconciles keyslots of the mounted encrypted system partitions with the machine configuration.
+type EncryptionKeySyncController struct {
+	// SyncKeys overrides the keys sync function (used in tests).
+	SyncKeys func(label string, encryptionConfig talosconfig.Encryption) (*encryption.SyncResult, error)
+
+	// RetryInterval overrides the interval to retry the failed keys sync (used in tests).
+	RetryInterval time.Duration
+
+	synced map[string]talosconfig.Encryption
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EncryptionKeySyncController) Name() string {
+	return "runtime.EncryptionKeySyncController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EncryptionKeySyncController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      runtime.MountStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EncryptionKeySyncController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.EncryptionKeySyncStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *EncryptionKeySyncController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.SyncKeys == nil {
+		ctrl.SyncKeys = mount.SystemPartitionSyncEncryptionKeys
+	}
+
+	if ctrl.RetryInterval == 0 {
+		ctrl.RetryInterval = encryptionKeySyncRetryInterval
+	}
+
+	if ctrl.synced == nil {
+		ctrl.synced = map[string]talosconfig.Encryption{}
+	}
+
+	var retryCh <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-retryCh:
+		}
+
+		retryCh = nil
+
+		cfg, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		for _, label := range []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel} {
+			if cfg == nil || cfg.Config().Machine() == nil {
+				break
+			}
+
+			encryptionConfig := cfg.Config().Machine().SystemDiskEncryption().Get(label)
+			if encryptionConfig == nil {
+				continue
+			}
+
+			_, err = safe.ReaderGet[*runtime.MountStatus](ctx, r, runtime.NewMountStatus(v1alpha1.NamespaceName, label).Metadata())
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					// partition is not mounted, keys will be synced on mount
+					continue
+				}
+
+				return fmt.Errorf("error getting mount status: %w", err)
+			}
+
+			touchedIDs[label] = struct{}{}
+
+			if reflect.DeepEqual(ctrl.synced[label], encryptionConfig) {
+				continue
+			}
+
+			var synced bool
+
+			synced, err = ctrl.syncKeys(ctx, r, logger, label, encryptionConfig, cfg.Metadata().Version().String())
+			if err != nil {
+				return err
+			}
+
+			if !synced {
+				// keys are synced again on the next config update, or after the retry interval
+				retryCh = time.After(ctrl.RetryInterval)
+
+				continue
+			}
+
+			ctrl.synced[label] = encryptionConfig
+		}
+
+		for label := range ctrl.synced {
+			if _, ok := touchedIDs[label]; !ok {
+				delete(ctrl.synced, label)
+			}
+		}
+
+		// list resources for cleanup
+		list, err := safe.ReaderList[*runtime.EncryptionKeySyncStatus](ctx, r, resource.NewMetadata(runtime.NamespaceName, runtime.EncryptionKeySyncStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if _, ok := touchedIDs[iter.Value().Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up encryption key sync status: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// syncKeys syncs the keys of the partition and updates the status.
+//
+// It returns false if the keys sync failed, the error is only returned if the status can't be updated.
+func (ctrl *EncryptionKeySyncController) syncKeys(ctx context.Context, r controller.Runtime, logger *zap.Logger,
+	label string, encryptionConfig talosconfig.Encryption, configVersion string,
+) (bool, error) {
+	if err := safe.WriterModify(ctx, r, runtime.NewEncryptionKeySyncStatus(runtime.NamespaceName, label), func(status *runtime.EncryptionKeySyncStatus) error {
+		status.TypedSpec().Phase = runtime.EncryptionKeySyncPhaseSyncing
+		status.TypedSpec().ConfigVersion = configVersion
+		status.TypedSpec().Error = ""
+
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("error updating encryption key sync status: %w", err)
+	}
+
+	logger.Info("syncing encryption keys", zap.String("partition", label))
+
+	result, syncErr := ctrl.SyncKeys(label, encryptionConfig)
+	if syncErr != nil {
+		logger.Error("failed to sync encryption keys", zap.String("partition", label), zap.Error(syncErr))
+	} else {
+		logger.Info("encryption keys synced", zap.String("partition", label),
+			zap.Ints("keyslots", result.Keyslots), zap.Ints("added", result.Added), zap.Ints("updated", result.Updated), zap.Ints("removed", result.Removed))
+	}
+
+	if err := safe.WriterModify(ctx, r, runtime.NewEncryptionKeySyncStatus(runtime.NamespaceName, label), func(status *runtime.EncryptionKeySyncStatus) error {
+		if syncErr != nil {
+			status.TypedSpec().Phase = runtime.EncryptionKeySyncPhaseFailed
+			status.TypedSpec().Error = syncErr.Error()
+
+			return nil
+		}
+
+		status.TypedSpec().Phase = runtime.EncryptionKeySyncPhaseSynced
+		status.TypedSpec().Keyslots = result.Keyslots
+		status.TypedSpec().Added = result.Added
+		status.TypedSpec().Updated = result.Updated
+		status.TypedSpec().Removed = result.Removed
+
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("error updating encryption key sync status: %w", err)
+	}
+
+	return syncErr == nil, nil
+}
@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/talos-systems/talos/internal/pkg/encryption"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	v1alpha1res "github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockKeySyncer struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (m *mockKeySyncer) SyncKeys(label string, encryptionConfig talosconfig.Encryption) (*encryption.SyncResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls[label]++
+
+	if label == constants.EphemeralPartitionLabel {
+		return nil, errors.New("no key unlocks the partition")
+	}
+
+	result := &encryption.SyncResult{}
+
+	for _, key := range encryptionConfig.Keys() {
+		result.Keyslots = append(result.Keyslots, key.Slot())
+
+		if key.Slot() != 0 {
+			result.Added = append(result.Added, key.Slot())
+		}
+	}
+
+	result.Removed = []int{3}
+
+	return result, nil
+}
+
+func (m *mockKeySyncer) Calls(label string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.calls[label]
+}
+
+func TestEncryptionKeySyncSuite(t *testing.T) {
+	syncer := &mockKeySyncer{calls: map[string]int{}}
+
+	suite.Run(t, &EncryptionKeySyncSuite{
+		syncer: syncer,
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrl.EncryptionKeySyncController{
+					SyncKeys:      syncer.SyncKeys,
+					RetryInterval: 500 * time.Millisecond,
+				}))
+			},
+		},
+	})
+}
+
+type EncryptionKeySyncSuite struct {
+	ctest.DefaultSuite
+
+	syncer *mockKeySyncer
+}
+
+func (suite *EncryptionKeySyncSuite) assertStatus(label string, check func(*assert.Assertions, *runtime.EncryptionKeySyncStatusSpec)) {
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		status, err := safe.StateGet[*runtime.EncryptionKeySyncStatus](suite.Ctx(), suite.State(), runtime.NewEncryptionKeySyncStatus(runtime.NamespaceName, label).Metadata())
+		if err != nil {
+			assert.NoError(err)
+
+			return
+		}
+
+		check(assert, status.TypedSpec())
+	}))
+}
+
+func (suite *EncryptionKeySyncSuite) TestReconcile() {
+	encryptionConfig := func(slots ...int) *v1alpha1.EncryptionConfig {
+		cfg := &v1alpha1.EncryptionConfig{
+			EncryptionProvider: "luks2",
+		}
+
+		for _, slot := range slots {
+			cfg.EncryptionKeys = append(cfg.EncryptionKeys, &v1alpha1.EncryptionKey{
+				KeyNodeID: &v1alpha1.EncryptionKeyNodeID{},
+				KeySlot:   slot,
+			})
+		}
+
+		return cfg
+	}
+
+	cfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+				StatePartition:     encryptionConfig(0),
+				EphemeralPartition: encryptionConfig(0),
+			},
+		},
+	}
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	// nothing is synced until the partitions are mounted
+	time.Sleep(500 * time.Millisecond)
+
+	suite.Assert().Zero(suite.syncer.Calls(constants.StatePartitionLabel))
+
+	for _, label := range []string{constants.StatePartitionLabel, constants.EphemeralPartitionLabel} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), runtime.NewMountStatus(v1alpha1res.NamespaceName, label)))
+	}
+
+	suite.assertStatus(constants.StatePartitionLabel, func(assert *assert.Assertions, spec *runtime.EncryptionKeySyncStatusSpec) {
+		assert.Equal(runtime.EncryptionKeySyncPhaseSynced, spec.Phase)
+		assert.Equal([]int{0}, spec.Keyslots)
+		assert.Empty(spec.Added)
+		assert.Equal([]int{3}, spec.Removed)
+	})
+
+	suite.assertStatus(constants.EphemeralPartitionLabel, func(assert *assert.Assertions, spec *runtime.EncryptionKeySyncStatusSpec) {
+		assert.Equal(runtime.EncryptionKeySyncPhaseFailed, spec.Phase)
+		assert.Equal("no key unlocks the partition", spec.Error)
+	})
+
+	// rotate the keys of the STATE partition
+	newCfg := cfg.DeepCopy()
+	newCfg.MachineConfig.MachineSystemDiskEncryption.StatePartition = encryptionConfig(0, 1)
+
+	newMachineConfig := config.NewMachineConfig(newCfg)
+	newMachineConfig.Metadata().SetVersion(machineConfig.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newMachineConfig))
+
+	machineConfig, err := safe.StateGet[*config.MachineConfig](suite.Ctx(), suite.State(), newMachineConfig.Metadata())
+	suite.Require().NoError(err)
+
+	suite.assertStatus(constants.StatePartitionLabel, func(assert *assert.Assertions, spec *runtime.EncryptionKeySyncStatusSpec) {
+		assert.Equal(runtime.EncryptionKeySyncPhaseSynced, spec.Phase)
+		assert.Equal(machineConfig.Metadata().Version().String(), spec.ConfigVersion)
+		assert.Equal([]int{0, 1}, spec.Keyslots)
+		assert.Equal([]int{1}, spec.Added)
+	})
+
+	// STATE config is synced once per change
+	suite.Assert().Equal(2, suite.syncer.Calls(constants.StatePartitionLabel))
+
+	// failed EPHEMERAL sync is retried
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		assert.GreaterOrEqual(suite.syncer.Calls(constants.EphemeralPartitionLabel), 3)
+	}))
+
+	suite.Assert().Equal(2, suite.syncer.Calls(constants.StatePartitionLabel))
+
+	// unmounted partition status is cleaned up
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), runtime.NewMountStatus(v1alpha1res.NamespaceName, constants.EphemeralPartitionLabel).Metadata()))
+
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, ctest.WrapRetry(func(assert *assert.Assertions, require *require.Assertions) {
+		_, err := suite.State().Get(suite.Ctx(), resource.NewMetadata(runtime.NamespaceName, runtime.EncryptionKeySyncStatusType, constants.EphemeralPartitionLabel, resource.VersionUndefined))
+		assert.True(state.IsNotFoundError(err))
+	}))
+}
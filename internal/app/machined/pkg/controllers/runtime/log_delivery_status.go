@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	talosruntime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+const logDeliveryStatusUpdateInterval = 15 * time.Second
+
+// LogDeliveryStatusController publishes delivery counters of the remote log senders.
+type LogDeliveryStatusController struct {
+	LoggingManager talosruntime.LoggingManager
+
+	// UpdateInterval overrides default update interval (used in tests).
+	UpdateInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LogDeliveryStatusController) Name() string {
+	return "runtime.LogDeliveryStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LogDeliveryStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LogDeliveryStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.LogDeliveryStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LogDeliveryStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	interval := ctrl.UpdateInterval
+	if interval == 0 {
+		interval = logDeliveryStatusUpdateInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := ctrl.update(ctx, r); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ctrl *LogDeliveryStatusController) update(ctx context.Context, r controller.Runtime) error {
+	touchedIDs := make(map[resource.ID]struct{})
+
+	for _, sender := range ctrl.LoggingManager.Senders() {
+		withStats, ok := sender.(talosruntime.LogSenderWithStats)
+		if !ok {
+			continue
+		}
+
+		stats := withStats.Stats()
+
+		if err := r.Modify(ctx, runtime.NewLogDeliveryStatus(runtime.NamespaceName, stats.Destination), func(res resource.Resource) error {
+			spec := res.(*runtime.LogDeliveryStatus).TypedSpec()
+
+			spec.Endpoint = stats.Endpoint
+			spec.Format = stats.Format
+			spec.Sent = stats.Sent
+			spec.Dropped = stats.Dropped
+			spec.Spooled = stats.Spooled
+			spec.SpoolSize = stats.SpoolSize
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating log delivery status: %w", err)
+		}
+
+		touchedIDs[stats.Destination] = struct{}{}
+	}
+
+	// list resources for cleanup
+	list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.LogDeliveryStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up log delivery status: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	talosruntime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+type mockLogSender struct {
+	stats talosruntime.LogSenderStats
+}
+
+func (s *mockLogSender) Send(context.Context, *talosruntime.LogEvent) error {
+	return nil
+}
+
+func (s *mockLogSender) Close(context.Context) error {
+	return nil
+}
+
+func (s *mockLogSender) Stats() talosruntime.LogSenderStats {
+	return s.stats
+}
+
+type LogDeliveryStatusSuite struct {
+	RuntimeSuite
+}
+
+func (suite *LogDeliveryStatusSuite) TestReconcile() {
+	loggingManager := logging.NewCircularBufferLoggingManager(log.Default())
+
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.LogDeliveryStatusController{
+		LoggingManager: loggingManager,
+		UpdateInterval: 100 * time.Millisecond,
+	}))
+
+	suite.startRuntime()
+
+	loggingManager.SetSenders([]talosruntime.LogSender{
+		&mockLogSender{
+			stats: talosruntime.LogSenderStats{
+				Destination: "json_lines-tcp-127.0.0.1_5140",
+				Endpoint:    "tcp://127.0.0.1:5140",
+				Format:      "json_lines",
+				Sent:        10,
+				Dropped:     1,
+				Spooled:     5,
+				SpoolSize:   1024,
+			},
+		},
+	})
+
+	md := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.LogDeliveryStatusType, "json_lines-tcp-127.0.0.1_5140", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			md,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.LogDeliveryStatus).TypedSpec()
+
+				return spec.Endpoint == "tcp://127.0.0.1:5140" &&
+					spec.Format == "json_lines" &&
+					spec.Sent == 10 &&
+					spec.Dropped == 1 &&
+					spec.Spooled == 5 &&
+					spec.SpoolSize == 1024
+			},
+		),
+	))
+
+	loggingManager.SetSenders(nil)
+
+	// wait for the resource to be removed
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, md)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return retry.ExpectedError(fmt.Errorf("resource still exists"))
+		},
+	))
+}
+
+func TestLogDeliveryStatusSuite(t *testing.T) {
+	suite.Run(t, new(LogDeliveryStatusSuite))
+}
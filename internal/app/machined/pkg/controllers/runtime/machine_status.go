@@ -6,6 +6,7 @@ package runtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -20,8 +21,10 @@ import (
 
 	k8sadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/k8s"
 	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
@@ -40,6 +43,7 @@ type MachineStatusController struct {
 
 	mu           sync.Mutex
 	currentStage runtime.MachineStage
+	bootError    string
 }
 
 // Name implements controller.Controller interface.
@@ -78,6 +82,12 @@ func (ctrl *MachineStatusController) Inputs() []controller.Input {
 			ID:        pointer.To(config.MachineTypeID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -123,15 +133,29 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 			machineType = machineTypeResource.MachineType()
 		}
 
+		machineConfig, err := safe.ReaderGet[*config.MachineConfig](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+		}
+
+		etcdRole := constants.EtcdRoleVoter
+
+		if machineConfig != nil {
+			etcdRole = machineConfig.Config().Cluster().Etcd().Role()
+		}
+
 		ctrl.mu.Lock()
 		currentStage := ctrl.currentStage
+		bootError := ctrl.bootError
 		ctrl.mu.Unlock()
 
 		ready := true
 
 		var unmetConditions []runtime.UnmetCondition
 
-		for _, check := range ctrl.getReadinessChecks(currentStage, machineType) {
+		for _, check := range ctrl.getReadinessChecks(currentStage, machineType, etcdRole, bootError) {
 			if err := check.f(ctx, r); err != nil {
 				ready = false
 
@@ -159,7 +183,7 @@ type readinessCheck struct {
 	f    func(context.Context, controller.Runtime) error
 }
 
-func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineStage, machineType machine.Type) []readinessCheck {
+func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineStage, machineType machine.Type, etcdRole, bootError string) []readinessCheck {
 	requiredServices := []string{
 		"apid",
 		"machined",
@@ -168,14 +192,32 @@ func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineSta
 
 	if machineType.IsControlPlane() {
 		requiredServices = append(requiredServices,
-			"etcd",
 			"trustd",
 		)
+
+		// control plane nodes with etcd role 'none' don't run etcd
+		if etcdRole != constants.EtcdRoleNone {
+			requiredServices = append(requiredServices,
+				"etcd",
+			)
+		}
 	}
 
 	switch stage { //nolint:exhaustive
 	case runtime.MachineStageBooting, runtime.MachineStageRunning:
-		return []readinessCheck{
+		var checks []readinessCheck
+
+		if bootError != "" {
+			// boot sequence failed (e.g. encrypted partition can't be opened), the machine won't become ready
+			checks = append(checks, readinessCheck{
+				name: "boot",
+				f: func(context.Context, controller.Runtime) error {
+					return errors.New(bootError)
+				},
+			})
+		}
+
+		return append(checks, []readinessCheck{
 			{
 				name: "time",
 				f:    ctrl.timeSyncCheck,
@@ -192,7 +234,7 @@ func (ctrl *MachineStatusController) getReadinessChecks(stage runtime.MachineSta
 				name: "staticPods",
 				f:    ctrl.staticPodsCheck,
 			},
-		}
+		}...)
 	default:
 		return nil
 	}
@@ -333,11 +375,13 @@ func (ctrl *MachineStatusController) watchEvents() {
 	ctrl.V1Alpha1Events.Watch(func(eventCh <-chan v1alpha1runtime.EventInfo) { //nolint:errcheck
 		var (
 			oldStage        runtime.MachineStage
+			oldBootError    string
 			currentSequence string
 		)
 
 		for ev := range eventCh {
 			newStage := oldStage
+			newBootError := oldBootError
 
 			switch event := ev.Event.Payload.(type) {
 			case *machineapi.SequenceEvent:
@@ -349,6 +393,7 @@ func (ctrl *MachineStatusController) watchEvents() {
 					switch event.Sequence {
 					case v1alpha1runtime.SequenceBoot.String(), v1alpha1runtime.SequenceInitialize.String():
 						newStage = runtime.MachineStageBooting
+						newBootError = ""
 					case v1alpha1runtime.SequenceInstall.String():
 						// install sequence is run always, even if the machine is already installed, so we'll catch it by phase name
 					case v1alpha1runtime.SequenceShutdown.String():
@@ -361,6 +406,11 @@ func (ctrl *MachineStatusController) watchEvents() {
 						newStage = runtime.MachineStageRebooting
 					}
 				case machineapi.SequenceEvent_NOOP:
+					// sequence failures are reported as NOOP events with the error
+					if (event.Sequence == v1alpha1runtime.SequenceBoot.String() || event.Sequence == v1alpha1runtime.SequenceInitialize.String()) &&
+						event.Error != nil && event.Error.Code == common.Code_FATAL {
+						newBootError = event.Error.Message
+					}
 				case machineapi.SequenceEvent_STOP:
 					if event.Sequence == v1alpha1runtime.SequenceBoot.String() && event.Error == nil {
 						newStage = runtime.MachineStageRunning
@@ -392,9 +442,10 @@ func (ctrl *MachineStatusController) watchEvents() {
 				}
 			}
 
-			if oldStage != newStage {
+			if oldStage != newStage || oldBootError != newBootError {
 				ctrl.mu.Lock()
 				ctrl.currentStage = newStage
+				ctrl.bootError = newBootError
 				ctrl.mu.Unlock()
 
 				select {
@@ -404,6 +455,7 @@ func (ctrl *MachineStatusController) watchEvents() {
 			}
 
 			oldStage = newStage
+			oldBootError = newBootError
 		}
 	}, v1alpha1runtime.WithTailEvents(-1))
 }
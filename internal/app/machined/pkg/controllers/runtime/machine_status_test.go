@@ -17,6 +17,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/ctest"
 	runtimectrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
 	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
@@ -92,6 +93,32 @@ func (suite *MachineStatusSuite) TestReconcile() {
 
 	suite.assertMachineStatus(runtime.MachineStageBooting, false, []string{"time", "network", "services"})
 
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.SequenceEvent{
+				Sequence: v1alpha1runtime.SequenceInitialize.String(),
+				Action:   machineapi.SequenceEvent_NOOP,
+				Error: &common.Error{
+					Code:    common.Code_FATAL,
+					Message: "sequence failed: failed to open encrypted device",
+				},
+			},
+		},
+	}
+
+	suite.assertMachineStatus(runtime.MachineStageBooting, false, []string{"boot", "time", "network", "services"})
+
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.SequenceEvent{
+				Sequence: v1alpha1runtime.SequenceInitialize.String(),
+				Action:   machineapi.SequenceEvent_START,
+			},
+		},
+	}
+
+	suite.assertMachineStatus(runtime.MachineStageBooting, false, []string{"time", "network", "services"})
+
 	machineType := config.NewMachineType()
 	machineType.SetMachineType(machine.TypeControlPlane)
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineType))
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+// ZramDevice is the name of the zram device used for swap.
+const ZramDevice = "zram0"
+
+// zramModule is the name of the kernel module providing zram devices.
+const zramModule = "zram"
+
+// SwapConfigController watches v1alpha1.Config, creates/updates/deletes swap specs.
+type SwapConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *SwapConfigController) Name() string {
+	return "runtime.SwapConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SwapConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.SwapSpecType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SwapConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.SwapSpecType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: runtime.KernelModuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *SwapConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		specs := map[resource.ID]runtime.SwapSpecSpec{}
+
+		if cfg != nil {
+			swap := cfg.(*config.MachineConfig).Config().Machine().Swap() //nolint:errcheck,forcetypeassert
+
+			if zram := swap.Zram(); zram.Enabled() {
+				specs[ZramDevice] = runtime.SwapSpecSpec{
+					Type:          runtime.SwapTypeZram,
+					Device:        "/dev/" + ZramDevice,
+					Priority:      zram.Priority(),
+					ZramSizeBytes: zram.Size(),
+					ZramAlgorithm: zram.Algorithm(),
+				}
+			}
+
+			for _, device := range swap.Devices() {
+				specs[device.Device()] = runtime.SwapSpecSpec{
+					Type:     runtime.SwapTypeDevice,
+					Device:   device.Device(),
+					Priority: device.Priority(),
+				}
+			}
+		}
+
+		for id, spec := range specs {
+			spec := spec
+
+			if err = r.Modify(ctx, runtime.NewSwapSpec(runtime.NamespaceName, id), func(res resource.Resource) error {
+				*res.(*runtime.SwapSpec).TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				if state.IsPhaseConflictError(err) {
+					// spec is being torn down, it will be re-created once the teardown is complete
+					delete(specs, id)
+				} else {
+					return fmt.Errorf("error updating swap spec: %w", err)
+				}
+			}
+		}
+
+		if _, ok := specs[ZramDevice]; ok {
+			if err = r.Modify(ctx, runtime.NewKernelModuleSpec(runtime.NamespaceName, zramModule), func(res resource.Resource) error {
+				res.(*runtime.KernelModuleSpec).TypedSpec().Name = zramModule
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating kernel module spec: %w", err)
+			}
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.SwapSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := specs[res.Metadata().ID()]; ok {
+				continue
+			}
+
+			okToDestroy, err := r.Teardown(ctx, res.Metadata())
+			if err != nil {
+				return fmt.Errorf("error tearing down swap spec: %w", err)
+			}
+
+			if okToDestroy {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up swap spec: %w", err)
+				}
+			}
+		}
+
+		list, err = r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.KernelModuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := specs[ZramDevice]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up kernel module spec: %w", err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+type SwapConfigSuite struct {
+	RuntimeSuite
+}
+
+func (suite *SwapConfigSuite) TestReconcileConfig() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.SwapConfigController{}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineSwap: &v1alpha1.SwapConfig{
+				SwapZram: &v1alpha1.ZramConfig{
+					ZramSize:      v1alpha1.DiskSize(512 * 1024 * 1024),
+					ZramAlgorithm: "zstd",
+				},
+				SwapDevices: []*v1alpha1.SwapDeviceConfig{
+					{
+						SwapDevice:   "/dev/sdb2",
+						SwapPriority: pointer.To(10),
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	zramMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.SwapSpecType, runtimecontrollers.ZramDevice, resource.VersionUndefined)
+	deviceMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.SwapSpecType, "/dev/sdb2", resource.VersionUndefined)
+	moduleMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelModuleSpecType, "zram", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			zramMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.SwapSpec).TypedSpec()
+
+				suite.Assert().Equal(runtimeresource.SwapTypeZram, spec.Type)
+				suite.Assert().Equal("/dev/zram0", spec.Device)
+				suite.Assert().EqualValues(512*1024*1024, spec.ZramSizeBytes)
+				suite.Assert().Equal("zstd", spec.ZramAlgorithm)
+				suite.Assert().Equal(100, spec.Priority)
+
+				return true
+			},
+		),
+	))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			deviceMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.SwapSpec).TypedSpec()
+
+				suite.Assert().Equal(runtimeresource.SwapTypeDevice, spec.Type)
+				suite.Assert().Equal("/dev/sdb2", spec.Device)
+				suite.Assert().Equal(10, spec.Priority)
+
+				return true
+			},
+		),
+	))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			moduleMD,
+			func(res resource.Resource) bool {
+				return res.(*runtimeresource.KernelModuleSpec).TypedSpec().Name == "zram"
+			},
+		),
+	))
+
+	old := cfg.Metadata().Version()
+	cfg = config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineSwap: &v1alpha1.SwapConfig{
+				SwapDevices: []*v1alpha1.SwapDeviceConfig{
+					{
+						SwapDevice: "/dev/sdb2",
+					},
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	cfg.Metadata().SetVersion(old)
+	suite.Require().NoError(suite.state.Update(suite.ctx, cfg))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			res, err := suite.state.Get(suite.ctx, deviceMD)
+			if err != nil {
+				return err
+			}
+
+			if res.(*runtimeresource.SwapSpec).TypedSpec().Priority != -1 {
+				return retry.ExpectedError(fmt.Errorf("priority is not updated yet"))
+			}
+
+			return nil
+		},
+	))
+
+	// wait for the zram resources to be removed
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			for _, md := range []resource.Metadata{zramMD, moduleMD} {
+				_, err := suite.state.Get(suite.ctx, md)
+				if err == nil {
+					return retry.ExpectedError(fmt.Errorf("resource %s still exists", md))
+				}
+
+				if !state.IsNotFoundError(err) {
+					return err
+				}
+			}
+
+			return nil
+		},
+	))
+}
+
+func TestSwapConfigSuite(t *testing.T) {
+	suite.Run(t, new(SwapConfigSuite))
+}
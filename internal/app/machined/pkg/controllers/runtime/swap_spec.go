@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/zram"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/makefs"
+)
+
+// swapEmptyCheckSize is the size of the device head which should be zeroed for the device to be formatted as swap.
+const swapEmptyCheckSize = 64 * 1024
+
+// SwapSpecController watches SwapSpecs, enables/disables swap areas.
+type SwapSpecController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SwapSpecController) Name() string {
+	return "runtime.SwapSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SwapSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.SwapSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SwapSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SwapSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// not supported in container mode
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.SwapSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing swap specs: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		var multiErr *multierror.Error
+
+		for _, res := range list.Items {
+			if err = ctrl.syncSwap(ctx, r, logger, res.(*runtime.SwapSpec)); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+}
+
+func findSwap(device string) (*mount.SwapArea, error) {
+	// swap areas are reported by the kernel with symlinks resolved
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+
+	areas, err := mount.ReadSwaps()
+	if err != nil {
+		return nil, fmt.Errorf("error reading swaps: %w", err)
+	}
+
+	for i := range areas {
+		if areas[i].Filename == device {
+			return &areas[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *SwapSpecController) syncSwap(ctx context.Context, r controller.Runtime, logger *zap.Logger, swap *runtime.SwapSpec) error {
+	spec := swap.TypedSpec()
+	logger = logger.With(zap.String("device", spec.Device))
+
+	active, err := findSwap(spec.Device)
+	if err != nil {
+		return err
+	}
+
+	switch swap.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		if active != nil {
+			if err = mount.Swapoff(spec.Device); err != nil {
+				return err
+			}
+
+			logger.Info("disabled swap")
+		}
+
+		if spec.Type == runtime.SwapTypeZram {
+			if err = zram.NewDevice(swap.Metadata().ID()).Reset(); err != nil {
+				return err
+			}
+		}
+
+		if err = r.RemoveFinalizer(ctx, swap.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		upToDate := active != nil && (spec.Priority < 0 || active.Priority == spec.Priority)
+
+		if spec.Type == runtime.SwapTypeZram {
+			dev := zram.NewDevice(swap.Metadata().ID())
+
+			if upToDate {
+				if upToDate, err = dev.Matches(spec.ZramSizeBytes, spec.ZramAlgorithm); err != nil {
+					return err
+				}
+			}
+
+			if upToDate {
+				return nil
+			}
+
+			if active != nil {
+				if err = mount.Swapoff(spec.Device); err != nil {
+					return err
+				}
+			}
+
+			if err = dev.Reset(); err != nil {
+				return err
+			}
+
+			if err = dev.Configure(spec.ZramSizeBytes, spec.ZramAlgorithm); err != nil {
+				return err
+			}
+
+			if err = makefs.Swap(spec.Device, makefs.WithForce(true)); err != nil {
+				return fmt.Errorf("error formatting %q as swap: %w", spec.Device, err)
+			}
+		} else {
+			if upToDate {
+				return nil
+			}
+
+			if active != nil {
+				// priority changed, the swap area has to be re-enabled
+				if err = mount.Swapoff(spec.Device); err != nil {
+					return err
+				}
+			} else if err = prepareSwapDevice(logger, spec.Device); err != nil {
+				return err
+			}
+		}
+
+		if err = mount.Swapon(spec.Device, spec.Priority); err != nil {
+			return err
+		}
+
+		logger.Info("enabled swap", zap.Int("priority", spec.Priority))
+	}
+
+	return nil
+}
+
+// prepareSwapDevice formats the device as swap if it is empty.
+//
+// Devices with some data but without swap signature are never formatted.
+func prepareSwapDevice(logger *zap.Logger, device string) error {
+	isSwap, err := makefs.IsSwap(device)
+	if err != nil {
+		return fmt.Errorf("error probing %q: %w", device, err)
+	}
+
+	if isSwap {
+		return nil
+	}
+
+	f, err := os.Open(device)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	buf := make([]byte, swapEmptyCheckSize)
+
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("error reading %q: %w", device, err)
+	}
+
+	if !bytes.Equal(buf[:n], make([]byte, n)) {
+		return fmt.Errorf("device %q is not empty and doesn't contain swap signature, refusing to format it", device)
+	}
+
+	logger.Info("formatting device as swap")
+
+	if err = makefs.Swap(device); err != nil {
+		return fmt.Errorf("error formatting %q as swap: %w", device, err)
+	}
+
+	return nil
+}
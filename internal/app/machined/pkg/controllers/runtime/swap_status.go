@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+// SwapStatusController reports the status of active swap areas.
+type SwapStatusController struct {
+	// ProcSwapsPath is the path to the list of swap areas, defaults to /proc/swaps.
+	ProcSwapsPath string
+	// Interval is the polling interval, defaults to 30 seconds.
+	Interval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SwapStatusController) Name() string {
+	return "runtime.SwapStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SwapStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.SwapSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SwapStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.SwapStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SwapStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.ProcSwapsPath == "" {
+		ctrl.ProcSwapsPath = mount.ProcSwaps
+	}
+
+	if ctrl.Interval == 0 {
+		ctrl.Interval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(ctrl.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.reconcile(ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *SwapStatusController) reconcile(ctx context.Context, r controller.Runtime) error {
+	f, err := os.Open(ctrl.ProcSwapsPath)
+	if err != nil {
+		return fmt.Errorf("error opening swaps: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	areas, err := mount.ParseSwaps(f)
+	if err != nil {
+		return fmt.Errorf("error reading swaps: %w", err)
+	}
+
+	touchedIDs := make(map[resource.ID]struct{}, len(areas))
+
+	for _, area := range areas {
+		area := area
+
+		if err = r.Modify(ctx, runtime.NewSwapStatus(runtime.NamespaceName, area.Filename), func(res resource.Resource) error {
+			*res.(*runtime.SwapStatus).TypedSpec() = runtime.SwapStatusSpec{
+				Device:    area.Filename,
+				Type:      area.Type,
+				SizeBytes: area.SizeBytes,
+				UsedBytes: area.UsedBytes,
+				Priority:  area.Priority,
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating swap status: %w", err)
+		}
+
+		touchedIDs[area.Filename] = struct{}{}
+	}
+
+	list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.SwapStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up swap status: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
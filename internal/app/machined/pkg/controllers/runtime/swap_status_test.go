@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+type SwapStatusSuite struct {
+	RuntimeSuite
+}
+
+func (suite *SwapStatusSuite) TestReconcile() {
+	swapsPath := filepath.Join(suite.T().TempDir(), "swaps")
+
+	suite.Require().NoError(os.WriteFile(swapsPath, []byte(`Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	1048572		2048		100
+/dev/sdb2                               partition	524284		0		-2
+`), 0o644))
+
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.SwapStatusController{
+		ProcSwapsPath: swapsPath,
+		Interval:      100 * time.Millisecond,
+	}))
+
+	suite.startRuntime()
+
+	zramMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.SwapStatusType, "/dev/zram0", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			zramMD,
+			func(res resource.Resource) bool {
+				spec := res.(*runtimeresource.SwapStatus).TypedSpec()
+
+				suite.Assert().Equal("/dev/zram0", spec.Device)
+				suite.Assert().Equal("partition", spec.Type)
+				suite.Assert().EqualValues(1048572*1024, spec.SizeBytes)
+				suite.Assert().EqualValues(2048*1024, spec.UsedBytes)
+				suite.Assert().Equal(100, spec.Priority)
+
+				return true
+			},
+		),
+	))
+
+	sdbMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.SwapStatusType, "/dev/sdb2", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			sdbMD,
+			func(res resource.Resource) bool {
+				return res.(*runtimeresource.SwapStatus).TypedSpec().Priority == -2
+			},
+		),
+	))
+
+	suite.Require().NoError(os.WriteFile(swapsPath, []byte(`Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	1048572		4096		100
+`), 0o644))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			res, err := suite.state.Get(suite.ctx, zramMD)
+			if err != nil {
+				return err
+			}
+
+			if res.(*runtimeresource.SwapStatus).TypedSpec().UsedBytes != 4096*1024 {
+				return retry.ExpectedError(fmt.Errorf("usage is not updated yet"))
+			}
+
+			return nil
+		},
+	))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, sdbMD)
+			if err == nil {
+				return retry.ExpectedError(fmt.Errorf("resource still exists"))
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		},
+	))
+}
+
+func TestSwapStatusSuite(t *testing.T) {
+	suite.Run(t, new(SwapStatusSuite))
+}
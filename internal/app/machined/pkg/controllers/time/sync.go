@@ -68,6 +68,8 @@ type NTPSyncer interface {
 	Run(ctx context.Context)
 	Synced() <-chan struct{}
 	EpochChange() <-chan struct{}
+	StatusChange() <-chan struct{}
+	SyncServer() (server string, authenticated bool)
 	SetTimeServers([]string)
 }
 
@@ -93,9 +95,10 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 		syncCtxCancel context.CancelFunc
 		syncWg        sync.WaitGroup
 
-		syncCh  <-chan struct{}
-		epochCh <-chan struct{}
-		syncer  NTPSyncer
+		syncCh   <-chan struct{}
+		epochCh  <-chan struct{}
+		statusCh <-chan struct{}
+		syncer   NTPSyncer
 
 		timeSynced bool
 		epoch      int
@@ -126,6 +129,7 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		case <-epochCh:
 			epoch++
+		case <-statusCh:
 		case <-timeSyncTimeoutCh:
 			timeSynced = true
 			timeSyncTimeoutTimer = nil
@@ -199,11 +203,13 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncer = nil
 			syncCh = nil
 			epochCh = nil
+			statusCh = nil
 		case !syncDisabled && syncer == nil:
 			// start syncing
 			syncer = ctrl.NewNTPSyncer(logger, timeServers)
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
+			statusCh = syncer.StatusChange()
 
 			timeSynced = false
 
@@ -226,11 +232,22 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		}
 
+		var (
+			syncServer    string
+			authenticated bool
+		)
+
+		if syncer != nil {
+			syncServer, authenticated = syncer.SyncServer()
+		}
+
 		if err = r.Modify(ctx, time.NewStatus(), func(r resource.Resource) error {
 			*r.(*time.Status).TypedSpec() = time.StatusSpec{
-				Epoch:        epoch,
-				Synced:       timeSynced,
-				SyncDisabled: syncDisabled,
+				Epoch:         epoch,
+				Synced:        timeSynced,
+				SyncDisabled:  syncDisabled,
+				SyncServer:    syncServer,
+				Authenticated: authenticated,
 			}
 
 			return nil
@@ -355,6 +355,24 @@ func (suite *SyncSuite) TestReconcileSyncChangeConfig() {
 		),
 	)
 
+	mockSyncer.setSyncServer("nts://time.cloudflare.com", true)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced:        true,
+						Epoch:         1,
+						SyncDisabled:  false,
+						SyncServer:    "nts://time.cloudflare.com",
+						Authenticated: true,
+					},
+				)
+			},
+		),
+	)
+
 	_, err = suite.state.UpdateWithConflicts(
 		suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
 			r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineTime = &v1alpha1.TimeConfig{
@@ -486,9 +504,12 @@ func TestSyncSuite(t *testing.T) {
 type mockSyncer struct {
 	mu sync.Mutex
 
-	timeServers []string
-	syncedCh    chan struct{}
-	epochCh     chan struct{}
+	timeServers   []string
+	syncServer    string
+	authenticated bool
+	syncedCh      chan struct{}
+	epochCh       chan struct{}
+	statusCh      chan struct{}
 }
 
 func (mock *mockSyncer) Run(ctx context.Context) {
@@ -503,6 +524,25 @@ func (mock *mockSyncer) EpochChange() <-chan struct{} {
 	return mock.epochCh
 }
 
+func (mock *mockSyncer) StatusChange() <-chan struct{} {
+	return mock.statusCh
+}
+
+func (mock *mockSyncer) SyncServer() (string, bool) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.syncServer, mock.authenticated
+}
+
+func (mock *mockSyncer) setSyncServer(server string, authenticated bool) {
+	mock.mu.Lock()
+	mock.syncServer, mock.authenticated = server, authenticated
+	mock.mu.Unlock()
+
+	mock.statusCh <- struct{}{}
+}
+
 func (mock *mockSyncer) getTimeServers() (servers []string) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -522,5 +562,6 @@ func newMockSyncer(_ *zap.Logger, servers []string) *mockSyncer {
 		timeServers: append([]string(nil), servers...),
 		syncedCh:    make(chan struct{}, 1),
 		epochCh:     make(chan struct{}, 1),
+		statusCh:    make(chan struct{}, 1),
 	}
 }
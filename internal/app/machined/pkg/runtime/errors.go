@@ -20,6 +20,14 @@ var (
 
 	// ErrUndefinedRuntime indicates that the sequencer's runtime is not defined.
 	ErrUndefinedRuntime = errors.New("undefined runtime")
+
+	// ErrNoConfigToConfirm indicates that there is no config applied in 'try' mode
+	// waiting for the confirmation.
+	ErrNoConfigToConfirm = errors.New("no configuration pending confirmation")
+
+	// ErrConfigNotSettled indicates that the config applied in 'try' mode can't be
+	// confirmed yet, as the network configuration hasn't settled since the apply.
+	ErrConfigNotSettled = errors.New("configuration is not settled yet, retry the confirmation later")
 )
 
 // RebootError encapsulates unix.Reboot() cmd argument.
@@ -23,6 +23,11 @@ type LoggingManager interface {
 	//
 	// SetSenders should be thread-safe.
 	SetSenders(senders []LogSender) []LogSender
+
+	// Senders returns currently set log senders.
+	//
+	// Senders should be thread-safe.
+	Senders() []LogSender
 }
 
 // LogOptions for LogHandler.Reader.
@@ -85,3 +90,27 @@ type LogSender interface {
 	// Close should be thread-safe.
 	Close(ctx context.Context) error
 }
+
+// LogSenderStats describes log delivery counters of the log sender.
+type LogSenderStats struct {
+	// Destination identifies the logging destination, it is unique across the destinations.
+	Destination string
+	Endpoint    string
+	Format      string
+
+	// Sent is the number of log events delivered to the destination.
+	Sent uint64
+	// Dropped is the number of log events which were lost.
+	Dropped uint64
+	// Spooled is the number of log events which were stored in the on-disk spool.
+	Spooled uint64
+	// SpoolSize is the current size of the on-disk spool in bytes.
+	SpoolSize uint64
+}
+
+// LogSenderWithStats is implemented by log senders which keep delivery counters.
+type LogSenderWithStats interface {
+	LogSender
+
+	Stats() LogSenderStats
+}
@@ -80,6 +80,14 @@ func (manager *CircularBufferLoggingManager) SetSenders(senders []runtime.LogSen
 	return prevSenders
 }
 
+// Senders implements runtime.LoggingManager interface.
+func (manager *CircularBufferLoggingManager) Senders() []runtime.LogSender {
+	manager.sendersRW.RLock()
+	defer manager.sendersRW.RUnlock()
+
+	return manager.senders
+}
+
 // getSenders waits for senders to be set and returns them.
 func (manager *CircularBufferLoggingManager) getSenders() []runtime.LogSender {
 	for {
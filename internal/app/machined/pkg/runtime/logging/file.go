@@ -42,6 +42,11 @@ func (manager *FileLoggingManager) SetSenders([]runtime.LogSender) []runtime.Log
 	return nil
 }
 
+// Senders implements runtime.LoggingManager interface (by doing nothing).
+func (manager *FileLoggingManager) Senders() []runtime.LogSender {
+	return nil
+}
+
 type fileLogHandler struct {
 	path string
 
@@ -0,0 +1,219 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	deliverySendTimeout = 5 * time.Second
+
+	deliveryMinBackoff = time.Second
+	deliveryMaxBackoff = 30 * time.Second
+)
+
+type deliverySender struct {
+	sender      runtime.LogSender
+	destination string
+	endpoint    string
+	format      string
+
+	spool *Spool
+
+	sent    uint64
+	dropped uint64
+	spooled uint64
+
+	replayNotify chan struct{}
+	replayCtx    context.Context //nolint:containedctx
+	replayCancel context.CancelFunc
+	replayDone   chan struct{}
+}
+
+// NewDeliverySender wraps the log sender to keep delivery counters.
+//
+// Destination identifies the logging destination in the counters.
+// If spool is not nil, log events which fail to be delivered are stored in the spool
+// and replayed in order once the destination is reachable again.
+// While the spool is not empty, new log events are appended to the spool to preserve the order.
+func NewDeliverySender(sender runtime.LogSender, destination, endpoint, format string, spool *Spool) runtime.LogSenderWithStats {
+	s := &deliverySender{
+		sender:      sender,
+		destination: destination,
+		endpoint:    endpoint,
+		format:      format,
+		spool:       spool,
+		replayDone:  make(chan struct{}),
+	}
+
+	if spool == nil {
+		close(s.replayDone)
+
+		return s
+	}
+
+	s.replayCtx, s.replayCancel = context.WithCancel(context.Background())
+
+	// replay events left in the spool from the previous run
+	s.replayNotify = make(chan struct{}, 1)
+	s.replayNotify <- struct{}{}
+
+	go s.replay()
+
+	return s
+}
+
+// Send implements runtime.LogSender interface.
+func (s *deliverySender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	if s.spool != nil && s.spool.Size() > 0 {
+		if err := s.push(e); err == nil {
+			return nil
+		}
+	}
+
+	err := s.sender.Send(ctx, e)
+
+	switch {
+	case err == nil:
+		atomic.AddUint64(&s.sent, 1)
+
+		return nil
+	case errors.Is(err, runtime.ErrDontRetry):
+		atomic.AddUint64(&s.dropped, 1)
+
+		return err
+	case s.spool == nil:
+		return err
+	}
+
+	if spoolErr := s.push(e); spoolErr != nil {
+		// keep the event in memory to be resent
+		return fmt.Errorf("%w (error spooling: %s)", err, spoolErr)
+	}
+
+	return nil
+}
+
+// push stores the event in the spool and wakes up the replay goroutine.
+func (s *deliverySender) push(e *runtime.LogEvent) error {
+	if err := s.spool.Push(e); err != nil {
+		return err
+	}
+
+	atomic.AddUint64(&s.spooled, 1)
+
+	select {
+	case s.replayNotify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// replay sends spooled events in order until the spool is empty.
+func (s *deliverySender) replay() {
+	defer close(s.replayDone)
+
+	backoff := deliveryMinBackoff
+
+	for {
+		select {
+		case <-s.replayCtx.Done():
+			return
+		case <-s.replayNotify:
+		}
+
+		for {
+			e, err := s.spool.Peek()
+			if err == nil && e == nil {
+				break
+			}
+
+			if err == nil {
+				sendCtx, sendCancel := context.WithTimeout(s.replayCtx, deliverySendTimeout)
+				err = s.sender.Send(sendCtx, e)
+
+				sendCancel()
+
+				switch {
+				case err == nil:
+					atomic.AddUint64(&s.sent, 1)
+				case errors.Is(err, runtime.ErrDontRetry):
+					atomic.AddUint64(&s.dropped, 1)
+				}
+
+				if err == nil || errors.Is(err, runtime.ErrDontRetry) {
+					if err = s.spool.Pop(); err == nil {
+						backoff = deliveryMinBackoff
+
+						continue
+					}
+				}
+			}
+
+			if s.replayCtx.Err() != nil {
+				return
+			}
+
+			select {
+			case <-s.replayCtx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > deliveryMaxBackoff {
+				backoff = deliveryMaxBackoff
+			}
+		}
+	}
+}
+
+// Stats implements runtime.LogSenderWithStats interface.
+func (s *deliverySender) Stats() runtime.LogSenderStats {
+	stats := runtime.LogSenderStats{
+		Destination: s.destination,
+		Endpoint:    s.endpoint,
+		Format:      s.format,
+		Sent:        atomic.LoadUint64(&s.sent),
+		Dropped:     atomic.LoadUint64(&s.dropped),
+		Spooled:     atomic.LoadUint64(&s.spooled),
+	}
+
+	if s.spool != nil {
+		stats.Dropped += s.spool.Dropped()
+		stats.SpoolSize = s.spool.Size()
+	}
+
+	return stats
+}
+
+// Close implements runtime.LogSender interface.
+//
+// Spooled events are kept on disk to be replayed by the next sender.
+func (s *deliverySender) Close(ctx context.Context) error {
+	if s.spool != nil {
+		s.replayCancel()
+
+		select {
+		case <-s.replayDone:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if err := s.spool.Close(); err != nil {
+			return err
+		}
+	}
+
+	return s.sender.Close(ctx)
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+type mockSender struct {
+	mu       sync.Mutex
+	down     bool
+	received []string
+}
+
+func (s *mockSender) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.down = down
+}
+
+func (s *mockSender) getReceived() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.received...)
+}
+
+func (s *mockSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.down {
+		return errors.New("connection refused")
+	}
+
+	if e.Msg == "invalid" {
+		return runtime.ErrDontRetry
+	}
+
+	s.received = append(s.received, e.Msg)
+
+	return nil
+}
+
+func (s *mockSender) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestDeliverySenderNoSpool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mock := &mockSender{}
+	sender := logging.NewDeliverySender(mock, "json_lines-tcp-127.0.0.1_5140", "tcp://127.0.0.1:5140", "json_lines", nil)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{Msg: "hello"}))
+	require.ErrorIs(t, sender.Send(ctx, &runtime.LogEvent{Msg: "invalid"}), runtime.ErrDontRetry)
+
+	mock.setDown(true)
+
+	// without the spool, the event should be retried by the caller
+	require.Error(t, sender.Send(ctx, &runtime.LogEvent{Msg: "lost"}))
+
+	assert.Equal(t, runtime.LogSenderStats{
+		Destination: "json_lines-tcp-127.0.0.1_5140",
+		Endpoint:    "tcp://127.0.0.1:5140",
+		Format:      "json_lines",
+		Sent:        1,
+		Dropped:     1,
+	}, sender.Stats())
+
+	require.NoError(t, sender.Close(ctx))
+}
+
+func TestDeliverySenderSpool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mock := &mockSender{}
+	spool := logging.NewSpool(filepath.Join(t.TempDir(), "spool"), 1024*1024)
+	sender := logging.NewDeliverySender(mock, "json_lines-tcp-127.0.0.1_5140", "tcp://127.0.0.1:5140", "json_lines", spool)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{Msg: "1"}))
+
+	mock.setDown(true)
+
+	for _, msg := range []string{"2", "3", "4"} {
+		require.NoError(t, sender.Send(ctx, &runtime.LogEvent{Msg: msg}))
+	}
+
+	stats := sender.Stats()
+	assert.EqualValues(t, 1, stats.Sent)
+	assert.EqualValues(t, 3, stats.Spooled)
+	assert.NotZero(t, stats.SpoolSize)
+
+	mock.setDown(false)
+
+	// new events should be delivered after the spooled ones
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{Msg: "5"}))
+
+	assert.Eventually(t, func() bool {
+		stats = sender.Stats()
+
+		return stats.Sent == 5 && stats.SpoolSize == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, mock.getReceived())
+	assert.EqualValues(t, 0, sender.Stats().Dropped)
+
+	require.NoError(t, sender.Close(ctx))
+}
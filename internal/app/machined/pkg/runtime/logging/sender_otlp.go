@@ -0,0 +1,233 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/version"
+)
+
+type otlpSender struct {
+	endpoint  *url.URL
+	tlsConfig *tls.Config
+	hostname  string
+
+	sema   chan struct{}
+	conn   *grpc.ClientConn
+	client collogspb.LogsServiceClient
+}
+
+// NewOTLP returns log sender that sends logs to the OpenTelemetry collector using OTLP over gRPC.
+//
+// If tlsConfig is nil, plaintext connection is used.
+func NewOTLP(endpoint *url.URL, tlsConfig *tls.Config) runtime.LogSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	hostname, _ := os.Hostname() //nolint:errcheck
+
+	return &otlpSender{
+		endpoint:  endpoint,
+		tlsConfig: tlsConfig,
+		hostname:  hostname,
+		sema:      sema,
+	}
+}
+
+func (o *otlpSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-o.sema:
+		unlock = func() { o.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+// otlpSeverity maps zap log level to OTLP severity number.
+func otlpSeverity(level zapcore.Level) logspb.SeverityNumber {
+	switch level {
+	case zapcore.DebugLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case zapcore.InfoLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case zapcore.WarnLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case zapcore.ErrorLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case zapcore.DPanicLevel, zapcore.PanicLevel, zapcore.FatalLevel:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+	}
+}
+
+func otlpStringValue(s string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: s}}
+}
+
+func otlpValue(v interface{}) *commonpb.AnyValue {
+	switch v := v.(type) {
+	case string:
+		return otlpStringValue(v)
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	default:
+		return otlpStringValue(fmt.Sprint(v))
+	}
+}
+
+func (o *otlpSender) request(e *runtime.LogEvent) *collogspb.ExportLogsServiceRequest {
+	serviceName := "talos"
+
+	if service, ok := e.Fields["talos-service"].(string); ok && service != "" {
+		serviceName = service
+	}
+
+	keys := make([]string, 0, len(e.Fields))
+
+	for k := range e.Fields {
+		if k == "talos-service" {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	attributes := make([]*commonpb.KeyValue, 0, len(keys))
+
+	for _, k := range keys {
+		attributes = append(attributes, &commonpb.KeyValue{Key: k, Value: otlpValue(e.Fields[k])})
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						{Key: "service.name", Value: otlpStringValue(serviceName)},
+						{Key: "host.name", Value: otlpStringValue(o.hostname)},
+					},
+				},
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope: &commonpb.InstrumentationScope{
+							Name:    version.Name,
+							Version: version.Tag,
+						},
+						LogRecords: []*logspb.LogRecord{
+							{
+								TimeUnixNano:   uint64(e.Time.UnixNano()),
+								SeverityNumber: otlpSeverity(e.Level),
+								SeverityText:   e.Level.CapitalString(),
+								Body:           otlpStringValue(e.Msg),
+								Attributes:     attributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Send implements LogSender interface.
+func (o *otlpSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	req := o.request(e)
+
+	unlock := o.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if o.conn == nil {
+		creds := insecure.NewCredentials()
+
+		if o.tlsConfig != nil {
+			creds = credentials.NewTLS(o.tlsConfig)
+		}
+
+		conn, err := grpc.DialContext(ctx, o.endpoint.Host, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+
+		o.conn = conn
+		o.client = collogspb.NewLogsServiceClient(conn)
+	}
+
+	if _, err := o.client.Export(ctx, req); err != nil {
+		// the collector rejected the event, resending won't help
+		if status.Code(err) == codes.InvalidArgument {
+			return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (o *otlpSender) Close(ctx context.Context) error {
+	unlock := o.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if o.conn == nil {
+		return nil
+	}
+
+	conn := o.conn
+	o.conn = nil
+	o.client = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
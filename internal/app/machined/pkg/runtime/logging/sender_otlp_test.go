@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+type mockLogsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	received chan *collogspb.ExportLogsServiceRequest
+}
+
+func (s *mockLogsServer) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.received <- req
+
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func TestOTLPSend(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &mockLogsServer{
+		received: make(chan *collogspb.ExportLogsServiceRequest, 1),
+	}
+
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, srv)
+
+	go server.Serve(l) //nolint:errcheck
+
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sender := NewOTLP(&url.URL{Scheme: "tcp", Host: l.Addr().String()}, nil)
+
+	now := time.Date(2021, 10, 19, 12, 42, 37, 123456789, time.UTC)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "hello",
+		Time:  now,
+		Level: zapcore.WarnLevel,
+		Fields: map[string]interface{}{
+			"talos-service": "apid",
+			"peers":         float64(4),
+		},
+	}))
+
+	select {
+	case req := <-srv.received:
+		require.Len(t, req.ResourceLogs, 1)
+
+		assert.Equal(t, "service.name", req.ResourceLogs[0].Resource.Attributes[0].Key)
+		assert.Equal(t, "apid", req.ResourceLogs[0].Resource.Attributes[0].Value.GetStringValue())
+
+		require.Len(t, req.ResourceLogs[0].ScopeLogs, 1)
+		require.Len(t, req.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+
+		record := req.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+
+		assert.Equal(t, uint64(now.UnixNano()), record.TimeUnixNano)
+		assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_WARN, record.SeverityNumber)
+		assert.Equal(t, "hello", record.Body.GetStringValue())
+
+		require.Len(t, record.Attributes, 1)
+		assert.Equal(t, "peers", record.Attributes[0].Key)
+		assert.Equal(t, 4.0, record.Attributes[0].Value.GetDoubleValue())
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the message")
+	}
+
+	require.NoError(t, sender.Close(ctx))
+}
@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// syslogFacilityDaemon is the "system daemons" syslog facility (RFC 5424, section 6.2.1).
+	syslogFacilityDaemon = 3
+
+	// syslogSDID is the structured data ID used to carry log event fields.
+	//
+	// 32473 is the private enterprise number reserved for documentation use (RFC 5612).
+	syslogSDID = "talos@32473"
+
+	syslogNilValue = "-"
+)
+
+type syslogSender struct {
+	endpoint  *url.URL
+	tlsConfig *tls.Config
+	hostname  string
+
+	sema chan struct{}
+	conn net.Conn
+}
+
+// NewSyslog returns log sender that sends logs in RFC 5424 syslog format over TCP (octet-counting framing, RFC 6587)
+// or UDP (one message per packet, RFC 5426).
+//
+// If tlsConfig is not nil, TCP connection is established over TLS (RFC 5425).
+func NewSyslog(endpoint *url.URL, tlsConfig *tls.Config) runtime.LogSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = syslogNilValue
+	}
+
+	return &syslogSender{
+		endpoint:  endpoint,
+		tlsConfig: tlsConfig,
+		hostname:  hostname,
+		sema:      sema,
+	}
+}
+
+func (s *syslogSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-s.sema:
+		unlock = func() { s.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+// syslogSeverity maps zap log level to syslog severity (RFC 5424, section 6.2.1).
+func syslogSeverity(level zapcore.Level) int {
+	switch level {
+	case zapcore.DebugLevel:
+		return 7
+	case zapcore.InfoLevel:
+		return 6
+	case zapcore.WarnLevel:
+		return 4
+	case zapcore.ErrorLevel:
+		return 3
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return 2
+	case zapcore.FatalLevel:
+		return 0
+	default:
+		return 5
+	}
+}
+
+// syslogName sanitizes the value to be used as APP-NAME or SD-NAME.
+//
+// Only printable US-ASCII characters are allowed, '=', ' ', ']' and '"' are not allowed in SD-NAME.
+func syslogName(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+
+	if s == "" {
+		return syslogNilValue
+	}
+
+	return s
+}
+
+var syslogParamValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func (s *syslogSender) format(e *runtime.LogEvent) []byte {
+	var buf bytes.Buffer
+
+	appName := "talos"
+
+	if service, ok := e.Fields["talos-service"].(string); ok && service != "" {
+		appName = service
+	}
+
+	// HEADER: PRI VERSION SP TIMESTAMP SP HOSTNAME SP APP-NAME SP PROCID SP MSGID
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %s %s ",
+		syslogFacilityDaemon*8+syslogSeverity(e.Level),
+		e.Time.UTC().Format(time.RFC3339Nano),
+		syslogName(s.hostname, 255),
+		syslogName(appName, 48),
+		syslogNilValue,
+		syslogNilValue,
+	)
+
+	// STRUCTURED-DATA
+	keys := make([]string, 0, len(e.Fields))
+
+	for k := range e.Fields {
+		if k == "talos-service" {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	if len(keys) == 0 {
+		buf.WriteString(syslogNilValue)
+	} else {
+		sort.Strings(keys)
+
+		buf.WriteString("[" + syslogSDID)
+
+		for _, k := range keys {
+			fmt.Fprintf(&buf, ` %s="%s"`, syslogName(k, 32), syslogParamValueEscaper.Replace(fmt.Sprint(e.Fields[k])))
+		}
+
+		buf.WriteString("]")
+	}
+
+	// MSG
+	if e.Msg != "" {
+		buf.WriteString(" \xef\xbb\xbf") // UTF-8 BOM
+		buf.WriteString(e.Msg)
+	}
+
+	return buf.Bytes()
+}
+
+func (s *syslogSender) dial(ctx context.Context) (net.Conn, error) {
+	if s.tlsConfig != nil {
+		return (&tls.Dialer{Config: s.tlsConfig}).DialContext(ctx, s.endpoint.Scheme, s.endpoint.Host)
+	}
+
+	return new(net.Dialer).DialContext(ctx, s.endpoint.Scheme, s.endpoint.Host)
+}
+
+// Send implements LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	b := s.format(e)
+
+	if s.endpoint.Scheme == "tcp" {
+		b = append([]byte(strconv.Itoa(len(b))+" "), b...)
+	}
+
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	// Connect (or "connect" for UDP) if no connection is established already.
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+	}
+
+	d, _ := ctx.Deadline()
+	s.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	// Close connection on send error.
+	if n, err := s.conn.Write(b); err != nil {
+		s.conn.Close() //nolint:errcheck
+		s.conn = nil
+
+		// skip partially sent events to avoid partial duplicates in the receiver
+		if n > 0 {
+			err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (s *syslogSender) Close(ctx context.Context) error {
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	conn := s.conn
+	s.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
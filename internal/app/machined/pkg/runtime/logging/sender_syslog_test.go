@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestSyslogFormat(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 10, 19, 12, 42, 37, 123456789, time.UTC)
+
+	sender := &syslogSender{hostname: "talos-node"}
+
+	for name, tc := range map[string]struct {
+		e        *runtime.LogEvent
+		expected string
+	}{
+		"machined": {
+			e: &runtime.LogEvent{
+				Msg:   "[talos] task updateBootloader (1/1): done",
+				Time:  now,
+				Level: zapcore.InfoLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "machined",
+				},
+			},
+			expected: "<30>1 2021-10-19T12:42:37.123456789Z talos-node machined - - - \xef\xbb\xbf[talos] task updateBootloader (1/1): done",
+		},
+		"fields": {
+			e: &runtime.LogEvent{
+				Msg:   "reconfigured wireguard link",
+				Time:  now,
+				Level: zapcore.ErrorLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "controller-runtime",
+					"peers":         4,
+					"link":          `kube"span]`,
+					"bad key":       "value",
+				},
+			},
+			expected: `<27>1 2021-10-19T12:42:37.123456789Z talos-node controller-runtime - - ` +
+				`[talos@32473 bad_key="value" link="kube\"span\]" peers="4"] ` + "\xef\xbb\xbf" + `reconfigured wireguard link`,
+		},
+		"no service": {
+			e: &runtime.LogEvent{
+				Time:  now,
+				Level: zapcore.DebugLevel,
+			},
+			expected: "<31>1 2021-10-19T12:42:37.123456789Z talos-node talos - - -",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, string(sender.format(tc.e)))
+		})
+	}
+}
+
+func TestSyslogSendTCP(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer l.Close() //nolint:errcheck
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		line, _ := bufio.NewReader(conn).ReadString('>') //nolint:errcheck
+
+		received <- line
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sender := NewSyslog(&url.URL{Scheme: "tcp", Host: l.Addr().String()}, nil)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "hello",
+		Time:  time.Now(),
+		Level: zapcore.WarnLevel,
+	}))
+
+	select {
+	case line := <-received:
+		// octet-counting framing: MSG-LEN SP SYSLOG-MSG
+		assert.Regexp(t, `^\d+ <28>$`, line)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the message")
+	}
+
+	require.NoError(t, sender.Close(ctx))
+}
@@ -0,0 +1,459 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	spoolSegmentExt = ".log"
+
+	spoolMaxSegmentSize = 1024 * 1024
+	spoolMinSegmentSize = 4096
+)
+
+// spoolEntry is the on-disk representation of the log event.
+type spoolEntry struct {
+	Msg    string                 `json:"msg"`
+	Time   time.Time              `json:"time"`
+	Level  zapcore.Level          `json:"level"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+type spoolSegment struct {
+	seq    uint64
+	size   int64
+	events int
+}
+
+// Spool is an on-disk FIFO queue of log events limited in size.
+//
+// Spool stores log events in segment files (one JSON object per line) in the spool directory.
+// When the size limit is reached, oldest segments are dropped.
+//
+// Spool directory and its parent (the directory holding all the spools) are created on first use,
+// but the directory above them should exist, so that the spool is not created before the filesystem is mounted.
+// Spool keeps read position only in memory, so after a restart events
+// from the first segment might be delivered again.
+type Spool struct {
+	path        string
+	maxSize     int64
+	segmentSize int64
+
+	mu sync.Mutex
+
+	opened   bool
+	segments []spoolSegment
+	size     int64
+
+	writer *os.File
+
+	reader     *os.File
+	bufReader  *bufio.Reader
+	readOffset int64
+	readEvents int
+
+	next     *runtime.LogEvent
+	nextSize int64
+
+	dropped uint64
+}
+
+// NewSpool initializes new Spool in the given directory.
+func NewSpool(path string, maxSize uint64) *Spool {
+	segmentSize := int64(maxSize / 8)
+
+	if segmentSize > spoolMaxSegmentSize {
+		segmentSize = spoolMaxSegmentSize
+	}
+
+	if segmentSize < spoolMinSegmentSize {
+		segmentSize = spoolMinSegmentSize
+	}
+
+	return &Spool{
+		path:        path,
+		maxSize:     int64(maxSize),
+		segmentSize: segmentSize,
+	}
+}
+
+func (s *Spool) segmentPath(seq uint64) string {
+	return filepath.Join(s.path, fmt.Sprintf("%020d%s", seq, spoolSegmentExt))
+}
+
+// open creates the spool directory (and its parent) if needed, and scans it for segments left from the previous run.
+//
+// Creating the directory fails if the directory above the parent doesn't exist (e.g. filesystem is not mounted yet).
+func (s *Spool) open() error {
+	if s.opened {
+		return nil
+	}
+
+	if err := os.Mkdir(s.path, 0o700); err != nil && !errors.Is(err, os.ErrExist) {
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		// create the spools directory only if its parent exists
+		if err = os.Mkdir(filepath.Dir(s.path), 0o700); err != nil && !errors.Is(err, os.ErrExist) {
+			return err
+		}
+
+		if err = os.Mkdir(s.path, 0o700); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return err
+	}
+
+	s.segments = nil
+	s.size = 0
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), spoolSegmentExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), spoolSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		segment := spoolSegment{seq: seq}
+
+		if segment.size, segment.events, err = countSegment(s.segmentPath(seq)); err != nil {
+			return err
+		}
+
+		s.segments = append(s.segments, segment)
+		s.size += segment.size
+	}
+
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].seq < s.segments[j].seq })
+
+	s.opened = true
+
+	return nil
+}
+
+func countSegment(path string) (size int64, events int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	r := bufio.NewReader(f)
+
+	for {
+		line, err := r.ReadBytes('\n')
+
+		size += int64(len(line))
+
+		if len(line) > 0 {
+			events++
+		}
+
+		if err == io.EOF {
+			return size, events, nil
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+}
+
+// Push appends the log event to the end of the spool.
+//
+// If the spool size limit is exceeded, oldest events are dropped.
+func (s *Spool) Push(e *runtime.LogEvent) error {
+	line, err := json.Marshal(spoolEntry{
+		Msg:    e.Msg,
+		Time:   e.Time,
+		Level:  e.Level,
+		Fields: e.Fields,
+	})
+	if err != nil {
+		return err
+	}
+
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if int64(len(line)) > s.maxSize {
+		return fmt.Errorf("log event size %d exceeds spool size %d", len(line), s.maxSize)
+	}
+
+	if err = s.open(); err != nil {
+		return err
+	}
+
+	if s.writer == nil || s.segments[len(s.segments)-1].size+int64(len(line)) > s.segmentSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err = s.writer.Write(line); err != nil {
+		return err
+	}
+
+	s.segments[len(s.segments)-1].size += int64(len(line))
+	s.segments[len(s.segments)-1].events++
+	s.size += int64(len(line))
+
+	for s.size > s.maxSize && len(s.segments) > 1 {
+		if err = s.dropHead(true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rotate starts a new segment for writing.
+func (s *Spool) rotate() error {
+	var seq uint64
+
+	if len(s.segments) > 0 {
+		seq = s.segments[len(s.segments)-1].seq + 1
+	}
+
+	if s.writer != nil {
+		if err := s.writer.Close(); err != nil {
+			return err
+		}
+
+		s.writer = nil
+	}
+
+	f, err := os.OpenFile(s.segmentPath(seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	s.writer = f
+	s.segments = append(s.segments, spoolSegment{seq: seq})
+
+	return nil
+}
+
+// dropHead removes the first segment.
+func (s *Spool) dropHead(countDropped bool) error {
+	head := s.segments[0]
+
+	if countDropped {
+		dropped := head.events - s.readEvents
+
+		// the peeked event is already being delivered, so it's not lost
+		if s.next != nil {
+			dropped--
+		}
+
+		s.dropped += uint64(dropped)
+	}
+
+	if s.reader != nil {
+		if err := s.reader.Close(); err != nil {
+			return err
+		}
+
+		s.reader, s.bufReader = nil, nil
+	}
+
+	if len(s.segments) == 1 && s.writer != nil {
+		if err := s.writer.Close(); err != nil {
+			return err
+		}
+
+		s.writer = nil
+	}
+
+	s.size -= head.size
+	s.segments = s.segments[1:]
+	s.readOffset, s.readEvents = 0, 0
+	s.next, s.nextSize = nil, 0
+
+	return os.Remove(s.segmentPath(head.seq))
+}
+
+// Peek returns the first log event in the spool without removing it.
+//
+// Peek returns nil if the spool is empty.
+func (s *Spool) Peek() (*runtime.LogEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	for s.next == nil {
+		if len(s.segments) == 0 {
+			return nil, nil
+		}
+
+		if s.reader == nil {
+			f, err := os.Open(s.segmentPath(s.segments[0].seq))
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err = f.Seek(s.readOffset, io.SeekStart); err != nil {
+				f.Close() //nolint:errcheck
+
+				return nil, err
+			}
+
+			s.reader, s.bufReader = f, bufio.NewReader(f)
+		}
+
+		line, err := s.bufReader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if err == io.EOF {
+			if len(s.segments) == 1 && s.writer != nil {
+				// the only segment is being written to, so stop at the last complete event:
+				// re-open the reader at the read offset next time to not consume the partial event
+				if len(line) > 0 {
+					if err = s.reader.Close(); err != nil {
+						return nil, err
+					}
+
+					s.reader, s.bufReader = nil, nil
+				}
+
+				return nil, nil
+			}
+
+			// the segment is fully read (a partial event might be left after a crash)
+			if len(line) > 0 {
+				s.dropped++
+			}
+
+			if err = s.dropHead(false); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		var entry spoolEntry
+
+		if err = json.Unmarshal(line, &entry); err != nil {
+			s.dropped++
+			s.readOffset += int64(len(line))
+			s.readEvents++
+
+			continue
+		}
+
+		s.next = &runtime.LogEvent{
+			Msg:    entry.Msg,
+			Time:   entry.Time,
+			Level:  entry.Level,
+			Fields: entry.Fields,
+		}
+		s.nextSize = int64(len(line))
+	}
+
+	return s.next, nil
+}
+
+// Pop removes the first log event from the spool.
+//
+// Pop should be called after successful Peek.
+func (s *Spool) Pop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.next == nil {
+		return nil
+	}
+
+	s.readOffset += s.nextSize
+	s.readEvents++
+	s.next, s.nextSize = nil, 0
+
+	// remove the last segment once everything is read to free up the space
+	if len(s.segments) == 1 && s.readOffset == s.segments[0].size {
+		return s.dropHead(false)
+	}
+
+	return nil
+}
+
+// Size returns the size of log events in the spool in bytes.
+func (s *Spool) Size() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return uint64(s.size - s.readOffset)
+}
+
+// Dropped returns the number of log events dropped by the spool.
+func (s *Spool) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dropped
+}
+
+// Close closes the spool files.
+//
+// Spooled log events are kept on disk.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+
+	if s.reader != nil {
+		err = s.reader.Close()
+		s.reader, s.bufReader = nil, nil
+	}
+
+	if s.writer != nil {
+		if closeErr := s.writer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+
+		s.writer = nil
+	}
+
+	s.opened = false
+	s.segments = nil
+	s.size = 0
+	s.readOffset, s.readEvents = 0, 0
+	s.next, s.nextSize = nil, 0
+
+	return err
+}
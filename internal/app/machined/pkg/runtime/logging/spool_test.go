@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+func spoolEvent(i int) *runtime.LogEvent {
+	return &runtime.LogEvent{
+		Msg:   fmt.Sprintf("message %d", i),
+		Time:  time.Date(2021, 10, 19, 12, 42, 37, i, time.UTC),
+		Level: zapcore.InfoLevel,
+		Fields: map[string]interface{}{
+			"talos-service": "machined",
+		},
+	}
+}
+
+func drainSpool(t *testing.T, spool *logging.Spool) []string {
+	var msgs []string
+
+	for {
+		e, err := spool.Peek()
+		require.NoError(t, err)
+
+		if e == nil {
+			return msgs
+		}
+
+		msgs = append(msgs, e.Msg)
+
+		require.NoError(t, spool.Pop())
+	}
+}
+
+func TestSpoolOrder(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "spool")
+
+	spool := logging.NewSpool(dir, 1024*1024)
+
+	e, err := spool.Peek()
+	require.NoError(t, err)
+	assert.Nil(t, e)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, spool.Push(spoolEvent(i)))
+	}
+
+	e, err = spool.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, spoolEvent(0).Msg, e.Msg)
+	assert.Equal(t, spoolEvent(0).Time, e.Time)
+	assert.Equal(t, zapcore.InfoLevel, e.Level)
+	assert.Equal(t, "machined", e.Fields["talos-service"])
+
+	// peek doesn't remove the event
+	e, err = spool.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, spoolEvent(0).Msg, e.Msg)
+
+	require.NoError(t, spool.Pop())
+
+	// push while reading
+	require.NoError(t, spool.Push(spoolEvent(3)))
+
+	assert.Equal(t, []string{"message 1", "message 2", "message 3"}, drainSpool(t, spool))
+	assert.EqualValues(t, 0, spool.Size())
+	assert.EqualValues(t, 0, spool.Dropped())
+
+	// segments are removed once read
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	require.NoError(t, spool.Close())
+}
+
+func TestSpoolMaxSize(t *testing.T) {
+	t.Parallel()
+
+	spool := logging.NewSpool(filepath.Join(t.TempDir(), "spool"), 32*1024)
+
+	const numEvents = 10000
+
+	for i := 0; i < numEvents; i++ {
+		require.NoError(t, spool.Push(spoolEvent(i)))
+	}
+
+	assert.LessOrEqual(t, spool.Size(), uint64(32*1024))
+	assert.NotZero(t, spool.Dropped())
+
+	msgs := drainSpool(t, spool)
+
+	// oldest events are dropped, newest events are kept in order
+	assert.EqualValues(t, numEvents, spool.Dropped()+uint64(len(msgs)))
+	assert.Equal(t, fmt.Sprintf("message %d", numEvents-1), msgs[len(msgs)-1])
+
+	require.NoError(t, spool.Close())
+}
+
+func TestSpoolMaxSizeInFlight(t *testing.T) {
+	t.Parallel()
+
+	spool := logging.NewSpool(filepath.Join(t.TempDir(), "spool"), 32*1024)
+
+	const numEvents = 10000
+
+	require.NoError(t, spool.Push(spoolEvent(0)))
+
+	// the first event is in flight while it gets dropped from the spool
+	e, err := spool.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "message 0", e.Msg)
+
+	for i := 1; i < numEvents; i++ {
+		require.NoError(t, spool.Push(spoolEvent(i)))
+	}
+
+	require.NoError(t, spool.Pop())
+
+	msgs := drainSpool(t, spool)
+
+	// the in-flight event is delivered, so it's not counted as dropped
+	assert.EqualValues(t, numEvents, spool.Dropped()+1+uint64(len(msgs)))
+	assert.Equal(t, fmt.Sprintf("message %d", numEvents-1), msgs[len(msgs)-1])
+
+	require.NoError(t, spool.Close())
+}
+
+func TestSpoolPartialEvent(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "spool")
+
+	spool := logging.NewSpool(dir, 1024*1024)
+
+	require.NoError(t, spool.Push(spoolEvent(0)))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	path := filepath.Join(dir, entries[0].Name())
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// the event is only partially written to the segment yet
+	require.NoError(t, os.Truncate(path, int64(len(contents)-5)))
+
+	e, err := spool.Peek()
+	require.NoError(t, err)
+	assert.Nil(t, e)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+
+	_, err = f.Write(contents[len(contents)-5:])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// once the event is complete, it's read from the beginning
+	assert.Equal(t, []string{"message 0"}, drainSpool(t, spool))
+	assert.EqualValues(t, 0, spool.Dropped())
+
+	require.NoError(t, spool.Close())
+}
+
+func TestSpoolReopen(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "spool")
+
+	spool := logging.NewSpool(dir, 1024*1024)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, spool.Push(spoolEvent(i)))
+	}
+
+	require.NoError(t, spool.Close())
+
+	spool = logging.NewSpool(dir, 1024*1024)
+
+	require.NoError(t, spool.Push(spoolEvent(3)))
+
+	assert.Equal(t, []string{"message 0", "message 1", "message 2", "message 3"}, drainSpool(t, spool))
+
+	require.NoError(t, spool.Close())
+}
+
+func TestSpoolNoParent(t *testing.T) {
+	t.Parallel()
+
+	spool := logging.NewSpool(filepath.Join(t.TempDir(), "missing", "spool", "dest"), 1024*1024)
+
+	assert.Error(t, spool.Push(spoolEvent(0)))
+}
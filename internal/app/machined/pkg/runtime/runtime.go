@@ -5,6 +5,7 @@
 package runtime
 
 import (
+	"context"
 	"time"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
@@ -14,8 +15,9 @@ import (
 type Runtime interface {
 	Config() config.Provider
 	LoadAndValidateConfig([]byte) (config.Provider, error)
-	RollbackToConfigAfter([]byte, time.Duration) error
-	CancelConfigRollbackTimeout()
+	RollbackToConfigAfter(context.Context, []byte, time.Duration, bool) error
+	ConfirmConfig(context.Context) error
+	CancelConfigRollbackTimeout(context.Context)
 	SetConfig(config.Provider) error
 	CanApplyImmediate(config.Provider) error
 	State() State
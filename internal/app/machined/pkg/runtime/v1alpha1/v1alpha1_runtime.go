@@ -6,14 +6,19 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	"reflect"
 	"sync"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/google/go-cmp/cmp"
+	"github.com/siderolabs/gen/slices"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
@@ -21,7 +26,10 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	resourceruntime "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Runtime implements the Runtime interface.
@@ -31,8 +39,10 @@ type Runtime struct {
 	e runtime.EventStream
 	l runtime.LoggingManager
 
-	rollbackTimerMu sync.Mutex
-	rollbackTimer   *time.Timer
+	rollbackTimerMu   sync.Mutex
+	rollbackTimer     *time.Timer
+	rollbackPersist   bool
+	rollbackAppliedAt time.Time
 }
 
 // NewRuntime initializes and returns the v1alpha1 runtime.
@@ -65,36 +75,223 @@ func (r *Runtime) LoadAndValidateConfig(b []byte) (config.Provider, error) {
 }
 
 // RollbackToConfigAfter implements the Runtime interface.
-func (r *Runtime) RollbackToConfigAfter(cfg []byte, timeout time.Duration) error {
+//
+// If persist is set, the new config is expected to be stored on disk by the caller,
+// and the previous config is kept on disk until the new one is confirmed, so that
+// the rollback happens even if the node is rebooted before the confirmation.
+func (r *Runtime) RollbackToConfigAfter(ctx context.Context, cfg []byte, timeout time.Duration, persist bool) error {
 	cfgProvider, err := r.LoadAndValidateConfig(cfg)
 	if err != nil {
 		return err
 	}
 
-	r.CancelConfigRollbackTimeout()
+	r.CancelConfigRollbackTimeout(ctx)
+
+	if persist {
+		if err = os.WriteFile(constants.ConfigTryRollbackPath, cfg, 0o600); err != nil {
+			return fmt.Errorf("error saving the previous config: %w", err)
+		}
+	}
+
+	r.rollbackTimerMu.Lock()
+	defer r.rollbackTimerMu.Unlock()
+
+	var timer *time.Timer
+
+	timer = time.AfterFunc(timeout, func() {
+		r.rollbackTimerMu.Lock()
+		defer r.rollbackTimerMu.Unlock()
+
+		// the rollback was confirmed or cancelled while the timer was firing
+		if r.rollbackTimer != timer {
+			return
+		}
+
+		r.rollbackTimer = nil
 
-	r.rollbackTimer = time.AfterFunc(timeout, func() {
 		log.Println("rolling back the configuration")
 
-		if err := r.SetConfig(cfgProvider); err != nil {
+		if err := r.rollbackConfig(context.Background(), cfgProvider, cfg, r.rollbackPersist); err != nil {
 			log.Printf("config rollback failed %s", err)
 		}
 	})
 
-	return nil
+	r.rollbackTimer = timer
+	r.rollbackPersist = persist
+	r.rollbackAppliedAt = time.Now()
+
+	return r.updateConfigTryStatus(ctx, func(spec *resourceruntime.ConfigTryStatusSpec) {
+		spec.State = resourceruntime.ConfigTryStatePending
+		spec.Deadline = time.Now().Add(timeout)
+		spec.ConfirmationRequired = persist
+		spec.Message = "waiting for the confirmation"
+	})
+}
+
+func (r *Runtime) rollbackConfig(ctx context.Context, cfgProvider config.Provider, cfg []byte, persist bool) error {
+	if persist {
+		if err := os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+			return err
+		}
+
+		if err := os.Remove(constants.ConfigTryRollbackPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	if err := r.SetConfig(cfgProvider); err != nil {
+		return err
+	}
+
+	return r.updateConfigTryStatus(ctx, func(spec *resourceruntime.ConfigTryStatusSpec) {
+		spec.State = resourceruntime.ConfigTryStateRolledBack
+		spec.Message = "configuration was not confirmed before the deadline"
+	})
+}
+
+// ConfirmConfig implements the Runtime interface.
+//
+// The confirmation is refused with runtime.ErrConfigNotSettled until the grace period
+// has passed since the apply and the network configuration has settled, as otherwise
+// the confirmation might arrive over the network configuration being replaced.
+func (r *Runtime) ConfirmConfig(ctx context.Context) error {
+	r.rollbackTimerMu.Lock()
+	defer r.rollbackTimerMu.Unlock()
+
+	if r.rollbackTimer == nil {
+		return runtime.ErrNoConfigToConfirm
+	}
+
+	if time.Since(r.rollbackAppliedAt) < constants.ConfigTryConfirmGracePeriod {
+		return runtime.ErrConfigNotSettled
+	}
+
+	settled, err := r.networkSettled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !settled {
+		return runtime.ErrConfigNotSettled
+	}
+
+	r.rollbackTimer.Stop()
+	r.rollbackTimer = nil
+
+	if r.rollbackPersist {
+		if err := os.Remove(constants.ConfigTryRollbackPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else {
+		// config applied in 'try' mode without confirmation lives only in memory, so make it permanent
+		cfg, err := r.Config().Bytes()
+		if err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
+			return err
+		}
+	}
+
+	log.Println("configuration confirmed")
+
+	return r.updateConfigTryStatus(ctx, func(spec *resourceruntime.ConfigTryStatusSpec) {
+		spec.State = resourceruntime.ConfigTryStateConfirmed
+		spec.Message = ""
+	})
+}
+
+// networkSettled checks that the network is ready, and that the network state wasn't
+// changed within the grace period.
+func (r *Runtime) networkSettled(ctx context.Context) (bool, error) {
+	st := r.s.V1Alpha2().Resources()
+
+	status, err := safe.StateGet[*network.Status](ctx, st, resource.NewMetadata(network.NamespaceName, network.StatusType, network.StatusID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if !status.TypedSpec().AddressReady || !status.TypedSpec().ConnectivityReady {
+		return false, nil
+	}
+
+	settledSince := time.Now().Add(-constants.ConfigTryConfirmGracePeriod)
+
+	for _, resourceType := range []resource.Type{network.LinkStatusType, network.AddressStatusType, network.RouteStatusType} {
+		var items resource.List
+
+		items, err = st.List(ctx, resource.NewMetadata(network.NamespaceName, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return false, err
+		}
+
+		for _, item := range items.Items {
+			if item.Metadata().Updated().After(settledSince) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
 }
 
 // CancelConfigRollbackTimeout implements the Runtime interface.
-func (r *Runtime) CancelConfigRollbackTimeout() {
+func (r *Runtime) CancelConfigRollbackTimeout(ctx context.Context) {
 	r.rollbackTimerMu.Lock()
 	defer r.rollbackTimerMu.Unlock()
 
-	if r.rollbackTimer != nil {
-		r.rollbackTimer.Stop()
-		r.rollbackTimer = nil
+	if r.rollbackTimer == nil {
+		return
+	}
+
+	r.rollbackTimer.Stop()
+	r.rollbackTimer = nil
+
+	if r.rollbackPersist {
+		if err := os.Remove(constants.ConfigTryRollbackPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Printf("failed to remove the previous config %s", err)
+		}
+	}
+
+	if err := r.updateConfigTryStatus(ctx, func(spec *resourceruntime.ConfigTryStatusSpec) {
+		spec.State = resourceruntime.ConfigTryStateCancelled
+		spec.Message = "superseded by another configuration change"
+	}); err != nil {
+		log.Printf("failed to update config try status %s", err)
 	}
 }
 
+func (r *Runtime) updateConfigTryStatus(ctx context.Context, update func(*resourceruntime.ConfigTryStatusSpec)) error {
+	return updateConfigTryStatus(ctx, r.s.V1Alpha2().Resources(), update)
+}
+
+func updateConfigTryStatus(ctx context.Context, st state.State, update func(*resourceruntime.ConfigTryStatusSpec)) error {
+	status := resourceruntime.NewConfigTryStatus()
+
+	existing, err := st.Get(ctx, status.Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return err
+		}
+
+		update(status.TypedSpec())
+
+		return st.Create(ctx, status)
+	}
+
+	*status.TypedSpec() = existing.(*resourceruntime.ConfigTryStatus).TypedSpec().DeepCopy()
+	status.Metadata().SetVersion(existing.Metadata().Version())
+
+	update(status.TypedSpec())
+
+	return st.Update(ctx, status)
+}
+
 // SetConfig implements the Runtime interface.
 func (r *Runtime) SetConfig(cfg config.Provider) error {
 	r.c = cfg
@@ -134,6 +331,9 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	// * .machine.pods
 	// * .machine.seccompProfiles
 	// * .machine.features.kubernetesTalosAPIAccess
+	// * .machine.features.hostDNS
+	// * .machine.systemDiskEncryption.*.keys
+	// * network rule documents (NetworkRuleConfig, NetworkDefaultActionConfig)
 	newConfig.ConfigDebug = currentConfig.ConfigDebug
 	newConfig.ClusterConfig = currentConfig.ClusterConfig
 
@@ -154,6 +354,20 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 
 		if newConfig.MachineConfig.MachineFeatures != nil && currentConfig.MachineConfig.MachineFeatures != nil {
 			newConfig.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig = currentConfig.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig
+			newConfig.MachineConfig.MachineFeatures.HostDNSSupport = currentConfig.MachineConfig.MachineFeatures.HostDNSSupport
+		}
+
+		// encryption keys are synced online, but the encryption itself can't be changed
+		if newConfig.MachineConfig.MachineSystemDiskEncryption != nil && currentConfig.MachineConfig.MachineSystemDiskEncryption != nil {
+			newEncryption, currentEncryption := newConfig.MachineConfig.MachineSystemDiskEncryption, currentConfig.MachineConfig.MachineSystemDiskEncryption
+
+			if newEncryption.StatePartition != nil && currentEncryption.StatePartition != nil {
+				newEncryption.StatePartition.EncryptionKeys = currentEncryption.StatePartition.EncryptionKeys
+			}
+
+			if newEncryption.EphemeralPartition != nil && currentEncryption.EphemeralPartition != nil {
+				newEncryption.EphemeralPartition.EncryptionKeys = currentEncryption.EphemeralPartition.EncryptionKeys
+			}
 		}
 	}
 
@@ -163,9 +377,34 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 		return fmt.Errorf("this config change can't be applied in immediate mode\ndiff: %s", diff)
 	}
 
+	// network rule documents are reconciled by the network controllers, any other document change requires a reboot
+	currentDocuments, err := v1alpha1.EncodeDocuments(slices.Filter(r.Config().Documents(), requiresReboot)...)
+	if err != nil {
+		return err
+	}
+
+	newDocuments, err := v1alpha1.EncodeDocuments(slices.Filter(cfg.Documents(), requiresReboot)...)
+	if err != nil {
+		return err
+	}
+
+	if diff := cmp.Diff(string(currentDocuments), string(newDocuments)); diff != "" {
+		return fmt.Errorf("this config change can't be applied in immediate mode\ndiff: %s", diff)
+	}
+
 	return nil
 }
 
+// requiresReboot returns true if the change of the config document can't be applied immediately.
+func requiresReboot(doc config.Document) bool {
+	switch doc.(type) {
+	case config.NetworkRule, config.NetworkDefaultAction:
+		return false
+	default:
+		return true
+	}
+}
+
 // State implements the Runtime interface.
 func (r *Runtime) State() runtime.State {
 	return r.s
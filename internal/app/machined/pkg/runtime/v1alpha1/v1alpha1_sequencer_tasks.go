@@ -538,6 +538,10 @@ func LoadConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFu
 			return r.SetConfig(cfg)
 		}
 
+		if err = restoreUnconfirmedConfig(ctx, logger, r); err != nil {
+			return err
+		}
+
 		cfg, err := configloader.NewFromFile(constants.ConfigPath)
 		if err != nil {
 			logger.Printf("downloading config")
@@ -566,6 +570,29 @@ func LoadConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFu
 	}, "loadConfig"
 }
 
+// restoreUnconfirmedConfig rolls back the config applied in 'try' mode which wasn't confirmed before the reboot.
+func restoreUnconfirmedConfig(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+	if _, err := os.Stat(constants.ConfigTryRollbackPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	logger.Printf("configuration applied in 'try' mode was not confirmed, rolling back")
+
+	if err := os.Rename(constants.ConfigTryRollbackPath, constants.ConfigPath); err != nil {
+		return fmt.Errorf("error restoring the previous config: %w", err)
+	}
+
+	return updateConfigTryStatus(ctx, r.State().V1Alpha2().Resources(), func(spec *resourceruntime.ConfigTryStatusSpec) {
+		spec.State = resourceruntime.ConfigTryStateRolledBack
+		spec.ConfirmationRequired = true
+		spec.Message = "configuration was not confirmed before the reboot"
+	})
+}
+
 // SaveConfig represents the SaveConfig task.
 func SaveConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -761,8 +788,14 @@ func StartAllServices(seq runtime.Sequence, data interface{}) (runtime.TaskExecu
 		case machine.TypeControlPlane:
 			serviceList = append(serviceList,
 				&services.Trustd{},
-				&services.Etcd{},
 			)
+
+			// control plane nodes with etcd role 'none' use external etcd
+			if r.Config().Cluster().Etcd().Role() != constants.EtcdRoleNone {
+				serviceList = append(serviceList,
+					&services.Etcd{},
+				)
+			}
 		case machine.TypeWorker:
 			// nothing
 		case machine.TypeUnknown:
@@ -947,6 +980,11 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 					},
 				}
 
+				if part.Swap() {
+					extraTarget.FormatOptions.PartitionType = partition.LinuxSwap
+					extraTarget.FormatOptions.FileSystemType = partition.FilesystemTypeSwap
+				}
+
 				m.Targets[disk.Device()] = append(m.Targets[disk.Device()], extraTarget)
 			}
 
@@ -964,6 +1002,10 @@ func mountDisks(r runtime.Runtime) (err error) {
 
 	for _, disk := range r.Config().Machine().Disks() {
 		for i, part := range disk.Partitions() {
+			if part.Swap() {
+				continue
+			}
+
 			var partname string
 
 			partname, err = util.PartPath(disk.Device(), i+1)
@@ -989,6 +1031,10 @@ func unmountDisks(r runtime.Runtime) (err error) {
 
 	for _, disk := range r.Config().Machine().Disks() {
 		for i, part := range disk.Partitions() {
+			if part.Swap() {
+				continue
+			}
+
 			var partname string
 
 			partname, err = util.PartPath(disk.Device(), i+1)
@@ -6,8 +6,11 @@ package v1alpha2
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,7 +18,6 @@ import (
 	osruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
-	"github.com/siderolabs/gen/slices"
 	"github.com/talos-systems/go-procfs/procfs"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -115,6 +117,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&etcd.ConfigController{},
 		&etcd.PKIController{},
 		&etcd.SpecController{},
+		&etcd.BackupController{},
+		&etcd.DefragController{},
 		&files.CRIConfigPartsController{},
 		&files.CRIRegistryConfigController{},
 		&files.EtcFileController{
@@ -163,8 +167,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
 		&network.DeviceConfigController{},
+		&network.DNSResolveCacheController{},
 		&network.EtcFileController{},
 		&network.HardwareAddrController{},
+		&network.HostDNSConfigController{},
 		&network.HostnameConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -178,6 +184,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkMergeController{},
 		&network.LinkStatusController{},
 		&network.LinkSpecController{},
+		&network.NetworkRuleConfigController{},
+		&network.NetworkRuleSpecController{},
 		&network.NodeAddressController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -205,6 +213,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteStatusController{},
 		&network.RouteSpecController{},
+		&network.RoutingRuleConfigController{},
+		&network.RoutingRuleMergeController{},
+		&network.RoutingRuleStatusController{},
+		&network.RoutingRuleSpecController{},
 		&network.StatusController{},
 		&network.TimeServerConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -212,6 +224,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.TimeServerMergeController{},
 		&network.TimeServerSpecController{},
 		&perf.StatsController{},
+		&runtimecontrollers.EncryptionKeySyncController{},
 		&runtimecontrollers.EventsSinkController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 			Cmdline:        procfs.ProcCmdline(),
@@ -235,12 +248,20 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 			Drainer: drainer,
 		},
+		&runtimecontrollers.LogDeliveryStatusController{
+			LoggingManager: ctrl.loggingManager,
+		},
 		&runtimecontrollers.MachineStatusController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
 		&runtimecontrollers.MachineStatusPublisherController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
+		&runtimecontrollers.SwapConfigController{},
+		&runtimecontrollers.SwapSpecController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.SwapStatusController{},
 		&secrets.APIController{},
 		&secrets.APICertSANsController{},
 		&secrets.EtcdController{},
@@ -279,7 +300,7 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		return
 	}
 
-	var loggingEndpoints []*url.URL
+	var loggingDestinations []talosconfig.LoggingDestination
 
 	for {
 		var cfg talosconfig.Provider
@@ -296,7 +317,7 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		}
 
 		ctrl.updateConsoleLoggingConfig(cfg)
-		ctrl.updateLoggingConfig(ctx, cfg, &loggingEndpoints)
+		ctrl.updateLoggingConfig(ctx, cfg, &loggingDestinations)
 	}
 }
 
@@ -312,24 +333,13 @@ func (ctrl *Controller) updateConsoleLoggingConfig(cfg talosconfig.Provider) {
 	}
 }
 
-func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Provider, prevLoggingEndpoints *[]*url.URL) {
+func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Provider, prevLoggingDestinations *[]talosconfig.LoggingDestination) {
 	dests := cfg.Machine().Logging().Destinations()
-	loggingEndpoints := make([]*url.URL, len(dests))
-
-	for i, dest := range dests {
-		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
-			loggingEndpoints[i] = dest.Endpoint()
-		default:
-			// should not be possible due to validation
-			panic(fmt.Sprintf("unhandled log destination format %q", f))
-		}
-	}
 
-	loggingChanged := len(*prevLoggingEndpoints) != len(loggingEndpoints)
+	loggingChanged := len(*prevLoggingDestinations) != len(dests)
 	if !loggingChanged {
-		for i, u := range *prevLoggingEndpoints {
-			if u.String() != loggingEndpoints[i].String() {
+		for i, dest := range *prevLoggingDestinations {
+			if !reflect.DeepEqual(dest, dests[i]) {
 				loggingChanged = true
 
 				break
@@ -341,19 +351,10 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig
 		return
 	}
 
-	*prevLoggingEndpoints = loggingEndpoints
+	*prevLoggingDestinations = dests
 
-	var prevSenders []runtime.LogSender
-
-	if len(loggingEndpoints) > 0 {
-		senders := slices.Map(loggingEndpoints, runtimelogging.NewJSONLines)
-
-		ctrl.logger.Info("enabling JSON logging")
-		prevSenders = ctrl.loggingManager.SetSenders(senders)
-	} else {
-		ctrl.logger.Info("disabling JSON logging")
-		prevSenders = ctrl.loggingManager.SetSenders(nil)
-	}
+	// close previous senders before creating new ones, as they might share the on-disk spool
+	prevSenders := ctrl.loggingManager.SetSenders(nil)
 
 	closeCtx, closeCancel := context.WithTimeout(ctx, 3*time.Second)
 	defer closeCancel()
@@ -374,4 +375,75 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig
 	}
 
 	wg.Wait()
+
+	senders := make([]runtime.LogSender, 0, len(dests))
+
+	for _, dest := range dests {
+		sender, err := newLogSender(dest)
+		if err != nil {
+			ctrl.logger.Error("error creating log sender", zap.Stringer("endpoint", dest.Endpoint()), zap.Error(err))
+
+			continue
+		}
+
+		senders = append(senders, sender)
+	}
+
+	if len(senders) > 0 {
+		ctrl.logger.Info("enabling remote logging")
+		ctrl.loggingManager.SetSenders(senders)
+	} else {
+		ctrl.logger.Info("disabling remote logging")
+	}
+}
+
+func newLogSender(dest talosconfig.LoggingDestination) (runtime.LogSender, error) {
+	var (
+		tlsConfig *tls.Config
+		err       error
+	)
+
+	if dest.TLS() != nil {
+		tlsConfig, err = dest.TLS().GetTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sender runtime.LogSender
+
+	switch f := dest.Format(); f {
+	case constants.LoggingFormatJSONLines:
+		sender = runtimelogging.NewJSONLines(dest.Endpoint())
+	case constants.LoggingFormatSyslog:
+		sender = runtimelogging.NewSyslog(dest.Endpoint(), tlsConfig)
+	case constants.LoggingFormatOTLP:
+		sender = runtimelogging.NewOTLP(dest.Endpoint(), tlsConfig)
+	default:
+		// should not be possible due to validation
+		panic(fmt.Sprintf("unhandled log destination format %q", f))
+	}
+
+	destination := logDestinationID(dest)
+
+	var spool *runtimelogging.Spool
+
+	if dest.Spool() != nil {
+		spool = runtimelogging.NewSpool(filepath.Join(constants.LoggingSpoolPath, destination), dest.Spool().MaxSize())
+	}
+
+	return runtimelogging.NewDeliverySender(sender, destination, dest.Endpoint().String(), dest.Format(), spool), nil
+}
+
+// logDestinationID builds the identifier of the logging destination.
+//
+// The identifier is used as the spool directory name and as the delivery status ID.
+func logDestinationID(dest talosconfig.LoggingDestination) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			return r
+		}
+
+		return '_'
+	}, fmt.Sprintf("%s-%s-%s", dest.Format(), dest.Endpoint().Scheme, dest.Endpoint().Host))
 }
@@ -93,6 +93,8 @@ func NewState() (*State, error) {
 		&config.MachineConfig{},
 		&config.MachineType{},
 		&cri.SeccompProfile{},
+		&etcd.BackupStatus{},
+		&etcd.DefragStatus{},
 		&etcd.Config{},
 		&etcd.PKIStatus{},
 		&etcd.Spec{},
@@ -130,12 +132,17 @@ func NewState() (*State, error) {
 		&network.AddressStatus{},
 		&network.AddressSpec{},
 		&network.DeviceConfigSpec{},
+		&network.DelegatedPrefix{},
+		&network.DNSUpstream{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.NetworkDefaultActionSpec{},
+		&network.NetworkRuleSpec{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -143,18 +150,25 @@ func NewState() (*State, error) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
 		&perf.CPU{},
 		&perf.Memory{},
+		&runtime.ConfigTryStatus{},
+		&runtime.EncryptionKeySyncStatus{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
+		&runtime.LogDeliveryStatus{},
 		&runtime.MachineStatus{},
 		&runtime.MountStatus{},
+		&runtime.SwapSpec{},
+		&runtime.SwapStatus{},
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
@@ -196,7 +196,9 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 
 	env = append(env, "ETCD_CIPHER_SUITES=TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305") //nolint:lll
 
-	if e.learnerMemberID != 0 {
+	if e.learnerMemberID != 0 && r.Config().Cluster().Etcd().Role() == constants.EtcdRoleLearner {
+		log.Printf("etcd member joined as a learner, it won't be promoted as configured by the etcd role")
+	} else if e.learnerMemberID != 0 {
 		var promoteCtx context.Context
 
 		promoteCtx, e.promoteCtxCancel = context.WithCancel(context.Background())
@@ -230,7 +232,7 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 }
 
 // HealthFunc implements the HealthcheckedService interface.
-func (e *Etcd) HealthFunc(runtime.Runtime) health.Check {
+func (e *Etcd) HealthFunc(r runtime.Runtime) health.Check {
 	return func(ctx context.Context) error {
 		if e.client == nil {
 			var err error
@@ -241,6 +243,11 @@ func (e *Etcd) HealthFunc(runtime.Runtime) health.Check {
 			}
 		}
 
+		// learners don't serve linearizable reads, so quorum can't be checked via learner member
+		if r.Config().Cluster().Etcd().Role() == constants.EtcdRoleLearner {
+			return e.client.ValidateLearner(ctx)
+		}
+
 		return e.client.ValidateQuorum(ctx)
 	}
 }
@@ -129,6 +129,16 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: "/var/log/pods", Source: "/var/log/pods", Options: []string{"rbind", "rshared", "rw"}},
 	}
 
+	// Mount credential provider binaries installed by system extensions.
+	if _, err = os.Stat(constants.KubeletCredentialProviderBinDir); err == nil {
+		mounts = append(mounts, specs.Mount{
+			Type:        "bind",
+			Destination: constants.KubeletCredentialProviderBinDir,
+			Source:      constants.KubeletCredentialProviderBinDir,
+			Options:     []string{"bind", "ro"},
+		})
+	}
+
 	// Add extra mounts.
 	// TODO(andrewrynhard): We should verify that the mount source is
 	// allowlisted. There is the potential that a user can expose
@@ -32,17 +32,22 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/ConfirmConfiguration":        role.MakeSet(role.Admin),
 	"/machine.MachineService/Containers":                  role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Copy":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/DiskUsage":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/EtcdAlarmDisarm":             role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdAlarmList":               role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/EtcdDefragment":              role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdForfeitLeadership":       role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdLeaveCluster":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdMemberList":              role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/EtcdRecover":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdRemoveMember":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdSnapshot":                role.MakeSet(role.Admin, role.EtcdBackup),
+	"/machine.MachineService/EtcdStatus":                  role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
@@ -53,8 +58,13 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Memory":                      role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Mounts":                      role.MakeSet(role.Admin, role.Reader),
+	"/machine.MachineService/Netstat":                     role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/NetworkDeviceStats":          role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/PacketCapture":               role.MakeSet(role.Admin),
+	"/machine.MachineService/ProbeDNS":                    role.MakeSet(role.Admin),
+	"/machine.MachineService/ProbePing":                   role.MakeSet(role.Admin),
+	"/machine.MachineService/ProbeTCP":                    role.MakeSet(role.Admin),
+	"/machine.MachineService/ProbeTraceroute":             role.MakeSet(role.Admin),
 	"/machine.MachineService/Processes":                   role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin),
@@ -30,6 +30,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	mc "github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Sysctl to use for testing config changes.
@@ -538,6 +539,96 @@ func (suite *ApplyConfigSuite) TestApplyTry() {
 	suite.Fail("dummy interface wasn't removed after config try timeout")
 }
 
+// TestApplyTryConfirm applies the config in try mode with confirmation, and confirms it.
+func (suite *ApplyConfigSuite) TestApplyTryConfirm() {
+	nodes := suite.DiscoverNodeInternalIPsByType(suite.ctx, machine.TypeWorker)
+	suite.Require().NotEmpty(nodes)
+
+	suite.WaitForBootDone(suite.ctx)
+
+	sort.Strings(nodes)
+
+	node := nodes[0]
+
+	nodeCtx := client.WithNode(suite.ctx, node)
+
+	provider, err := suite.ReadConfigFromNode(nodeCtx)
+	suite.Require().Nilf(err, "failed to read existing config from node %q: %s", node, err)
+
+	originalCfgData, err := provider.Bytes()
+	suite.Require().NoError(err)
+
+	cfg, ok := provider.Raw().(*v1alpha1.Config)
+	suite.Require().True(ok)
+
+	if cfg.MachineConfig.MachineNetwork == nil {
+		cfg.MachineConfig.MachineNetwork = &v1alpha1.NetworkConfig{}
+	}
+
+	cfg.MachineConfig.MachineNetwork.NetworkInterfaces = append(cfg.MachineConfig.MachineNetwork.NetworkInterfaces,
+		&v1alpha1.Device{
+			DeviceInterface: "dummy1",
+			DeviceDummy:     pointer.To(true),
+		},
+	)
+
+	cfgDataOut, err := cfg.Bytes()
+	suite.Require().NoError(err)
+
+	_, err = suite.Client.ApplyConfiguration(
+		nodeCtx, &machineapi.ApplyConfigurationRequest{
+			Data:           cfgDataOut,
+			Mode:           machineapi.ApplyConfigurationRequest_TRY,
+			TryModeTimeout: durationpb.New(time.Minute),
+			TryModeConfirm: true,
+		},
+	)
+	suite.Require().Nilf(err, "failed to apply configuration (node %q): %s", node, err)
+
+	defer func() {
+		// restore the original config
+		_, err = suite.Client.ApplyConfiguration(
+			nodeCtx, &machineapi.ApplyConfigurationRequest{
+				Data: originalCfgData,
+				Mode: machineapi.ApplyConfigurationRequest_NO_REBOOT,
+			},
+		)
+		suite.Assert().Nilf(err, "failed to restore configuration (node %q): %s", node, err)
+	}()
+
+	tryStatus, err := safe.StateGet[*runtime.ConfigTryStatus](nodeCtx, suite.Client.COSI, runtime.NewConfigTryStatus().Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().Equal(runtime.ConfigTryStatePending, tryStatus.TypedSpec().State)
+	suite.Assert().True(tryStatus.TypedSpec().ConfirmationRequired)
+
+	// confirmation is held off until the network configuration settles
+	_, err = suite.Client.ConfirmConfiguration(nodeCtx)
+	suite.Require().Error(err)
+	suite.Assert().Equal(codes.Unavailable, client.StatusCode(err))
+
+	suite.Require().NoError(
+		retry.Constant(time.Minute-constants.ConfigTryConfirmGracePeriod, retry.WithUnits(time.Second)).Retry(
+			func() error {
+				_, confirmErr := suite.Client.ConfirmConfiguration(nodeCtx)
+				if client.StatusCode(confirmErr) == codes.Unavailable {
+					return retry.ExpectedError(confirmErr)
+				}
+
+				return confirmErr
+			},
+		), "failed to confirm configuration (node %q)", node,
+	)
+
+	tryStatus, err = safe.StateGet[*runtime.ConfigTryStatus](nodeCtx, suite.Client.COSI, runtime.NewConfigTryStatus().Metadata())
+	suite.Require().NoError(err)
+	suite.Assert().Equal(runtime.ConfigTryStateConfirmed, tryStatus.TypedSpec().State)
+
+	// nothing left to confirm
+	_, err = suite.Client.ConfirmConfiguration(nodeCtx)
+	suite.Require().Error(err)
+	suite.Assert().Equal(codes.FailedPrecondition, client.StatusCode(err))
+}
+
 func init() {
 	allSuites = append(allSuites, new(ApplyConfigSuite))
 }
@@ -8,6 +8,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"regexp"
 
@@ -54,6 +55,42 @@ func (suite *EtcdSuite) TestSnapshot() {
 	)
 }
 
+// TestStatus tests etcd status.
+func (suite *EtcdSuite) TestStatus() {
+	suite.RunCLI([]string{"etcd", "status", "--nodes", suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)},
+		base.StdoutShouldMatch(regexp.MustCompile(`DB SIZE`)),
+	)
+}
+
+// TestDefrag tests etcd defragmentation.
+func (suite *EtcdSuite) TestDefrag() {
+	suite.RunCLI([]string{"etcd", "defrag", "--nodes", suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)},
+		base.StdoutShouldMatch(regexp.MustCompile(`db size .+ -> .+`)),
+	)
+}
+
+// TestDefragCluster tests rolling etcd defragmentation of all members.
+func (suite *EtcdSuite) TestDefragCluster() {
+	nodes := suite.DiscoverNodes(context.TODO()).NodesByType(machine.TypeControlPlane)
+
+	suite.RunCLI([]string{"etcd", "defrag", "--cluster", "--nodes", suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)},
+		base.StdoutShouldMatch(regexp.MustCompile(fmt.Sprintf(`(?s)(defragmenting etcd member.+){%d}`, len(nodes)))),
+	)
+}
+
+// TestAlarm tests etcd alarm list and disarm.
+func (suite *EtcdSuite) TestAlarm() {
+	node := suite.RandomDiscoveredNodeInternalIP(machine.TypeControlPlane)
+
+	suite.RunCLI([]string{"etcd", "alarm", "list", "--nodes", node},
+		base.StdoutShouldMatch(regexp.MustCompile(`NODE\s+MEMBER\s+ALARM`)),
+	)
+
+	suite.RunCLI([]string{"etcd", "alarm", "disarm", "--nodes", node},
+		base.StdoutShouldMatch(regexp.MustCompile(`NODE\s+MEMBER\s+ALARM`)),
+	)
+}
+
 func init() {
 	allSuites = append(allSuites, new(EtcdSuite))
 }
@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bgp implements a minimal BGP-4 (RFC 4271) speaker which announces a set of prefixes to the peers.
+//
+// The speaker only initiates the sessions (active mode), it never installs routes received from the peers.
+// IPv6 prefixes are announced with the multiprotocol extensions (RFC 4760).
+package bgp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultPort is the default BGP port.
+const DefaultPort = 179
+
+// DefaultHoldTime is the default hold time proposed to the peers.
+const DefaultHoldTime = 90 * time.Second
+
+const (
+	defaultConnectRetryInterval = 5 * time.Second
+	shutdownTimeout             = 5 * time.Second
+)
+
+// PeerConfig describes a BGP peer.
+type PeerConfig struct {
+	Address netip.Addr
+	// Port defaults to DefaultPort.
+	Port uint16
+	ASN  uint32
+}
+
+// Config describes the BGP speaker.
+type Config struct {
+	LocalASN uint32
+	// RouterID defaults to the local IPv4 address of the session.
+	RouterID netip.Addr
+	// HoldTime defaults to DefaultHoldTime.
+	HoldTime time.Duration
+	// ConnectRetryInterval defaults to 5 seconds.
+	ConnectRetryInterval time.Duration
+
+	Peers []PeerConfig
+
+	// Prefixes are announced to all the peers as long as the speaker is running.
+	Prefixes []netip.Prefix
+}
+
+// Speaker announces the prefixes to the BGP peers.
+type Speaker struct {
+	cfg    Config
+	logger *zap.Logger
+
+	mu          sync.Mutex
+	established map[netip.Addr]struct{}
+}
+
+// NewSpeaker creates a new BGP speaker.
+func NewSpeaker(cfg Config, logger *zap.Logger) *Speaker {
+	if cfg.HoldTime == 0 {
+		cfg.HoldTime = DefaultHoldTime
+	}
+
+	if cfg.ConnectRetryInterval == 0 {
+		cfg.ConnectRetryInterval = defaultConnectRetryInterval
+	}
+
+	return &Speaker{
+		cfg:         cfg,
+		logger:      logger,
+		established: map[netip.Addr]struct{}{},
+	}
+}
+
+// Established returns the list of peers with the established sessions.
+func (speaker *Speaker) Established() []netip.Addr {
+	speaker.mu.Lock()
+	defer speaker.mu.Unlock()
+
+	peers := make([]netip.Addr, 0, len(speaker.established))
+
+	for peer := range speaker.established {
+		peers = append(peers, peer)
+	}
+
+	return peers
+}
+
+func (speaker *Speaker) setEstablished(peer netip.Addr, established bool) {
+	speaker.mu.Lock()
+	defer speaker.mu.Unlock()
+
+	if established {
+		speaker.established[peer] = struct{}{}
+	} else {
+		delete(speaker.established, peer)
+	}
+}
+
+// Run the speaker until the context is canceled.
+//
+// On shutdown, the prefixes are withdrawn and the sessions are closed.
+func (speaker *Speaker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, peer := range speaker.cfg.Peers {
+		peer := peer
+
+		if peer.Port == 0 {
+			peer.Port = DefaultPort
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			speaker.runPeer(ctx, peer)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (speaker *Speaker) runPeer(ctx context.Context, peer PeerConfig) {
+	logger := speaker.logger.With(zap.Stringer("peer", peer.Address))
+
+	for {
+		err := speaker.runSession(ctx, logger, peer)
+
+		speaker.setEstablished(peer.Address, false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Warn("BGP session failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(speaker.cfg.ConnectRetryInterval):
+		}
+	}
+}
+
+func (speaker *Speaker) runSession(ctx context.Context, logger *zap.Logger, peer PeerConfig) error {
+	var dialer net.Dialer
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, speaker.cfg.HoldTime)
+	defer dialCancel()
+
+	conn, err := dialer.DialContext(dialCtx, "tcp", net.JoinHostPort(peer.Address.String(), strconv.Itoa(int(peer.Port))))
+	if err != nil {
+		return fmt.Errorf("error connecting: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	sess := &session{
+		conn:     conn,
+		cfg:      &speaker.cfg,
+		peer:     peer,
+		localIP:  conn.LocalAddr().(*net.TCPAddr).AddrPort().Addr().Unmap(), //nolint:forcetypeassert
+		logger:   logger,
+		msgCh:    make(chan message),
+		readErrC: make(chan error, 1),
+		done:     make(chan struct{}),
+	}
+
+	defer close(sess.done)
+
+	go sess.readLoop()
+
+	if err = sess.open(ctx); err != nil {
+		return err
+	}
+
+	speaker.setEstablished(peer.Address, true)
+
+	logger.Info("BGP session established", zap.Uint32("peer_asn", sess.peerASN))
+
+	if err = sess.announce(); err != nil {
+		return err
+	}
+
+	err = sess.established(ctx)
+
+	if ctx.Err() != nil {
+		// graceful shutdown: withdraw the prefixes and close the session
+		conn.SetWriteDeadline(time.Now().Add(shutdownTimeout)) //nolint:errcheck
+
+		if shutdownErr := sess.shutdown(); shutdownErr != nil {
+			logger.Warn("error shutting down BGP session", zap.Error(shutdownErr))
+		} else {
+			logger.Info("BGP session closed")
+		}
+
+		return nil
+	}
+
+	return err
+}
+
+// NotificationError is returned when the peer sends a NOTIFICATION message.
+type NotificationError struct {
+	Code    uint8
+	Subcode uint8
+}
+
+func (err *NotificationError) Error() string {
+	return fmt.Sprintf("received notification: code %d, subcode %d", err.Code, err.Subcode)
+}
+
+var errHoldTimerExpired = errors.New("hold timer expired")
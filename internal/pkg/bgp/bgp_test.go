@@ -0,0 +1,329 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+)
+
+// testPeer is a minimal passive BGP speaker which records received messages.
+type testPeer struct {
+	t        *testing.T
+	listener net.Listener
+	asn      uint32
+	ipv6     bool
+
+	mu            sync.Mutex
+	opens         []*bgp.OpenMessage
+	updates       []*bgp.Update
+	notifications [][]byte
+	sessions      int
+
+	// dropSession closes the first session right after it is established.
+	dropSession bool
+}
+
+func newTestPeer(t *testing.T, network, address string, asn uint32) *testPeer {
+	t.Helper()
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		t.Skipf("failed to listen on %s: %s", address, err)
+	}
+
+	return newTestPeerWithListener(t, listener, asn, network == "tcp6")
+}
+
+func newTestPeerWithListener(t *testing.T, listener net.Listener, asn uint32, ipv6 bool) *testPeer {
+	t.Helper()
+
+	peer := &testPeer{
+		t:        t,
+		listener: listener,
+		asn:      asn,
+		ipv6:     ipv6,
+	}
+
+	go peer.serve()
+
+	t.Cleanup(func() {
+		listener.Close() //nolint:errcheck
+	})
+
+	return peer
+}
+
+func (peer *testPeer) addrPort() netip.AddrPort {
+	return peer.listener.Addr().(*net.TCPAddr).AddrPort() //nolint:forcetypeassert
+}
+
+func (peer *testPeer) serve() {
+	for {
+		conn, err := peer.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go peer.handle(conn)
+	}
+}
+
+func (peer *testPeer) handle(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	typ, body, err := bgp.ReadMessage(conn)
+	if err != nil || typ != bgp.MsgOpen {
+		return
+	}
+
+	open, err := bgp.ParseOpen(body)
+	assert.NoError(peer.t, err)
+
+	if err = bgp.WriteMessage(conn, bgp.MsgOpen, bgp.MarshalOpen(peer.asn, 3, netip.MustParseAddr("192.0.2.1"), peer.ipv6)); err != nil {
+		return
+	}
+
+	if err = bgp.WriteMessage(conn, bgp.MsgKeepalive, nil); err != nil {
+		return
+	}
+
+	peer.mu.Lock()
+	peer.opens = append(peer.opens, open)
+	peer.sessions++
+	drop := peer.dropSession && peer.sessions == 1
+	peer.mu.Unlock()
+
+	if typ, _, err = bgp.ReadMessage(conn); err != nil || typ != bgp.MsgKeepalive {
+		return
+	}
+
+	if drop {
+		return
+	}
+
+	for {
+		typ, body, err = bgp.ReadMessage(conn)
+		if err != nil {
+			return
+		}
+
+		switch typ {
+		case bgp.MsgUpdate:
+			var u *bgp.Update
+
+			u, err = bgp.ParseUpdate(body)
+			assert.NoError(peer.t, err)
+
+			peer.mu.Lock()
+			peer.updates = append(peer.updates, u)
+			peer.mu.Unlock()
+		case bgp.MsgNotification:
+			peer.mu.Lock()
+			peer.notifications = append(peer.notifications, body)
+			peer.mu.Unlock()
+
+			return
+		case bgp.MsgKeepalive:
+			bgp.WriteMessage(conn, bgp.MsgKeepalive, nil) //nolint:errcheck
+		}
+	}
+}
+
+func (peer *testPeer) state() (opens []*bgp.OpenMessage, updates []*bgp.Update, notifications [][]byte, sessions int) {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	return append([]*bgp.OpenMessage(nil), peer.opens...),
+		append([]*bgp.Update(nil), peer.updates...),
+		append([][]byte(nil), peer.notifications...),
+		peer.sessions
+}
+
+func runSpeaker(t *testing.T, cfg bgp.Config) (*bgp.Speaker, context.CancelFunc, <-chan struct{}) {
+	t.Helper()
+
+	speaker := bgp.NewSpeaker(cfg, zaptest.NewLogger(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer close(doneCh)
+
+		speaker.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-doneCh
+	})
+
+	return speaker, cancel, doneCh
+}
+
+func TestSpeakerIPv4(t *testing.T) {
+	peer := newTestPeer(t, "tcp4", "127.0.0.1:0", 65001)
+
+	speaker, cancel, doneCh := runSpeaker(t, bgp.Config{
+		LocalASN: 4200000000,
+		Peers: []bgp.PeerConfig{
+			{
+				Address: peer.addrPort().Addr(),
+				Port:    peer.addrPort().Port(),
+				ASN:     65001,
+			},
+		},
+		Prefixes: []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")},
+	})
+
+	require.Eventually(t, func() bool {
+		_, updates, _, _ := peer.state()
+
+		return len(updates) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []netip.Addr{peer.addrPort().Addr()}, speaker.Established())
+
+	opens, updates, _, _ := peer.state()
+
+	require.Len(t, opens, 1)
+	assert.EqualValues(t, 4200000000, opens[0].ASN)
+	assert.True(t, opens[0].AS4)
+	assert.EqualValues(t, bgp.DefaultHoldTime/time.Second, opens[0].HoldTime)
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), opens[0].RouterID)
+
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")}, updates[0].Announced)
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), updates[0].NextHop)
+	assert.Equal(t, []uint32{4200000000}, updates[0].ASPath)
+	assert.Zero(t, updates[0].LocalPref)
+
+	cancel()
+	<-doneCh
+
+	require.Eventually(t, func() bool {
+		_, _, notifications, _ := peer.state()
+
+		return len(notifications) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, updates, notifications, _ := peer.state()
+
+	require.Len(t, updates, 2)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")}, updates[1].Withdrawn)
+
+	// cease, administrative shutdown
+	assert.Equal(t, []byte{6, 2}, notifications[0])
+}
+
+func TestSpeakerIPv6(t *testing.T) {
+	peer := newTestPeer(t, "tcp6", "[::1]:0", 65000)
+
+	_, cancel, doneCh := runSpeaker(t, bgp.Config{
+		LocalASN: 65000,
+		RouterID: netip.MustParseAddr("10.0.0.1"),
+		Peers: []bgp.PeerConfig{
+			{
+				Address: peer.addrPort().Addr(),
+				Port:    peer.addrPort().Port(),
+				ASN:     65000,
+			},
+		},
+		Prefixes: []netip.Prefix{netip.MustParsePrefix("2001:db8::1/128")},
+	})
+
+	require.Eventually(t, func() bool {
+		_, updates, _, _ := peer.state()
+
+		return len(updates) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	opens, updates, _, _ := peer.state()
+
+	require.Len(t, opens, 1)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), opens[0].RouterID)
+
+	// iBGP: empty AS path, local preference is set
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("2001:db8::1/128")}, updates[0].Announced)
+	assert.Equal(t, netip.MustParseAddr("::1"), updates[0].NextHop)
+	assert.Empty(t, updates[0].ASPath)
+	assert.EqualValues(t, 100, updates[0].LocalPref)
+
+	cancel()
+	<-doneCh
+
+	require.Eventually(t, func() bool {
+		_, updates, _, _ := peer.state()
+
+		return len(updates) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, updates, _, _ = peer.state()
+
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("2001:db8::1/128")}, updates[1].Withdrawn)
+}
+
+func TestSpeakerReconnect(t *testing.T) {
+	peer := newTestPeer(t, "tcp4", "127.0.0.1:0", 65001)
+	peer.dropSession = true
+
+	runSpeaker(t, bgp.Config{
+		LocalASN:             65000,
+		ConnectRetryInterval: 100 * time.Millisecond,
+		Peers: []bgp.PeerConfig{
+			{
+				Address: peer.addrPort().Addr(),
+				Port:    peer.addrPort().Port(),
+				ASN:     65001,
+			},
+		},
+		Prefixes: []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")},
+	})
+
+	require.Eventually(t, func() bool {
+		_, updates, _, sessions := peer.state()
+
+		return sessions == 2 && len(updates) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestSpeakerPeerASMismatch(t *testing.T) {
+	peer := newTestPeer(t, "tcp4", "127.0.0.1:0", 65002)
+
+	speaker, _, _ := runSpeaker(t, bgp.Config{
+		LocalASN: 65000,
+		Peers: []bgp.PeerConfig{
+			{
+				Address: peer.addrPort().Addr(),
+				Port:    peer.addrPort().Port(),
+				ASN:     65001,
+			},
+		},
+		Prefixes: []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")},
+	})
+
+	require.Eventually(t, func() bool {
+		_, _, _, sessions := peer.state()
+
+		return sessions == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, updates, _, _ := peer.state()
+
+	assert.Empty(t, updates)
+	assert.Empty(t, speaker.Established())
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"io"
+	"net/netip"
+)
+
+// Message types.
+const (
+	MsgOpen         = uint8(msgOpen)
+	MsgUpdate       = uint8(msgUpdate)
+	MsgNotification = uint8(msgNotification)
+	MsgKeepalive    = uint8(msgKeepalive)
+)
+
+// Update is exported for testing.
+type Update = update
+
+// OpenMessage is exported for testing.
+type OpenMessage = openMessage
+
+// ReadMessage is exported for testing.
+func ReadMessage(r io.Reader) (uint8, []byte, error) {
+	typ, body, err := readMessage(r)
+
+	return uint8(typ), body, err
+}
+
+// WriteMessage is exported for testing.
+func WriteMessage(w io.Writer, typ uint8, body []byte) error {
+	return writeMessage(w, messageType(typ), body)
+}
+
+// ParseOpen is exported for testing.
+func ParseOpen(body []byte) (*OpenMessage, error) {
+	return parseOpen(body)
+}
+
+// MarshalOpen is exported for testing.
+func MarshalOpen(asn uint32, holdTime uint16, routerID netip.Addr, ipv6 bool) []byte {
+	f := family{afi: afiIPv4, safi: safiUnicast}
+	if ipv6 {
+		f.afi = afiIPv6
+	}
+
+	m := openMessage{
+		ASN:      asn,
+		HoldTime: holdTime,
+		RouterID: routerID,
+		AS4:      true,
+		Families: []family{f},
+	}
+
+	return m.marshal()
+}
+
+// ParseUpdate is exported for testing.
+func ParseUpdate(body []byte) (*Update, error) {
+	return parseUpdate(body)
+}
+
+// MarshalAnnounce is exported for testing.
+func MarshalAnnounce(prefix netip.Prefix, nextHop netip.Addr, localASN uint32, ibgp, as4 bool) ([]byte, error) {
+	return marshalAnnounce(prefix, updateParams{
+		NextHop:  nextHop,
+		LocalASN: localASN,
+		IBGP:     ibgp,
+		AS4:      as4,
+	})
+}
+
+// MarshalWithdraw is exported for testing.
+func MarshalWithdraw(prefix netip.Prefix) []byte {
+	return marshalWithdraw(prefix)
+}
+
+// MarshalNotification is exported for testing.
+func MarshalNotification(code, subcode uint8) []byte {
+	return marshalNotification(code, subcode)
+}
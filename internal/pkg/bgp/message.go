@@ -0,0 +1,492 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+)
+
+const (
+	headerLength     = 19
+	maxMessageLength = 4096
+	bgpVersion       = 4
+)
+
+type messageType uint8
+
+// Message types (RFC 4271, section 4.1).
+const (
+	msgOpen         messageType = 1
+	msgUpdate       messageType = 2
+	msgNotification messageType = 3
+	msgKeepalive    messageType = 4
+)
+
+// Address families (RFC 4760).
+const (
+	afiIPv4     = 1
+	afiIPv6     = 2
+	safiUnicast = 1
+)
+
+// Capabilities (RFC 5492).
+const (
+	optParamCapabilities  = 2
+	capMultiprotocol      = 1
+	capFourOctetASN       = 65
+	asTrans               = 23456
+	maxTwoOctetASN        = 0xffff
+	notificationCease     = 6
+	notificationHoldTimer = 4
+	ceaseAdminShutdown    = 2
+)
+
+// Path attributes (RFC 4271, section 5; RFC 4760).
+const (
+	attrFlagOptional       = 0x80
+	attrFlagTransitive     = 0x40
+	attrFlagExtendedLength = 0x10
+
+	attrOrigin        = 1
+	attrASPath        = 2
+	attrNextHop       = 3
+	attrLocalPref     = 5
+	attrMPReachNLRI   = 14
+	attrMPUnreachNLRI = 15
+
+	originIGP        = 0
+	asPathSequence   = 2
+	defaultLocalPref = 100
+)
+
+type family struct {
+	afi  uint16
+	safi uint8
+}
+
+func familyOf(addr netip.Addr) family {
+	if addr.Is4() {
+		return family{afi: afiIPv4, safi: safiUnicast}
+	}
+
+	return family{afi: afiIPv6, safi: safiUnicast}
+}
+
+func writeMessage(w io.Writer, typ messageType, body []byte) error {
+	if headerLength+len(body) > maxMessageLength {
+		return fmt.Errorf("message too long: %d bytes", headerLength+len(body))
+	}
+
+	buf := make([]byte, headerLength, headerLength+len(body))
+
+	for i := 0; i < 16; i++ {
+		buf[i] = 0xff
+	}
+
+	binary.BigEndian.PutUint16(buf[16:], uint16(headerLength+len(body)))
+	buf[18] = byte(typ)
+
+	_, err := w.Write(append(buf, body...))
+
+	return err
+}
+
+func readMessage(r io.Reader) (messageType, []byte, error) {
+	var hdr [headerLength]byte
+
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+
+	if !bytes.Equal(hdr[:16], bytes.Repeat([]byte{0xff}, 16)) {
+		return 0, nil, errors.New("invalid message marker")
+	}
+
+	length := int(binary.BigEndian.Uint16(hdr[16:]))
+	if length < headerLength || length > maxMessageLength {
+		return 0, nil, fmt.Errorf("invalid message length %d", length)
+	}
+
+	body := make([]byte, length-headerLength)
+
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+
+	return messageType(hdr[18]), body, nil
+}
+
+// openMessage is the BGP OPEN message.
+type openMessage struct {
+	ASN      uint32
+	HoldTime uint16
+	RouterID netip.Addr
+	AS4      bool
+	Families []family
+}
+
+func (m *openMessage) marshal() []byte {
+	var caps []byte
+
+	for _, f := range m.Families {
+		caps = append(caps, capMultiprotocol, 4)
+		caps = binary.BigEndian.AppendUint16(caps, f.afi)
+		caps = append(caps, 0, f.safi)
+	}
+
+	if m.AS4 {
+		caps = append(caps, capFourOctetASN, 4)
+		caps = binary.BigEndian.AppendUint32(caps, m.ASN)
+	}
+
+	myAS := uint16(asTrans)
+	if m.ASN <= maxTwoOctetASN {
+		myAS = uint16(m.ASN)
+	}
+
+	routerID := m.RouterID.As4()
+
+	body := []byte{bgpVersion}
+	body = binary.BigEndian.AppendUint16(body, myAS)
+	body = binary.BigEndian.AppendUint16(body, m.HoldTime)
+	body = append(body, routerID[:]...)
+
+	if len(caps) == 0 {
+		return append(body, 0)
+	}
+
+	body = append(body, byte(len(caps)+2), optParamCapabilities, byte(len(caps)))
+
+	return append(body, caps...)
+}
+
+//nolint:gocyclo
+func parseOpen(body []byte) (*openMessage, error) {
+	if len(body) < 10 {
+		return nil, errors.New("OPEN message too short")
+	}
+
+	if body[0] != bgpVersion {
+		return nil, fmt.Errorf("unsupported BGP version %d", body[0])
+	}
+
+	m := &openMessage{
+		ASN:      uint32(binary.BigEndian.Uint16(body[1:])),
+		HoldTime: binary.BigEndian.Uint16(body[3:]),
+		RouterID: netip.AddrFrom4(*(*[4]byte)(body[5:9])),
+	}
+
+	params := body[10:]
+	if len(params) != int(body[9]) {
+		return nil, errors.New("invalid OPEN optional parameters length")
+	}
+
+	for len(params) > 0 {
+		if len(params) < 2 || len(params) < 2+int(params[1]) {
+			return nil, errors.New("truncated OPEN optional parameter")
+		}
+
+		typ, value := params[0], params[2:2+int(params[1])]
+		params = params[2+int(params[1]):]
+
+		if typ != optParamCapabilities {
+			continue
+		}
+
+		for len(value) > 0 {
+			if len(value) < 2 || len(value) < 2+int(value[1]) {
+				return nil, errors.New("truncated capability")
+			}
+
+			code, capValue := value[0], value[2:2+int(value[1])]
+			value = value[2+int(value[1]):]
+
+			switch {
+			case code == capMultiprotocol && len(capValue) == 4:
+				m.Families = append(m.Families, family{afi: binary.BigEndian.Uint16(capValue), safi: capValue[3]})
+			case code == capFourOctetASN && len(capValue) == 4:
+				m.AS4 = true
+				m.ASN = binary.BigEndian.Uint32(capValue)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func marshalNotification(code, subcode uint8) []byte {
+	return []byte{code, subcode}
+}
+
+func appendPrefix(b []byte, prefix netip.Prefix) []byte {
+	addr := prefix.Addr().AsSlice()
+
+	b = append(b, byte(prefix.Bits()))
+
+	return append(b, addr[:(prefix.Bits()+7)/8]...)
+}
+
+func appendAttribute(b []byte, flags, typ uint8, value []byte) []byte {
+	if len(value) > 0xff {
+		b = append(b, flags|attrFlagExtendedLength, typ)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(value)))
+	} else {
+		b = append(b, flags, typ, byte(len(value)))
+	}
+
+	return append(b, value...)
+}
+
+// updateParams describe the path attributes of the announced route.
+type updateParams struct {
+	NextHop  netip.Addr
+	LocalASN uint32
+	// IBGP is set for internal peers: AS path is empty and LOCAL_PREF is sent.
+	IBGP bool
+	// AS4 is set if the peer supports four-octet AS numbers.
+	AS4 bool
+}
+
+// marshalAnnounce builds the UPDATE message announcing the prefix.
+func marshalAnnounce(prefix netip.Prefix, params updateParams) ([]byte, error) {
+	if prefix.Addr().Is4() != params.NextHop.Is4() {
+		return nil, fmt.Errorf("next hop %s address family doesn't match prefix %s", params.NextHop, prefix)
+	}
+
+	var asPath []byte
+
+	if !params.IBGP {
+		asPath = []byte{asPathSequence, 1}
+
+		switch {
+		case params.AS4:
+			asPath = binary.BigEndian.AppendUint32(asPath, params.LocalASN)
+		case params.LocalASN <= maxTwoOctetASN:
+			asPath = binary.BigEndian.AppendUint16(asPath, uint16(params.LocalASN))
+		default:
+			return nil, fmt.Errorf("peer doesn't support four-octet AS number %d", params.LocalASN)
+		}
+	}
+
+	var attrs []byte
+
+	attrs = appendAttribute(attrs, attrFlagTransitive, attrOrigin, []byte{originIGP})
+	attrs = appendAttribute(attrs, attrFlagTransitive, attrASPath, asPath)
+
+	if params.IBGP {
+		attrs = appendAttribute(attrs, attrFlagTransitive, attrLocalPref, binary.BigEndian.AppendUint32(nil, defaultLocalPref))
+	}
+
+	var nlri []byte
+
+	if prefix.Addr().Is4() {
+		nextHop := params.NextHop.As4()
+
+		attrs = appendAttribute(attrs, attrFlagTransitive, attrNextHop, nextHop[:])
+		nlri = appendPrefix(nil, prefix)
+	} else {
+		nextHop := params.NextHop.As16()
+
+		reach := binary.BigEndian.AppendUint16(nil, afiIPv6)
+		reach = append(reach, safiUnicast, byte(len(nextHop)))
+		reach = append(reach, nextHop[:]...)
+		reach = append(reach, 0)
+		reach = appendPrefix(reach, prefix)
+
+		attrs = appendAttribute(attrs, attrFlagOptional, attrMPReachNLRI, reach)
+	}
+
+	body := binary.BigEndian.AppendUint16(nil, 0)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(attrs)))
+	body = append(body, attrs...)
+
+	return append(body, nlri...), nil
+}
+
+// marshalWithdraw builds the UPDATE message withdrawing the prefix.
+func marshalWithdraw(prefix netip.Prefix) []byte {
+	if prefix.Addr().Is4() {
+		withdrawn := appendPrefix(nil, prefix)
+
+		body := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn)))
+		body = append(body, withdrawn...)
+
+		return binary.BigEndian.AppendUint16(body, 0)
+	}
+
+	unreach := binary.BigEndian.AppendUint16(nil, afiIPv6)
+	unreach = append(unreach, safiUnicast)
+	unreach = appendPrefix(unreach, prefix)
+
+	attrs := appendAttribute(nil, attrFlagOptional, attrMPUnreachNLRI, unreach)
+
+	body := binary.BigEndian.AppendUint16(nil, 0)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(attrs)))
+
+	return append(body, attrs...)
+}
+
+// update is the decoded UPDATE message.
+type update struct {
+	Announced []netip.Prefix
+	Withdrawn []netip.Prefix
+	NextHop   netip.Addr
+	ASPath    []uint32
+	LocalPref uint32
+}
+
+func parsePrefixes(b []byte, is4 bool) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for len(b) > 0 {
+		bits := int(b[0])
+		length := (bits + 7) / 8
+
+		if len(b) < 1+length {
+			return nil, errors.New("truncated prefix")
+		}
+
+		var addr netip.Addr
+
+		if is4 {
+			var raw [4]byte
+
+			copy(raw[:], b[1:1+length])
+			addr = netip.AddrFrom4(raw)
+		} else {
+			var raw [16]byte
+
+			copy(raw[:], b[1:1+length])
+			addr = netip.AddrFrom16(raw)
+		}
+
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes = append(prefixes, prefix)
+		b = b[1+length:]
+	}
+
+	return prefixes, nil
+}
+
+// parseUpdate decodes UPDATE message, four-octet AS numbers are expected in the AS path.
+//
+//nolint:gocyclo,cyclop
+func parseUpdate(body []byte) (*update, error) {
+	if len(body) < 4 {
+		return nil, errors.New("UPDATE message too short")
+	}
+
+	u := &update{}
+
+	withdrawnLength := int(binary.BigEndian.Uint16(body))
+	if len(body) < 4+withdrawnLength {
+		return nil, errors.New("truncated withdrawn routes")
+	}
+
+	var err error
+
+	if u.Withdrawn, err = parsePrefixes(body[2:2+withdrawnLength], true); err != nil {
+		return nil, err
+	}
+
+	body = body[2+withdrawnLength:]
+
+	attrsLength := int(binary.BigEndian.Uint16(body))
+	if len(body) < 2+attrsLength {
+		return nil, errors.New("truncated path attributes")
+	}
+
+	attrs := body[2 : 2+attrsLength]
+
+	if u.Announced, err = parsePrefixes(body[2+attrsLength:], true); err != nil {
+		return nil, err
+	}
+
+	for len(attrs) > 0 {
+		if len(attrs) < 3 {
+			return nil, errors.New("truncated path attribute")
+		}
+
+		flags, typ := attrs[0], attrs[1]
+		attrs = attrs[2:]
+
+		var length int
+
+		if flags&attrFlagExtendedLength != 0 {
+			if len(attrs) < 2 {
+				return nil, errors.New("truncated path attribute")
+			}
+
+			length = int(binary.BigEndian.Uint16(attrs))
+			attrs = attrs[2:]
+		} else {
+			length = int(attrs[0])
+			attrs = attrs[1:]
+		}
+
+		if len(attrs) < length {
+			return nil, errors.New("truncated path attribute")
+		}
+
+		value := attrs[:length]
+		attrs = attrs[length:]
+
+		switch typ {
+		case attrNextHop:
+			if length == 4 {
+				u.NextHop = netip.AddrFrom4(*(*[4]byte)(value))
+			}
+		case attrLocalPref:
+			if length == 4 {
+				u.LocalPref = binary.BigEndian.Uint32(value)
+			}
+		case attrASPath:
+			for len(value) >= 2 {
+				count := int(value[1])
+				value = value[2:]
+
+				for i := 0; i < count && len(value) >= 4; i++ {
+					u.ASPath = append(u.ASPath, binary.BigEndian.Uint32(value))
+					value = value[4:]
+				}
+			}
+		case attrMPReachNLRI:
+			if length < 5 || length < 5+int(value[3]) || value[3] != 16 {
+				return nil, errors.New("unsupported MP_REACH_NLRI")
+			}
+
+			u.NextHop = netip.AddrFrom16(*(*[16]byte)(value[4:20]))
+
+			prefixes, err := parsePrefixes(value[5+int(value[3]):], false)
+			if err != nil {
+				return nil, err
+			}
+
+			u.Announced = append(u.Announced, prefixes...)
+		case attrMPUnreachNLRI:
+			if length < 3 {
+				return nil, errors.New("unsupported MP_UNREACH_NLRI")
+			}
+
+			prefixes, err := parsePrefixes(value[3:], false)
+			if err != nil {
+				return nil, err
+			}
+
+			u.Withdrawn = append(u.Withdrawn, prefixes...)
+		}
+	}
+
+	return u, nil
+}
@@ -0,0 +1,301 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+)
+
+// marker is the BGP header marker (RFC 4271, section 4.1).
+const marker = "ffffffff ffffffff ffffffff ffffffff "
+
+// golden decodes the wire message written as hex with the whitespace separating the fields.
+func golden(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(strings.Join(strings.Fields(s), ""))
+	require.NoError(t, err)
+
+	return b
+}
+
+func wireMessage(t *testing.T, typ uint8, body []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, bgp.WriteMessage(&buf, typ, body))
+
+	return buf.Bytes()
+}
+
+func TestOpenGolden(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		asn      uint32
+		routerID netip.Addr
+		ipv6     bool
+
+		expected string
+	}{
+		{
+			name:     "two-octet ASN, IPv4",
+			asn:      65000,
+			routerID: netip.MustParseAddr("192.0.2.1"),
+
+			expected: marker +
+				"002b 01 " + // length 43, OPEN
+				"04 fde8 005a c0000201 " + // version 4, My AS 65000, hold time 90, BGP Identifier 192.0.2.1
+				"0e 02 0c " + // optional parameters length 14, capabilities parameter of length 12
+				"01 04 0001 00 01 " + // multiprotocol: AFI IPv4, SAFI unicast
+				"41 04 0000fde8", // four-octet AS number 65000
+		},
+		{
+			name:     "four-octet ASN, IPv6",
+			asn:      4200000000,
+			routerID: netip.MustParseAddr("10.0.0.1"),
+			ipv6:     true,
+
+			expected: marker +
+				"002b 01 " + // length 43, OPEN
+				"04 5ba0 005a 0a000001 " + // version 4, My AS AS_TRANS (23456), hold time 90, BGP Identifier 10.0.0.1
+				"0e 02 0c " + // optional parameters length 14, capabilities parameter of length 12
+				"01 04 0002 00 01 " + // multiprotocol: AFI IPv6, SAFI unicast
+				"41 04 fa56ea00", // four-octet AS number 4200000000
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			expected := golden(t, test.expected)
+
+			assert.Equal(t, expected, wireMessage(t, bgp.MsgOpen, bgp.MarshalOpen(test.asn, 90, test.routerID, test.ipv6)))
+
+			typ, body, err := bgp.ReadMessage(bytes.NewReader(expected))
+			require.NoError(t, err)
+			assert.Equal(t, bgp.MsgOpen, typ)
+
+			open, err := bgp.ParseOpen(body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.asn, open.ASN)
+			assert.True(t, open.AS4)
+			assert.EqualValues(t, 90, open.HoldTime)
+			assert.Equal(t, test.routerID, open.RouterID)
+		})
+	}
+}
+
+func TestOpenParseGolden(t *testing.T) {
+	// OPEN without the optional parameters, as sent by the speakers without the capabilities support
+	_, body, err := bgp.ReadMessage(bytes.NewReader(golden(t, marker+
+		"001d 01 "+ // length 29, OPEN
+		"04 fde9 00b4 c0000202 00", // version 4, My AS 65001, hold time 180, BGP Identifier 192.0.2.2, no optional parameters
+	)))
+	require.NoError(t, err)
+
+	open, err := bgp.ParseOpen(body)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 65001, open.ASN)
+	assert.False(t, open.AS4)
+	assert.EqualValues(t, 180, open.HoldTime)
+	assert.Equal(t, netip.MustParseAddr("192.0.2.2"), open.RouterID)
+	assert.Empty(t, open.Families)
+}
+
+func TestUpdateGolden(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		prefix   netip.Prefix
+		nextHop  netip.Addr
+		localASN uint32
+		ibgp     bool
+		as4      bool
+
+		expected string
+	}{
+		{
+			name:     "eBGP IPv4, four-octet AS path",
+			prefix:   netip.MustParsePrefix("10.5.0.1/32"),
+			nextHop:  netip.MustParseAddr("192.0.2.10"),
+			localASN: 65000,
+			as4:      true,
+
+			expected: marker +
+				"0030 02 " + // length 48, UPDATE
+				"0000 " + // no withdrawn routes
+				"0014 " + // path attributes length 20
+				"40 01 01 00 " + // ORIGIN: IGP
+				"40 02 06 02 01 0000fde8 " + // AS_PATH: AS_SEQUENCE of 1: 65000
+				"40 03 04 c000020a " + // NEXT_HOP: 192.0.2.10
+				"20 0a050001", // NLRI: 10.5.0.1/32
+		},
+		{
+			name:     "eBGP IPv4, two-octet AS path",
+			prefix:   netip.MustParsePrefix("10.5.0.0/24"),
+			nextHop:  netip.MustParseAddr("192.0.2.10"),
+			localASN: 65000,
+
+			expected: marker +
+				"002d 02 " + // length 45, UPDATE
+				"0000 " + // no withdrawn routes
+				"0012 " + // path attributes length 18
+				"40 01 01 00 " + // ORIGIN: IGP
+				"40 02 04 02 01 fde8 " + // AS_PATH: AS_SEQUENCE of 1: 65000
+				"40 03 04 c000020a " + // NEXT_HOP: 192.0.2.10
+				"18 0a0500", // NLRI: 10.5.0.0/24
+		},
+		{
+			name:     "iBGP IPv6",
+			prefix:   netip.MustParsePrefix("2001:db8::1/128"),
+			nextHop:  netip.MustParseAddr("2001:db8::a"),
+			localASN: 65000,
+			ibgp:     true,
+			as4:      true,
+
+			expected: marker +
+				"004e 02 " + // length 78, UPDATE
+				"0000 " + // no withdrawn routes
+				"0037 " + // path attributes length 55
+				"40 01 01 00 " + // ORIGIN: IGP
+				"40 02 00 " + // AS_PATH: empty
+				"40 05 04 00000064 " + // LOCAL_PREF: 100
+				"80 0e 26 " + // MP_REACH_NLRI, length 38
+				"0002 01 " + // AFI IPv6, SAFI unicast
+				"10 20010db800000000000000000000000a " + // next hop: 2001:db8::a
+				"00 " + // reserved
+				"80 20010db8000000000000000000000001", // NLRI: 2001:db8::1/128
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			expected := golden(t, test.expected)
+
+			body, err := bgp.MarshalAnnounce(test.prefix, test.nextHop, test.localASN, test.ibgp, test.as4)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, wireMessage(t, bgp.MsgUpdate, body))
+
+			if !test.as4 {
+				// only four-octet AS path is parsed
+				return
+			}
+
+			typ, body, err := bgp.ReadMessage(bytes.NewReader(expected))
+			require.NoError(t, err)
+			assert.Equal(t, bgp.MsgUpdate, typ)
+
+			u, err := bgp.ParseUpdate(body)
+			require.NoError(t, err)
+
+			assert.Equal(t, []netip.Prefix{test.prefix}, u.Announced)
+			assert.Equal(t, test.nextHop, u.NextHop)
+		})
+	}
+}
+
+func TestWithdrawGolden(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		prefix netip.Prefix
+
+		expected string
+	}{
+		{
+			name:   "IPv4",
+			prefix: netip.MustParsePrefix("10.5.0.1/32"),
+
+			expected: marker +
+				"001c 02 " + // length 28, UPDATE
+				"0005 20 0a050001 " + // withdrawn routes length 5: 10.5.0.1/32
+				"0000", // no path attributes
+		},
+		{
+			name:   "IPv6",
+			prefix: netip.MustParsePrefix("2001:db8::1/128"),
+
+			expected: marker +
+				"002e 02 " + // length 46, UPDATE
+				"0000 " + // no withdrawn routes
+				"0017 " + // path attributes length 23
+				"80 0f 14 " + // MP_UNREACH_NLRI, length 20
+				"0002 01 " + // AFI IPv6, SAFI unicast
+				"80 20010db8000000000000000000000001", // withdrawn: 2001:db8::1/128
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			expected := golden(t, test.expected)
+
+			assert.Equal(t, expected, wireMessage(t, bgp.MsgUpdate, bgp.MarshalWithdraw(test.prefix)))
+
+			_, body, err := bgp.ReadMessage(bytes.NewReader(expected))
+			require.NoError(t, err)
+
+			u, err := bgp.ParseUpdate(body)
+			require.NoError(t, err)
+
+			assert.Equal(t, []netip.Prefix{test.prefix}, u.Withdrawn)
+			assert.Empty(t, u.Announced)
+		})
+	}
+}
+
+func TestKeepaliveGolden(t *testing.T) {
+	expected := golden(t, marker+"0013 04") // length 19, KEEPALIVE
+
+	assert.Equal(t, expected, wireMessage(t, bgp.MsgKeepalive, nil))
+
+	typ, body, err := bgp.ReadMessage(bytes.NewReader(expected))
+	require.NoError(t, err)
+	assert.Equal(t, bgp.MsgKeepalive, typ)
+	assert.Empty(t, body)
+}
+
+func TestNotificationGolden(t *testing.T) {
+	// Cease, Administrative Shutdown (RFC 4486)
+	expected := golden(t, marker+"0015 03 06 02") // length 21, NOTIFICATION, error code 6, subcode 2
+
+	assert.Equal(t, expected, wireMessage(t, bgp.MsgNotification, bgp.MarshalNotification(6, 2)))
+}
+
+func TestReadMessageInvalid(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		message string
+	}{
+		{
+			name:    "bad marker",
+			message: "ffffffff ffffffff ffffffff fffffffe 0013 04",
+		},
+		{
+			name:    "short length",
+			message: marker + "0012 04",
+		},
+		{
+			name:    "long length",
+			message: marker + "1001 04",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := bgp.ReadMessage(bytes.NewReader(golden(t, test.message)))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/bgp"
+)
+
+// netnsSpeakerPeerEnv is set when the test binary is re-executed to run the speaker in the network namespace.
+const netnsSpeakerPeerEnv = "TALOS_BGP_NETNS_PEER"
+
+var (
+	netnsSpeakerAddr = netip.MustParseAddr("10.213.0.1")
+	netnsPeerAddr    = netip.MustParseAddr("10.213.0.2")
+	netnsPrefix      = netip.MustParsePrefix("10.213.1.1/32")
+)
+
+// TestSpeakerNetns runs the speaker and the peer in the separate network namespaces connected with a veth pair.
+func TestSpeakerNetns(t *testing.T) {
+	if peer := os.Getenv(netnsSpeakerPeerEnv); peer != "" {
+		runNetnsSpeaker(t, netip.MustParseAddrPort(peer))
+
+		return
+	}
+
+	if os.Getuid() != 0 {
+		t.Skip("can't run the test as non-root")
+	}
+
+	if _, err := exec.LookPath("ip"); err != nil {
+		t.Skip("ip binary is not available, skipping the test")
+	}
+
+	suffix := strconv.Itoa(os.Getpid())
+	speakerNs, peerNs := "bgp-speaker-"+suffix, "bgp-peer-"+suffix
+
+	for _, ns := range []string{speakerNs, peerNs} {
+		ns := ns
+
+		ipCmd(t, "netns", "add", ns)
+
+		t.Cleanup(func() {
+			exec.Command("ip", "netns", "del", ns).Run() //nolint:errcheck
+		})
+	}
+
+	ipCmd(t, "link", "add", "veth-speaker", "netns", speakerNs, "type", "veth", "peer", "name", "veth-peer", "netns", peerNs)
+
+	for _, link := range []struct {
+		ns, name string
+		addr     netip.Addr
+	}{
+		{speakerNs, "veth-speaker", netnsSpeakerAddr},
+		{peerNs, "veth-peer", netnsPeerAddr},
+	} {
+		ipCmd(t, "-n", link.ns, "address", "add", netip.PrefixFrom(link.addr, 24).String(), "dev", link.name)
+		ipCmd(t, "-n", link.ns, "link", "set", link.name, "up")
+		ipCmd(t, "-n", link.ns, "link", "set", "lo", "up")
+	}
+
+	peer := newTestPeerWithListener(t, listenInNetns(t, peerNs, netip.AddrPortFrom(netnsPeerAddr, 0)), 65001, false)
+
+	cmd := exec.Command("ip", "netns", "exec", speakerNs, os.Args[0], "-test.run=^TestSpeakerNetns$", "-test.v")
+	cmd.Env = append(os.Environ(), netnsSpeakerPeerEnv+"="+peer.addrPort().String())
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	require.NoError(t, cmd.Start())
+
+	t.Cleanup(func() {
+		cmd.Process.Kill() //nolint:errcheck
+		cmd.Wait()         //nolint:errcheck
+	})
+
+	require.Eventually(t, func() bool {
+		_, updates, _, _ := peer.state()
+
+		return len(updates) == 1
+	}, 30*time.Second, 10*time.Millisecond)
+
+	opens, updates, _, _ := peer.state()
+
+	require.Len(t, opens, 1)
+	assert.EqualValues(t, 65000, opens[0].ASN)
+	assert.Equal(t, netnsSpeakerAddr, opens[0].RouterID)
+
+	assert.Equal(t, []netip.Prefix{netnsPrefix}, updates[0].Announced)
+	assert.Equal(t, netnsSpeakerAddr, updates[0].NextHop)
+	assert.Equal(t, []uint32{65000}, updates[0].ASPath)
+
+	// on shutdown, the prefix is withdrawn and the session is closed
+	require.NoError(t, cmd.Process.Signal(os.Interrupt))
+
+	require.Eventually(t, func() bool {
+		_, _, notifications, _ := peer.state()
+
+		return len(notifications) == 1
+	}, 30*time.Second, 10*time.Millisecond)
+
+	_, updates, notifications, _ := peer.state()
+
+	require.Len(t, updates, 2)
+	assert.Equal(t, []netip.Prefix{netnsPrefix}, updates[1].Withdrawn)
+	assert.Equal(t, []byte{6, 2}, notifications[0])
+}
+
+// runNetnsSpeaker runs the speaker until the test binary is interrupted.
+func runNetnsSpeaker(t *testing.T, peer netip.AddrPort) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	speaker := bgp.NewSpeaker(bgp.Config{
+		LocalASN:             65000,
+		ConnectRetryInterval: 100 * time.Millisecond,
+		Peers: []bgp.PeerConfig{
+			{
+				Address: peer.Addr(),
+				Port:    peer.Port(),
+				ASN:     65001,
+			},
+		},
+		Prefixes: []netip.Prefix{netnsPrefix},
+	}, zaptest.NewLogger(t))
+
+	speaker.Run(ctx)
+}
+
+// listenInNetns creates the listener in the named network namespace.
+//
+// The socket stays in the namespace it was created in, so only the thread creating it is switched to the namespace.
+func listenInNetns(t *testing.T, ns string, addr netip.AddrPort) net.Listener {
+	t.Helper()
+
+	// if the thread can't be switched back, it stays locked, so it is terminated with the goroutine
+	runtime.LockOSThread()
+
+	origNs, err := os.Open("/proc/thread-self/ns/net")
+	require.NoError(t, err)
+
+	defer origNs.Close() //nolint:errcheck
+
+	targetNs, err := os.Open(filepath.Join("/run/netns", ns))
+	require.NoError(t, err)
+
+	defer targetNs.Close() //nolint:errcheck
+
+	require.NoError(t, unix.Setns(int(targetNs.Fd()), unix.CLONE_NEWNET))
+
+	listener, listenErr := net.Listen("tcp4", addr.String())
+
+	require.NoError(t, unix.Setns(int(origNs.Fd()), unix.CLONE_NEWNET))
+
+	runtime.UnlockOSThread()
+
+	require.NoError(t, listenErr)
+
+	return listener
+}
+
+func ipCmd(t *testing.T, args ...string) {
+	t.Helper()
+
+	out, err := exec.Command("ip", args...).CombinedOutput()
+	require.NoError(t, err, "ip %v: %s", args, string(out))
+}
@@ -0,0 +1,270 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type message struct {
+	typ  messageType
+	body []byte
+}
+
+// session is a single BGP session with the peer.
+type session struct {
+	conn    net.Conn
+	cfg     *Config
+	peer    PeerConfig
+	localIP netip.Addr
+	logger  *zap.Logger
+
+	msgCh    chan message
+	readErrC chan error
+	done     chan struct{}
+
+	peerASN  uint32
+	peerAS4  bool
+	holdTime time.Duration
+}
+
+func (sess *session) readLoop() {
+	for {
+		typ, body, err := readMessage(sess.conn)
+		if err != nil {
+			sess.readErrC <- err
+
+			return
+		}
+
+		if typ == msgNotification {
+			notification := &NotificationError{}
+
+			if len(body) >= 2 {
+				notification.Code, notification.Subcode = body[0], body[1]
+			}
+
+			sess.readErrC <- notification
+
+			return
+		}
+
+		select {
+		case sess.msgCh <- message{typ: typ, body: body}:
+		case <-sess.done:
+			return
+		}
+	}
+}
+
+// receive waits for the next message from the peer.
+func (sess *session) receive(ctx context.Context, timeout <-chan time.Time) (message, error) {
+	select {
+	case <-ctx.Done():
+		return message{}, ctx.Err()
+	case err := <-sess.readErrC:
+		return message{}, err
+	case <-timeout:
+		sess.notify(notificationHoldTimer, 0)
+
+		return message{}, errHoldTimerExpired
+	case msg := <-sess.msgCh:
+		return msg, nil
+	}
+}
+
+func (sess *session) notify(code, subcode uint8) {
+	writeMessage(sess.conn, msgNotification, marshalNotification(code, subcode)) //nolint:errcheck
+}
+
+// open performs OPEN/KEEPALIVE exchange.
+//
+//nolint:gocyclo
+func (sess *session) open(ctx context.Context) error {
+	routerID := sess.cfg.RouterID
+
+	if !routerID.IsValid() {
+		if !sess.localIP.Is4() {
+			return errors.New("router ID should be set for IPv6 sessions")
+		}
+
+		routerID = sess.localIP
+	}
+
+	families := []family{familyOf(sess.peer.Address)}
+
+	for _, prefix := range sess.cfg.Prefixes {
+		if f := familyOf(prefix.Addr()); f != families[0] {
+			families = append(families, f)
+
+			break
+		}
+	}
+
+	open := openMessage{
+		ASN:      sess.cfg.LocalASN,
+		HoldTime: uint16(sess.cfg.HoldTime / time.Second),
+		RouterID: routerID,
+		AS4:      true,
+		Families: families,
+	}
+
+	if err := writeMessage(sess.conn, msgOpen, open.marshal()); err != nil {
+		return fmt.Errorf("error sending OPEN: %w", err)
+	}
+
+	// large hold timer is used while waiting for OPEN (RFC 4271, section 8)
+	timer := time.NewTimer(4 * time.Minute)
+	defer timer.Stop()
+
+	msg, err := sess.receive(ctx, timer.C)
+	if err != nil {
+		return err
+	}
+
+	if msg.typ != msgOpen {
+		return fmt.Errorf("unexpected message %d, expected OPEN", msg.typ)
+	}
+
+	peerOpen, err := parseOpen(msg.body)
+	if err != nil {
+		return err
+	}
+
+	if peerOpen.ASN != sess.peer.ASN {
+		sess.notify(2, 2) // OPEN message error, bad peer AS
+
+		return fmt.Errorf("peer AS mismatch: expected %d, got %d", sess.peer.ASN, peerOpen.ASN)
+	}
+
+	sess.peerASN = peerOpen.ASN
+	sess.peerAS4 = peerOpen.AS4
+
+	sess.holdTime = sess.cfg.HoldTime
+	if peerHoldTime := time.Duration(peerOpen.HoldTime) * time.Second; peerHoldTime < sess.holdTime {
+		sess.holdTime = peerHoldTime
+	}
+
+	if err = writeMessage(sess.conn, msgKeepalive, nil); err != nil {
+		return fmt.Errorf("error sending KEEPALIVE: %w", err)
+	}
+
+	msg, err = sess.receive(ctx, timer.C)
+	if err != nil {
+		return err
+	}
+
+	if msg.typ != msgKeepalive {
+		return fmt.Errorf("unexpected message %d, expected KEEPALIVE", msg.typ)
+	}
+
+	return nil
+}
+
+func (sess *session) updateParams() updateParams {
+	return updateParams{
+		NextHop:  sess.localIP,
+		LocalASN: sess.cfg.LocalASN,
+		IBGP:     sess.peerASN == sess.cfg.LocalASN,
+		AS4:      sess.peerAS4,
+	}
+}
+
+// announce sends UPDATE for all the prefixes of the same address family as the session.
+func (sess *session) announce() error {
+	for _, prefix := range sess.cfg.Prefixes {
+		if prefix.Addr().Is4() != sess.localIP.Is4() {
+			sess.logger.Warn("skipping prefix with address family not matching the session", zap.Stringer("prefix", prefix))
+
+			continue
+		}
+
+		body, err := marshalAnnounce(prefix, sess.updateParams())
+		if err != nil {
+			return err
+		}
+
+		if err = writeMessage(sess.conn, msgUpdate, body); err != nil {
+			return fmt.Errorf("error announcing %s: %w", prefix, err)
+		}
+
+		sess.logger.Info("announced prefix", zap.Stringer("prefix", prefix))
+	}
+
+	return nil
+}
+
+// established runs the session until the context is canceled or the session fails.
+func (sess *session) established(ctx context.Context) error {
+	var (
+		keepaliveC <-chan time.Time
+		holdC      <-chan time.Time
+		holdTimer  *time.Timer
+	)
+
+	// zero hold time means no keepalives
+	if sess.holdTime > 0 {
+		keepaliveTicker := time.NewTicker(sess.holdTime / 3)
+		defer keepaliveTicker.Stop()
+
+		keepaliveC = keepaliveTicker.C
+
+		holdTimer = time.NewTimer(sess.holdTime)
+		defer holdTimer.Stop()
+
+		holdC = holdTimer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sess.readErrC:
+			return err
+		case <-holdC:
+			sess.notify(notificationHoldTimer, 0)
+
+			return errHoldTimerExpired
+		case <-keepaliveC:
+			if err := writeMessage(sess.conn, msgKeepalive, nil); err != nil {
+				return fmt.Errorf("error sending KEEPALIVE: %w", err)
+			}
+		case <-sess.msgCh:
+			// any message from the peer restarts the hold timer, received updates are ignored
+			if holdTimer != nil {
+				if !holdTimer.Stop() {
+					select {
+					case <-holdTimer.C:
+					default:
+					}
+				}
+
+				holdTimer.Reset(sess.holdTime)
+			}
+		}
+	}
+}
+
+// shutdown withdraws the announced prefixes and closes the session.
+func (sess *session) shutdown() error {
+	for _, prefix := range sess.cfg.Prefixes {
+		if prefix.Addr().Is4() != sess.localIP.Is4() {
+			continue
+		}
+
+		if err := writeMessage(sess.conn, msgUpdate, marshalWithdraw(prefix)); err != nil {
+			return fmt.Errorf("error withdrawing %s: %w", prefix, err)
+		}
+	}
+
+	return writeMessage(sess.conn, msgNotification, marshalNotification(notificationCease, ceaseAdminShutdown))
+}
@@ -0,0 +1,232 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Cache limits.
+const (
+	DefaultCacheSize = 4096
+	MaxTTL           = time.Hour
+	MaxNegativeTTL   = 5 * time.Minute
+)
+
+// cacheKey identifies the cached response.
+//
+// EDNS0 options of the request are part of the key, as they change the response: the UDP size
+// affects truncation, and the DO bit requests DNSSEC records.
+type cacheKey struct {
+	name    string
+	qtype   uint16
+	qclass  uint16
+	edns    bool
+	udpSize uint16
+	do      bool
+}
+
+type cacheEntry struct {
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+}
+
+// Cache stores DNS responses honouring the TTL of the records.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+	size    int
+
+	// CurrentTime is overridden in tests.
+	CurrentTime func() time.Time
+}
+
+// NewCache creates a new Cache which holds up to size responses.
+func NewCache(size int) *Cache {
+	return &Cache{
+		entries:     make(map[cacheKey]cacheEntry, size),
+		size:        size,
+		CurrentTime: time.Now,
+	}
+}
+
+func keyFromRequest(req *dns.Msg) cacheKey {
+	q := req.Question[0]
+
+	key := cacheKey{
+		name:   strings.ToLower(q.Name),
+		qtype:  q.Qtype,
+		qclass: q.Qclass,
+	}
+
+	if opt := req.IsEdns0(); opt != nil {
+		key.edns = true
+		key.udpSize = opt.UDPSize()
+		key.do = opt.Do()
+	}
+
+	return key
+}
+
+// Get returns a cached response for the request.
+//
+// The request should have exactly one question.
+// TTLs of the records in the returned response are decreased by the time spent in the cache.
+func (c *Cache) Get(req *dns.Msg) *dns.Msg {
+	if len(req.Question) != 1 {
+		return nil
+	}
+
+	key := keyFromRequest(req)
+	now := c.CurrentTime()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+
+	if ok && !now.Before(entry.expires) {
+		delete(c.entries, key)
+
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	msg := entry.msg.Copy()
+	elapsed := uint32(now.Sub(entry.stored) / time.Second)
+
+	for _, section := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+
+			if rr.Header().Ttl > elapsed {
+				rr.Header().Ttl -= elapsed
+			} else {
+				rr.Header().Ttl = 0
+			}
+		}
+	}
+
+	return msg
+}
+
+// Put stores the response to the request in the cache.
+//
+// Only successful and NXDOMAIN responses are cached, responses with zero TTL are not cached.
+func (c *Cache) Put(req, msg *dns.Msg) {
+	if len(req.Question) != 1 || len(msg.Question) != 1 || msg.Truncated {
+		return
+	}
+
+	ttl, ok := responseTTL(msg)
+	if !ok || ttl == 0 {
+		return
+	}
+
+	now := c.CurrentTime()
+	key := keyFromRequest(req)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+
+	c.entries[key] = cacheEntry{
+		msg:     msg.Copy(),
+		stored:  now,
+		expires: now.Add(ttl),
+	}
+}
+
+// Len returns the number of cached responses.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// evict removes expired entries, and if the cache is still full, an arbitrary entry.
+func (c *Cache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.size {
+			break
+		}
+
+		delete(c.entries, key)
+	}
+}
+
+// responseTTL calculates the time the response can be cached for.
+func responseTTL(msg *dns.Msg) (time.Duration, bool) {
+	switch msg.Rcode {
+	case dns.RcodeSuccess:
+	case dns.RcodeNameError:
+		// negative response is cached for SOA minimum TTL (RFC 2308)
+		for _, rr := range msg.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl := soa.Hdr.Ttl
+				if soa.Minttl < ttl {
+					ttl = soa.Minttl
+				}
+
+				return minDuration(time.Duration(ttl)*time.Second, MaxNegativeTTL), true
+			}
+		}
+
+		return 0, false
+	default:
+		return 0, false
+	}
+
+	var (
+		minTTL uint32
+		found  bool
+	)
+
+	for _, section := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, rr := range section {
+			if rr.Header().Rrtype == dns.TypeOPT {
+				continue
+			}
+
+			if !found || rr.Header().Ttl < minTTL {
+				minTTL = rr.Header().Ttl
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return 0, false
+	}
+
+	return minDuration(time.Duration(minTTL)*time.Second, MaxTTL), true
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+
+	return b
+}
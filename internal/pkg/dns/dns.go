@@ -0,0 +1,265 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dns provides a caching DNS forwarder with upstream health checking.
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+)
+
+// Default timeouts.
+const (
+	DefaultTimeout             = 2 * time.Second
+	DefaultHealthCheckInterval = 10 * time.Second
+)
+
+// Upstream is a DNS server requests are forwarded to.
+type Upstream struct {
+	addr    string
+	healthy atomic.Bool
+}
+
+// Addr returns the upstream address.
+func (u *Upstream) Addr() string {
+	return u.addr
+}
+
+// Healthy returns true if the last request to the upstream succeeded.
+func (u *Upstream) Healthy() bool {
+	return u.healthy.Load()
+}
+
+// Handler forwards DNS requests to the upstreams caching the responses.
+//
+// Requests are sent to the healthy upstreams in order, an upstream which fails to respond
+// is marked unhealthy until the health check succeeds.
+type Handler struct {
+	logger *zap.Logger
+	cache  *Cache
+
+	upstreamsMu sync.Mutex
+	upstreams   []*Upstream
+
+	// these fields are overridden in tests
+	Timeout             time.Duration
+	HealthCheckInterval time.Duration
+}
+
+// NewHandler creates a new Handler.
+func NewHandler(logger *zap.Logger) *Handler {
+	return &Handler{
+		logger:              logger,
+		cache:               NewCache(DefaultCacheSize),
+		Timeout:             DefaultTimeout,
+		HealthCheckInterval: DefaultHealthCheckInterval,
+	}
+}
+
+// Cache returns the response cache.
+func (h *Handler) Cache() *Cache {
+	return h.cache
+}
+
+// SetUpstreams replaces the list of upstreams (host:port), preserving the health state of the existing ones.
+func (h *Handler) SetUpstreams(addrs []string) {
+	h.upstreamsMu.Lock()
+	defer h.upstreamsMu.Unlock()
+
+	existing := make(map[string]*Upstream, len(h.upstreams))
+
+	for _, u := range h.upstreams {
+		existing[u.addr] = u
+	}
+
+	upstreams := make([]*Upstream, 0, len(addrs))
+
+	for _, addr := range addrs {
+		u, ok := existing[addr]
+		if !ok {
+			u = &Upstream{addr: addr}
+			u.healthy.Store(true)
+		}
+
+		upstreams = append(upstreams, u)
+	}
+
+	h.upstreams = upstreams
+}
+
+// Upstreams returns the current list of upstreams.
+func (h *Handler) Upstreams() []*Upstream {
+	h.upstreamsMu.Lock()
+	defer h.upstreamsMu.Unlock()
+
+	return append([]*Upstream(nil), h.upstreams...)
+}
+
+// ServeDNS implements dns.Handler.
+func (h *Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	resp := h.resolve(req)
+
+	if _, ok := w.LocalAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+
+		resp.Truncate(size)
+	}
+
+	if err := w.WriteMsg(resp); err != nil {
+		h.logger.Debug("error writing DNS response", zap.Error(err))
+	}
+}
+
+func (h *Handler) resolve(req *dns.Msg) *dns.Msg {
+	if len(req.Question) != 1 {
+		return new(dns.Msg).SetRcode(req, dns.RcodeFormatError)
+	}
+
+	if cached := h.cache.Get(req); cached != nil {
+		cached.Id = req.Id
+
+		return cached
+	}
+
+	resp, err := h.forward(req)
+	if err != nil {
+		h.logger.Debug("error forwarding DNS request", zap.String("question", req.Question[0].String()), zap.Error(err))
+
+		return new(dns.Msg).SetRcode(req, dns.RcodeServerFailure)
+	}
+
+	h.cache.Put(req, resp)
+
+	return resp
+}
+
+// forward sends the request to the upstreams: healthy ones first, unhealthy ones as the last resort.
+func (h *Handler) forward(req *dns.Msg) (*dns.Msg, error) {
+	upstreams := h.Upstreams()
+	if len(upstreams) == 0 {
+		return nil, errors.New("no upstreams configured")
+	}
+
+	ordered := make([]*Upstream, 0, len(upstreams))
+
+	for _, u := range upstreams {
+		if u.Healthy() {
+			ordered = append(ordered, u)
+		}
+	}
+
+	for _, u := range upstreams {
+		if !u.Healthy() {
+			ordered = append(ordered, u)
+		}
+	}
+
+	var (
+		lastResp *dns.Msg
+		errs     *multierror.Error
+	)
+
+	for _, u := range ordered {
+		resp, err := h.exchange(req, u.addr)
+		if err != nil {
+			h.markUnhealthy(u, err)
+
+			errs = multierror.Append(errs, err)
+
+			continue
+		}
+
+		u.healthy.Store(true)
+
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			// try other upstreams, but return the response if all of them fail
+			lastResp = resp
+
+			continue
+		}
+
+		return resp, nil
+	}
+
+	if lastResp != nil {
+		return lastResp, nil
+	}
+
+	return nil, errs.ErrorOrNil()
+}
+
+func (h *Handler) exchange(req *dns.Msg, addr string) (*dns.Msg, error) {
+	client := &dns.Client{
+		Net:     "udp",
+		Timeout: h.Timeout,
+	}
+
+	resp, _, err := client.Exchange(req, addr)
+	if err == nil && resp.Truncated {
+		client.Net = "tcp"
+
+		resp, _, err = client.Exchange(req, addr)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("upstream %q: %w", addr, err)
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) markUnhealthy(u *Upstream, err error) {
+	if u.healthy.Swap(false) {
+		h.logger.Warn("DNS upstream is unhealthy", zap.String("upstream", u.addr), zap.Error(err))
+	}
+}
+
+// RunHealthChecks periodically probes unhealthy upstreams until the context is canceled.
+func (h *Handler) RunHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(h.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		h.HealthCheck()
+	}
+}
+
+// HealthCheck probes unhealthy upstreams with a root NS query.
+func (h *Handler) HealthCheck() {
+	probe := new(dns.Msg).SetQuestion(".", dns.TypeNS)
+
+	for _, u := range h.Upstreams() {
+		if u.Healthy() {
+			continue
+		}
+
+		if _, err := h.exchange(probe, u.addr); err != nil {
+			continue
+		}
+
+		if !u.healthy.Swap(true) {
+			h.logger.Info("DNS upstream is healthy", zap.String("upstream", u.addr))
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"net"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	talosdns "github.com/talos-systems/talos/internal/pkg/dns"
+)
+
+type fakeUpstream struct {
+	server   *talosdns.Server
+	requests atomic.Int32
+	rcode    int
+}
+
+func (u *fakeUpstream) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	u.requests.Add(1)
+
+	resp := new(dns.Msg).SetRcode(req, u.rcode)
+
+	if u.rcode == dns.RcodeSuccess && req.Question[0].Qtype == dns.TypeA {
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.IPv4(10, 5, 0, 1),
+		})
+	}
+
+	w.WriteMsg(resp) //nolint:errcheck
+}
+
+func startUpstream(t *testing.T, rcode int) *fakeUpstream {
+	u := &fakeUpstream{rcode: rcode}
+
+	var err error
+
+	u.server, err = talosdns.NewServer(netip.MustParseAddrPort("127.0.0.1:0"), u)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { u.server.Shutdown() }) //nolint:errcheck
+
+	return u
+}
+
+// deadUpstream returns an address nobody listens on.
+func deadUpstream(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := conn.LocalAddr().String()
+
+	require.NoError(t, conn.Close())
+
+	return addr
+}
+
+func startHandler(t *testing.T, upstreams ...string) (*talosdns.Handler, string) {
+	handler := talosdns.NewHandler(zaptest.NewLogger(t))
+	handler.Timeout = 200 * time.Millisecond
+	handler.SetUpstreams(upstreams)
+
+	srv, err := talosdns.NewServer(netip.MustParseAddrPort("127.0.0.1:0"), handler)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { srv.Shutdown() }) //nolint:errcheck
+
+	return handler, srv.Addr().String()
+}
+
+func query(t *testing.T, addr, name string, network string) *dns.Msg {
+	client := &dns.Client{Net: network}
+
+	resp, _, err := client.Exchange(new(dns.Msg).SetQuestion(name, dns.TypeA), addr)
+	require.NoError(t, err)
+
+	return resp
+}
+
+func TestForwardAndCache(t *testing.T) {
+	upstream := startUpstream(t, dns.RcodeSuccess)
+
+	_, addr := startHandler(t, upstream.server.Addr().String())
+
+	for _, network := range []string{"udp", "tcp", "udp"} {
+		resp := query(t, addr, "example.com.", network)
+
+		assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+		require.Len(t, resp.Answer, 1)
+		assert.Equal(t, "10.5.0.1", resp.Answer[0].(*dns.A).A.String())
+	}
+
+	// all requests except for the first one are served from the cache
+	assert.EqualValues(t, 1, upstream.requests.Load())
+
+	query(t, addr, "EXAMPLE.com.", "udp")
+	assert.EqualValues(t, 1, upstream.requests.Load())
+
+	query(t, addr, "example.org.", "udp")
+	assert.EqualValues(t, 2, upstream.requests.Load())
+}
+
+func TestFailover(t *testing.T) {
+	failing := startUpstream(t, dns.RcodeServerFailure)
+	upstream := startUpstream(t, dns.RcodeSuccess)
+	dead := deadUpstream(t)
+
+	handler, addr := startHandler(t, dead, failing.server.Addr().String(), upstream.server.Addr().String())
+
+	resp := query(t, addr, "example.com.", "udp")
+	assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+
+	upstreams := handler.Upstreams()
+	require.Len(t, upstreams, 3)
+
+	// dead upstream is marked unhealthy, SERVFAIL doesn't affect upstream health
+	assert.False(t, upstreams[0].Healthy())
+	assert.True(t, upstreams[1].Healthy())
+	assert.True(t, upstreams[2].Healthy())
+
+	assert.EqualValues(t, 1, failing.requests.Load())
+	assert.EqualValues(t, 1, upstream.requests.Load())
+
+	// preserve health state on upstreams update
+	handler.SetUpstreams([]string{upstream.server.Addr().String(), dead})
+
+	upstreams = handler.Upstreams()
+	require.Len(t, upstreams, 2)
+
+	assert.True(t, upstreams[0].Healthy())
+	assert.False(t, upstreams[1].Healthy())
+
+	handler.HealthCheck()
+	assert.False(t, handler.Upstreams()[1].Healthy())
+}
+
+func TestAllUpstreamsFail(t *testing.T) {
+	failing := startUpstream(t, dns.RcodeServerFailure)
+
+	_, addr := startHandler(t, deadUpstream(t), failing.server.Addr().String())
+
+	resp := query(t, addr, "example.com.", "udp")
+	assert.Equal(t, dns.RcodeServerFailure, resp.Rcode)
+
+	// failures are not cached
+	query(t, addr, "example.com.", "udp")
+	assert.EqualValues(t, 2, failing.requests.Load())
+
+	_, addr = startHandler(t)
+
+	resp = query(t, addr, "example.com.", "udp")
+	assert.Equal(t, dns.RcodeServerFailure, resp.Rcode)
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache := talosdns.NewCache(2)
+
+	now := time.Now()
+	cache.CurrentTime = func() time.Time { return now }
+
+	question := dns.Question{Name: "example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+
+	req := new(dns.Msg)
+	req.Question = []dns.Question{question}
+
+	msg := new(dns.Msg)
+	msg.Question = []dns.Question{question}
+	msg.Answer = []dns.RR{
+		&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30}, A: net.IPv4(10, 5, 0, 1)},
+		&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.IPv4(10, 5, 0, 2)},
+	}
+
+	cache.Put(req, msg)
+
+	now = now.Add(10 * time.Second)
+
+	cached := cache.Get(req)
+	require.NotNil(t, cached)
+	assert.EqualValues(t, 20, cached.Answer[0].Header().Ttl)
+	assert.EqualValues(t, 50, cached.Answer[1].Header().Ttl)
+
+	// the entry expires with the minimum TTL
+	now = now.Add(20 * time.Second)
+
+	assert.Nil(t, cache.Get(req))
+	assert.Equal(t, 0, cache.Len())
+
+	// negative response is cached for SOA minimum
+	nxdomain := new(dns.Msg)
+	nxdomain.Question = []dns.Question{question}
+	nxdomain.Rcode = dns.RcodeNameError
+	nxdomain.Ns = []dns.RR{
+		&dns.SOA{Hdr: dns.RR_Header{Name: "com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 900}, Minttl: 5},
+	}
+
+	cache.Put(req, nxdomain)
+	assert.NotNil(t, cache.Get(req))
+
+	now = now.Add(5 * time.Second)
+	assert.Nil(t, cache.Get(req))
+
+	// zero TTL and SERVFAIL responses are not cached
+	msg.Answer[0].Header().Ttl = 0
+	cache.Put(req, msg)
+	assert.Equal(t, 0, cache.Len())
+
+	servfail := new(dns.Msg)
+	servfail.Question = []dns.Question{question}
+	servfail.Rcode = dns.RcodeServerFailure
+	cache.Put(req, servfail)
+	assert.Equal(t, 0, cache.Len())
+
+	// cache size is limited
+	msg.Answer[0].Header().Ttl = 30
+
+	for _, name := range []string{"a.", "b.", "c."} {
+		req.Question[0].Name = name
+		msg.Question[0].Name = name
+		cache.Put(req, msg)
+	}
+
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCacheKeyEDNS(t *testing.T) {
+	cache := talosdns.NewCache(talosdns.DefaultCacheSize)
+
+	newRequest := func(edns bool, udpSize uint16, do bool) *dns.Msg {
+		req := new(dns.Msg)
+		req.SetQuestion("example.com.", dns.TypeA)
+
+		if edns {
+			req.SetEdns0(udpSize, do)
+		}
+
+		return req
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("example.com.", dns.TypeA)
+	msg.Answer = []dns.RR{
+		&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30}, A: net.IPv4(10, 5, 0, 1)},
+	}
+
+	cache.Put(newRequest(false, 0, false), msg)
+
+	assert.NotNil(t, cache.Get(newRequest(false, 0, false)))
+	assert.Nil(t, cache.Get(newRequest(true, 1232, false)))
+
+	cache.Put(newRequest(true, 1232, false), msg)
+
+	assert.NotNil(t, cache.Get(newRequest(true, 1232, false)))
+	assert.Nil(t, cache.Get(newRequest(true, 4096, false)))
+	assert.Nil(t, cache.Get(newRequest(true, 1232, true)))
+
+	assert.Equal(t, 2, cache.Len())
+}
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/miekg/dns"
+	"golang.org/x/sys/unix"
+)
+
+// Server serves DNS requests over UDP and TCP on the same address.
+type Server struct {
+	servers []*dns.Server
+	errCh   chan error
+}
+
+// NewServer binds the listeners and starts serving the requests with the handler.
+//
+// Listeners are created with IP_FREEBIND, so the address doesn't have to be assigned to the host yet.
+func NewServer(addr netip.AddrPort, handler dns.Handler) (*Server, error) {
+	lc := net.ListenConfig{
+		Control: func(network, address string, c syscall.RawConn) error {
+			var sockErr error
+
+			if err := c.Control(func(fd uintptr) {
+				sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IP, unix.IP_FREEBIND, 1)
+			}); err != nil {
+				return err
+			}
+
+			return sockErr
+		},
+	}
+
+	packetConn, err := lc.ListenPacket(context.Background(), "udp", addr.String())
+	if err != nil {
+		return nil, fmt.Errorf("error listening on UDP %s: %w", addr, err)
+	}
+
+	// pick the same port for TCP if the port was allocated dynamically
+	addr = localAddrPort(packetConn)
+
+	listener, err := lc.Listen(context.Background(), "tcp", addr.String())
+	if err != nil {
+		packetConn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error listening on TCP %s: %w", addr, err)
+	}
+
+	srv := &Server{
+		servers: []*dns.Server{
+			{PacketConn: packetConn, Handler: handler},
+			{Listener: listener, Handler: handler},
+		},
+		errCh: make(chan error, 2),
+	}
+
+	for _, s := range srv.servers {
+		started := make(chan struct{})
+
+		s.NotifyStartedFunc = func() { close(started) }
+
+		go func(s *dns.Server) {
+			srv.errCh <- s.ActivateAndServe()
+		}(s)
+
+		select {
+		case <-started:
+		case err = <-srv.errCh:
+			srv.Shutdown()     //nolint:errcheck
+			packetConn.Close() //nolint:errcheck
+			listener.Close()   //nolint:errcheck
+
+			return nil, fmt.Errorf("error starting DNS server: %w", err)
+		}
+	}
+
+	return srv, nil
+}
+
+// Addr returns the address the server listens on.
+func (srv *Server) Addr() netip.AddrPort {
+	return localAddrPort(srv.servers[0].PacketConn)
+}
+
+func localAddrPort(conn net.PacketConn) netip.AddrPort {
+	addrPort := conn.LocalAddr().(*net.UDPAddr).AddrPort()
+
+	return netip.AddrPortFrom(addrPort.Addr().Unmap(), addrPort.Port())
+}
+
+// Shutdown stops the server.
+func (srv *Server) Shutdown() error {
+	var errs *multierror.Error
+
+	for _, s := range srv.servers {
+		if err := s.Shutdown(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/go-blockdevice/blockdevice"
 	"github.com/siderolabs/go-blockdevice/blockdevice/encryption"
 	"github.com/siderolabs/go-blockdevice/blockdevice/encryption/luks"
@@ -22,7 +23,7 @@ import (
 
 // NewHandler creates new Handler.
 func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encryptionConfig config.Encryption) (*Handler, error) {
-	keys, err := getKeys(encryptionConfig, partition)
+	keyHandlers, err := getKeyHandlers(encryptionConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,7 @@ func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encry
 		device:             device,
 		partition:          partition,
 		encryptionConfig:   encryptionConfig,
-		keys:               keys,
+		keyHandlers:        keyHandlers,
 		encryptionProvider: provider,
 	}, nil
 }
@@ -78,11 +79,22 @@ type Handler struct {
 	device             *blockdevice.BlockDevice
 	partition          *gpt.Partition
 	encryptionConfig   config.Encryption
+	keyHandlers        []keyHandler
 	keys               []*encryption.Key
+	sealedKeys         map[int][]byte
+	unavailableSlots   map[int]struct{}
+	unsealErrors       *multierror.Error
 	encryptionProvider encryption.Provider
 	encryptedPath      string
 }
 
+// keyHandler is a key handler for the key slot.
+type keyHandler struct {
+	keys.Handler
+
+	slot int
+}
+
 // Open encrypted partition.
 //
 //nolint:gocyclo
@@ -101,12 +113,26 @@ func (h *Handler) Open() (string, error) {
 
 	// encrypt if partition is not encrypted and empty
 	if sb == nil {
+		if err = h.getKeys(nil); err != nil {
+			return "", err
+		}
+
 		err = h.formatAndEncrypt(partPath)
 		if err != nil {
 			return "", err
 		}
 	} else if sb.Type() != h.encryptionConfig.Kind() {
 		return "", fmt.Errorf("failed to encrypt the partition %s, because it is not empty", partPath)
+	} else {
+		var tokens map[int]*sealedKeyToken
+
+		if tokens, err = readTokens(partPath); err != nil {
+			return "", err
+		}
+
+		if err = h.getKeys(tokens); err != nil {
+			return "", err
+		}
 	}
 
 	var k *encryption.Key
@@ -125,12 +151,20 @@ func (h *Handler) Open() (string, error) {
 	}
 
 	if path == "" {
+		if unsealErr := h.unsealErrors.ErrorOrNil(); unsealErr != nil {
+			return "", fmt.Errorf("failed to open encrypted device %s, no key matched: %w", partPath, unsealErr)
+		}
+
 		return "", fmt.Errorf("failed to open encrypted device %s, no key matched", partPath)
 	}
 
 	log.Printf("mapped encrypted partition %s -> %s", partPath, path)
 
-	if err = h.syncKeys(k, partPath); err != nil {
+	if _, err = h.syncKeys(k, partPath); err != nil {
+		return "", err
+	}
+
+	if err = h.writeSealedKeys(partPath); err != nil {
 		return "", err
 	}
 
@@ -177,17 +211,97 @@ func (h *Handler) formatAndEncrypt(path string) error {
 	return nil
 }
 
-//nolint:gocyclo
-func (h *Handler) syncKeys(k *encryption.Key, path string) error {
+// SyncResult describes the changes made to the keyslots by the keys sync.
+type SyncResult struct {
+	Keyslots []int
+	Added    []int
+	Updated  []int
+	Removed  []int
+}
+
+// SyncKeys reconciles keyslots of the partition with the new encryption config.
+//
+// Partition should be already opened.
+// New keys are added (and verified) first, keys which are not in the config are removed last,
+// so the partition can always be unlocked with at least one key.
+func (h *Handler) SyncKeys(encryptionConfig config.Encryption) (*SyncResult, error) {
+	if h.encryptedPath == "" {
+		return nil, fmt.Errorf("encrypted partition %s is not open", h.partition.Name)
+	}
+
+	keyHandlers, err := getKeyHandlers(encryptionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	h.encryptionConfig = encryptionConfig
+	h.keyHandlers = keyHandlers
+
+	partPath, err := h.partition.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := readTokens(partPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.getKeys(tokens); err != nil {
+		return nil, err
+	}
+
+	// find the key which unlocks the partition
+	var k *encryption.Key
+
+	for _, key := range h.keys {
+		var valid bool
+
+		if valid, err = h.encryptionProvider.CheckKey(partPath, key); err != nil {
+			return nil, err
+		}
+
+		if valid {
+			k = key
+
+			break
+		}
+	}
+
+	if k == nil {
+		return nil, fmt.Errorf("none of the configured keys unlocks the partition %s, at least one of the existing keys should be kept", partPath)
+	}
+
+	result, err := h.syncKeys(k, partPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.writeSealedKeys(partPath); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+//nolint:gocyclo,cyclop
+func (h *Handler) syncKeys(k *encryption.Key, path string) (*SyncResult, error) {
 	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	result := &SyncResult{}
+
 	visited := map[string]bool{}
 
+	// keep the slots which keys can't be fetched
+	for slot := range h.unavailableSlots {
+		visited[strconv.Itoa(slot)] = true
+	}
+
 	for _, key := range h.keys {
-		slot := fmt.Sprintf("%d", key.Slot)
+		slot := strconv.Itoa(key.Slot)
 		visited[slot] = true
 		// no need to update the key which we already detected as unchanged
 		if k.Slot == key.Slot {
@@ -196,17 +310,29 @@ func (h *Handler) syncKeys(k *encryption.Key, path string) error {
 
 		// keyslot exists
 		if _, ok := keyslots.Keyslots[slot]; ok {
-			if err = h.updateKey(k, key, path); err != nil {
-				return err
+			var updated bool
+
+			if updated, err = h.updateKey(k, key, path); err != nil {
+				return nil, err
 			}
 
-			log.Printf("updated encryption key at slot %d", key.Slot)
+			if updated {
+				result.Updated = append(result.Updated, key.Slot)
+
+				log.Printf("updated encryption key at slot %d", key.Slot)
+			}
 		} else {
 			// keyslot does not exist so just add the key
 			if err = h.encryptionProvider.AddKey(path, k, key); err != nil {
-				return err
+				return nil, err
 			}
 
+			if err = h.verifyKey(key, path); err != nil {
+				return nil, err
+			}
+
+			result.Added = append(result.Added, key.Slot)
+
 			log.Printf("added encryption key to slot %d", key.Slot)
 		}
 	}
@@ -214,62 +340,165 @@ func (h *Handler) syncKeys(k *encryption.Key, path string) error {
 	// cleanup deleted key slots
 	for slot := range keyslots.Keyslots {
 		if !visited[slot] {
-			s, err := strconv.ParseInt(slot, 10, 64)
+			s, err := strconv.Atoi(slot)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			if err = h.encryptionProvider.RemoveKey(path, int(s), k); err != nil {
-				return err
+			if err = h.encryptionProvider.RemoveKey(path, s, k); err != nil {
+				return nil, err
 			}
 
-			log.Printf("removed key at slot %d", k.Slot)
+			if err = removeToken(path, s); err != nil {
+				return nil, err
+			}
+
+			result.Removed = append(result.Removed, s)
+
+			log.Printf("removed key at slot %d", s)
 		}
 	}
 
-	return nil
-}
+	sort.Ints(result.Removed)
 
-func (h *Handler) updateKey(existingKey, newKey *encryption.Key, path string) error {
-	if valid, err := h.encryptionProvider.CheckKey(path, newKey); err != nil {
-		return err
-	} else if !valid {
-		// re-add the key to the slot
-		err = h.encryptionProvider.RemoveKey(path, newKey.Slot, existingKey)
-		if err != nil {
-			return fmt.Errorf("failed to drop old key during key update %w", err)
-		}
+	if keyslots, err = h.encryptionProvider.ReadKeyslots(path); err != nil {
+		return nil, err
+	}
 
-		err = h.encryptionProvider.AddKey(path, existingKey, newKey)
+	for slot := range keyslots.Keyslots {
+		s, err := strconv.Atoi(slot)
 		if err != nil {
-			return fmt.Errorf("failed to add new key during key update %w", err)
+			return nil, err
 		}
 
+		result.Keyslots = append(result.Keyslots, s)
+	}
+
+	sort.Ints(result.Keyslots)
+
+	return result, nil
+}
+
+// updateKey replaces the key in the slot if the key doesn't match.
+func (h *Handler) updateKey(existingKey, newKey *encryption.Key, path string) (bool, error) {
+	if valid, err := h.encryptionProvider.CheckKey(path, newKey); err != nil {
+		return false, err
+	} else if valid {
+		return false, nil
+	}
+
+	// re-add the key to the slot
+	if err := h.encryptionProvider.RemoveKey(path, newKey.Slot, existingKey); err != nil {
+		return false, fmt.Errorf("failed to drop old key during key update %w", err)
+	}
+
+	if err := h.encryptionProvider.AddKey(path, existingKey, newKey); err != nil {
+		return false, fmt.Errorf("failed to add new key during key update %w", err)
+	}
+
+	return true, h.verifyKey(newKey, path)
+}
+
+// verifyKey checks that the key unlocks the partition.
+func (h *Handler) verifyKey(key *encryption.Key, path string) error {
+	valid, err := h.encryptionProvider.CheckKey(path, key)
+	if err != nil {
 		return err
 	}
 
+	if !valid {
+		return fmt.Errorf("key at slot %d doesn't unlock the partition after it was added", key.Slot)
+	}
+
 	return nil
 }
 
-func getKeys(encryptionConfig config.Encryption, partition *gpt.Partition) ([]*encryption.Key, error) {
-	encryptionKeys := make([]*encryption.Key, len(encryptionConfig.Keys()))
+func getKeyHandlers(encryptionConfig config.Encryption) ([]keyHandler, error) {
+	keyHandlers := make([]keyHandler, 0, len(encryptionConfig.Keys()))
 
-	for i, cfg := range encryptionConfig.Keys() {
+	for _, cfg := range encryptionConfig.Keys() {
 		handler, err := keys.NewHandler(cfg)
 		if err != nil {
 			return nil, err
 		}
 
-		k, err := handler.GetKey(keys.WithPartitionLabel(partition.Name))
+		keyHandlers = append(keyHandlers, keyHandler{
+			Handler: handler,
+			slot:    cfg.Slot(),
+		})
+	}
+
+	//nolint:scopelint
+	sort.Slice(keyHandlers, func(i, j int) bool { return keyHandlers[i].slot < keyHandlers[j].slot })
+
+	return keyHandlers, nil
+}
+
+// getKeys fetches the keys from the key handlers.
+//
+// Sealing key handlers unseal the keys stored in the tokens, or generate new keys
+// if the token doesn't exist (sealed keys are written after the keyslot is updated).
+// If the sealed key can't be unsealed, the keyslot is left untouched.
+func (h *Handler) getKeys(tokens map[int]*sealedKeyToken) error {
+	h.keys = make([]*encryption.Key, 0, len(h.keyHandlers))
+	h.sealedKeys = map[int][]byte{}
+	h.unavailableSlots = map[int]struct{}{}
+	h.unsealErrors = nil
+
+	for _, handler := range h.keyHandlers {
+		opts := []keys.KeyOption{keys.WithPartitionLabel(h.partition.Name)}
+
+		sealingHandler, ok := handler.Handler.(keys.SealingHandler)
+		if !ok {
+			k, err := handler.GetKey(opts...)
+			if err != nil {
+				return err
+			}
+
+			h.keys = append(h.keys, encryption.NewKey(handler.slot, k))
+
+			continue
+		}
+
+		if token, ok := tokens[handler.slot]; ok {
+			k, err := sealingHandler.GetKey(append(opts, keys.WithSealedKey(token.SealedKey))...)
+			if err != nil {
+				log.Printf("failed to unseal the key at slot %d: %s", handler.slot, err)
+
+				h.unavailableSlots[handler.slot] = struct{}{}
+				h.unsealErrors = multierror.Append(h.unsealErrors, fmt.Errorf("slot %d: %w", handler.slot, err))
+
+				continue
+			}
+
+			h.keys = append(h.keys, encryption.NewKey(handler.slot, k))
+
+			continue
+		}
+
+		k, sealedKey, err := sealingHandler.NewKey(opts...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		encryptionKeys[i] = encryption.NewKey(cfg.Slot(), k)
+		h.keys = append(h.keys, encryption.NewKey(handler.slot, k))
+		h.sealedKeys[handler.slot] = sealedKey
 	}
 
-	//nolint:scopelint
-	sort.Slice(encryptionKeys, func(i, j int) bool { return encryptionKeys[i].Slot < encryptionKeys[j].Slot })
+	return nil
+}
+
+// writeSealedKeys stores newly sealed keys in the LUKS2 tokens.
+func (h *Handler) writeSealedKeys(path string) error {
+	for slot, sealedKey := range h.sealedKeys {
+		if err := writeToken(path, slot, sealedKey); err != nil {
+			return err
+		}
 
-	return encryptionKeys, nil
+		log.Printf("stored sealed key for slot %d", slot)
+	}
+
+	h.sealedKeys = nil
+
+	return nil
 }
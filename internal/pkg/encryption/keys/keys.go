@@ -6,6 +6,7 @@
 package keys
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
@@ -23,6 +24,15 @@ func NewHandler(key config.EncryptionKey) (Handler, error) {
 		return NewStaticKeyHandler(k)
 	case key.NodeID() != nil:
 		return NewNodeIDKeyHandler()
+	case key.TPM() != nil:
+		return NewTPMKeyHandler(key.TPM().PCRs())
+	case key.KMS() != nil:
+		tlsConfig, err := key.KMS().GetTLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("KMS key TLS config is invalid: %w", err)
+		}
+
+		return NewKMSKeyHandler(key.KMS().Endpoint(), tlsConfig)
 	}
 
 	return nil, fmt.Errorf("failed to create key handler: malformed config")
@@ -32,3 +42,16 @@ func NewHandler(key config.EncryptionKey) (Handler, error) {
 type Handler interface {
 	GetKey(options ...KeyOption) ([]byte, error)
 }
+
+// SealingHandler represents an interface for key handlers which generate random keys
+// and seal them, sealed keys are stored along with the encrypted partition.
+//
+// Sealed key is passed back to the GetKey func using WithSealedKey option.
+type SealingHandler interface {
+	Handler
+
+	NewKey(options ...KeyOption) (key, sealedKey []byte, err error)
+}
+
+// ErrSealedKeyNotFound is returned by SealingHandler.GetKey if the sealed key is not provided.
+var ErrSealedKeyNotFound = errors.New("sealed key not found")
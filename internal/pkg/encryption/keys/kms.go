@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/talos-systems/go-retry/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/smbios"
+	"github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+const (
+	// kmsKeySize is the size of the random key sealed by the KMS server.
+	kmsKeySize = 32
+
+	// kmsRetryTimeout is the time to wait for the KMS server to become reachable.
+	kmsRetryTimeout = 5 * time.Minute
+)
+
+// KMSKeyHandler seals a random key with the remote key management server.
+//
+// Sealed key can only be unsealed while the KMS server is reachable,
+// so the encrypted partition can't be opened outside of the network.
+type KMSKeyHandler struct {
+	endpoint  string
+	tlsConfig *tls.Config
+
+	retryTimeout time.Duration
+	getNodeUUID  func() (string, error)
+}
+
+// NewKMSKeyHandler creates new KMSKeyHandler.
+func NewKMSKeyHandler(endpoint string, tlsConfig *tls.Config) (*KMSKeyHandler, error) {
+	return &KMSKeyHandler{
+		endpoint:     endpoint,
+		tlsConfig:    tlsConfig,
+		retryTimeout: kmsRetryTimeout,
+		getNodeUUID: func() (string, error) {
+			s, err := smbios.GetSMBIOSInfo()
+			if err != nil {
+				return "", err
+			}
+
+			if s.SystemInformation.UUID == "" {
+				return "", fmt.Errorf("machine UUID is not populated")
+			}
+
+			return s.SystemInformation.UUID, nil
+		},
+	}, nil
+}
+
+// NewKey implements SealingHandler interface.
+func (h *KMSKeyHandler) NewKey(options ...KeyOption) (key, sealedKey []byte, err error) {
+	key = make([]byte, kmsKeySize)
+
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	sealedKey, err = h.call(kms.KMSServiceClient.Seal, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sealing the key: %w", err)
+	}
+
+	return key, sealedKey, nil
+}
+
+// GetKey implements Handler interface.
+//
+// GetKey unseals the key passed with WithSealedKey option.
+func (h *KMSKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+	opts, err := NewDefaultOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SealedKey == nil {
+		return nil, ErrSealedKeyNotFound
+	}
+
+	key, err := h.call(kms.KMSServiceClient.Unseal, opts.SealedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unsealing the key: %w", err)
+	}
+
+	return key, nil
+}
+
+type kmsCall func(kms.KMSServiceClient, context.Context, *kms.Request, ...grpc.CallOption) (*kms.Response, error)
+
+// call invokes the KMS server method retrying while the server is unavailable.
+func (h *KMSKeyHandler) call(method kmsCall, data []byte) ([]byte, error) {
+	nodeUUID, err := h.getNodeUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(h.endpoint, grpc.WithTransportCredentials(credentials.NewTLS(h.tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to KMS server %q: %w", h.endpoint, err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	client := kms.NewKMSServiceClient(conn)
+
+	var resp *kms.Response
+
+	err = retry.Exponential(h.retryTimeout,
+		retry.WithAttemptTimeout(10*time.Second),
+		retry.WithUnits(time.Second),
+		retry.WithJitter(100*time.Millisecond),
+		retry.WithErrorLogging(true),
+	).RetryWithContext(context.Background(), func(ctx context.Context) error {
+		resp, err = method(client, ctx, &kms.Request{
+			NodeUuid: nodeUUID,
+			Data:     data,
+		})
+
+		switch status.Code(err) { //nolint:exhaustive
+		case codes.OK:
+			return nil
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return retry.ExpectedError(err)
+		default:
+			return err
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("KMS server %q: %w", h.endpoint, err)
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys //nolint:testpackage
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/talos-systems/talos/internal/pkg/kms"
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+type kmsEnv struct {
+	endpoint string
+	rootCAs  *stdx509.CertPool
+
+	clientCert tls.Certificate
+}
+
+// startKMSServer runs the reference KMS server with mutual TLS.
+func startKMSServer(t *testing.T) *kmsEnv {
+	t.Helper()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.ECDSA(true))
+	require.NoError(t, err)
+
+	serverKeyPair, err := x509.NewKeyPair(ca,
+		x509.ECDSA(true),
+		x509.IPAddresses([]net.IP{net.ParseIP("127.0.0.1")}),
+		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth}),
+	)
+	require.NoError(t, err)
+
+	clientKeyPair, err := x509.NewKeyPair(ca,
+		x509.ECDSA(true),
+		x509.CommonName("node-1"),
+		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageClientAuth}),
+	)
+	require.NoError(t, err)
+
+	clientCert, err := tls.X509KeyPair(clientKeyPair.CrtPEM, clientKeyPair.KeyPEM)
+	require.NoError(t, err)
+
+	serverCert, err := tls.X509KeyPair(serverKeyPair.CrtPEM, serverKeyPair.KeyPEM)
+	require.NoError(t, err)
+
+	certPool := stdx509.NewCertPool()
+	require.True(t, certPool.AppendCertsFromPEM(ca.CrtPEM))
+
+	masterKey := make([]byte, kms.MasterKeySize)
+
+	_, err = rand.Read(masterKey)
+	require.NoError(t, err)
+
+	srv, err := kms.NewServer(masterKey)
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+		MinVersion:   tls.VersionTLS12,
+	})))
+
+	kmsapi.RegisterKMSServiceServer(grpcServer, srv)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go grpcServer.Serve(lis) //nolint:errcheck
+
+	t.Cleanup(grpcServer.Stop)
+
+	return &kmsEnv{
+		endpoint:   lis.Addr().String(),
+		rootCAs:    certPool,
+		clientCert: clientCert,
+	}
+}
+
+func newTestKMSKeyHandler(t *testing.T, endpoint string, tlsConfig *tls.Config, nodeUUID string) *KMSKeyHandler {
+	t.Helper()
+
+	h, err := NewKMSKeyHandler(endpoint, tlsConfig)
+	require.NoError(t, err)
+
+	h.retryTimeout = 3 * time.Second
+	h.getNodeUUID = func() (string, error) {
+		return nodeUUID, nil
+	}
+
+	return h
+}
+
+func TestKMSKeyHandler(t *testing.T) {
+	env := startKMSServer(t)
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{env.clientCert},
+		RootCAs:      env.rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	h := newTestKMSKeyHandler(t, env.endpoint, tlsConfig, "node-1")
+
+	key, sealedKey, err := h.NewKey()
+	require.NoError(t, err)
+	assert.Len(t, key, kmsKeySize)
+	assert.NotEqual(t, key, sealedKey)
+
+	// sealed key is required to get the key
+	_, err = h.GetKey()
+	require.ErrorIs(t, err, ErrSealedKeyNotFound)
+
+	unsealed, err := h.GetKey(WithSealedKey(sealedKey))
+	require.NoError(t, err)
+	assert.Equal(t, key, unsealed)
+
+	// keys are random
+	anotherKey, _, err := h.NewKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, anotherKey)
+
+	// the key can't be unsealed for another node
+	_, err = newTestKMSKeyHandler(t, env.endpoint, tlsConfig, "node-2").GetKey(WithSealedKey(sealedKey))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+}
+
+func TestKMSKeyHandlerNoClientCertificate(t *testing.T) {
+	env := startKMSServer(t)
+
+	h := newTestKMSKeyHandler(t, env.endpoint, &tls.Config{
+		RootCAs:    env.rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}, "node-1")
+
+	_, _, err := h.NewKey()
+	require.Error(t, err)
+}
+
+func TestKMSKeyHandlerUnreachable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	endpoint := lis.Addr().String()
+
+	require.NoError(t, lis.Close())
+
+	h := newTestKMSKeyHandler(t, endpoint, &tls.Config{MinVersion: tls.VersionTLS12}, "node-1")
+
+	start := time.Now()
+
+	_, err = h.GetKey(WithSealedKey([]byte("sealed")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), endpoint)
+
+	// unavailable server is retried until the timeout
+	assert.GreaterOrEqual(t, time.Since(start), h.retryTimeout)
+}
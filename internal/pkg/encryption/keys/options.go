@@ -10,6 +10,7 @@ type KeyOption func(o *KeyOptions) error
 // KeyOptions set of options to be used in KeyHandler.GetKey func.
 type KeyOptions struct {
 	PartitionLabel string
+	SealedKey      []byte
 }
 
 // WithPartitionLabel passes the partition label in to GetKey function.
@@ -21,6 +22,15 @@ func WithPartitionLabel(label string) KeyOption {
 	}
 }
 
+// WithSealedKey passes the sealed key in to GetKey function.
+func WithSealedKey(sealedKey []byte) KeyOption {
+	return func(o *KeyOptions) error {
+		o.SealedKey = sealedKey
+
+		return nil
+	}
+}
+
 // NewDefaultOptions creates new KeyOptions.
 func NewDefaultOptions(options []KeyOption) (*KeyOptions, error) {
 	var opts KeyOptions
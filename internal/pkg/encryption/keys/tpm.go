@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+)
+
+// tpmKeySize is the size of the random key sealed to the TPM.
+const tpmKeySize = 32
+
+// srkTemplate is the template of the Storage Root Key (ECC P256) the key is sealed under.
+//
+// SRK is created from the same template every time, so it doesn't need to be persisted.
+var srkTemplate = tpm2.Public{
+	Type:       tpm2.AlgECC,
+	NameAlg:    tpm2.AlgSHA256,
+	Attributes: tpm2.FlagFixedTPM | tpm2.FlagFixedParent | tpm2.FlagSensitiveDataOrigin | tpm2.FlagUserWithAuth | tpm2.FlagNoDA | tpm2.FlagRestricted | tpm2.FlagDecrypt,
+	ECCParameters: &tpm2.ECCParams{
+		Symmetric: &tpm2.SymScheme{
+			Alg:     tpm2.AlgAES,
+			KeyBits: 128,
+			Mode:    tpm2.AlgCFB,
+		},
+		CurveID: tpm2.CurveNISTP256,
+	},
+}
+
+// tpmSealedKey is the serialized form of the sealed key.
+type tpmSealedKey struct {
+	PCRs    []int  `json:"pcrs"`
+	Public  []byte `json:"public"`
+	Private []byte `json:"private"`
+}
+
+// TPMKeyHandler seals a random key to the TPM binding it to the PCR values.
+type TPMKeyHandler struct {
+	pcrs []int
+
+	openTPM func() (io.ReadWriteCloser, error)
+}
+
+// NewTPMKeyHandler creates new TPMKeyHandler.
+func NewTPMKeyHandler(pcrs []int) (*TPMKeyHandler, error) {
+	return &TPMKeyHandler{
+		pcrs: pcrs,
+		openTPM: func() (io.ReadWriteCloser, error) {
+			return tpm2.OpenTPM()
+		},
+	}, nil
+}
+
+// NewKey implements SealingHandler interface.
+func (h *TPMKeyHandler) NewKey(options ...KeyOption) (key, sealedKey []byte, err error) {
+	key = make([]byte, tpmKeySize)
+
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	rw, err := h.openTPM()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening TPM: %w", err)
+	}
+
+	defer rw.Close() //nolint:errcheck
+
+	srk, err := createSRK(rw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer tpm2.FlushContext(rw, srk) //nolint:errcheck
+
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: h.pcrs}
+
+	policy, err := pcrPolicy(rw, tpm2.SessionTrial, sel, func(session tpmutil.Handle) ([]byte, error) {
+		return tpm2.PolicyGetDigest(rw, session)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error calculating PCR policy: %w", err)
+	}
+
+	private, public, err := tpm2.Seal(rw, srk, "", "", policy, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sealing the key: %w", err)
+	}
+
+	sealedKey, err = json.Marshal(tpmSealedKey{
+		PCRs:    h.pcrs,
+		Public:  public,
+		Private: private,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, sealedKey, nil
+}
+
+// GetKey implements Handler interface.
+//
+// GetKey unseals the key passed with WithSealedKey option.
+func (h *TPMKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+	opts, err := NewDefaultOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SealedKey == nil {
+		return nil, ErrSealedKeyNotFound
+	}
+
+	var sealed tpmSealedKey
+
+	if err = json.Unmarshal(opts.SealedKey, &sealed); err != nil {
+		return nil, fmt.Errorf("error decoding sealed key: %w", err)
+	}
+
+	rw, err := h.openTPM()
+	if err != nil {
+		return nil, fmt.Errorf("error opening TPM: %w", err)
+	}
+
+	defer rw.Close() //nolint:errcheck
+
+	srk, err := createSRK(rw)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tpm2.FlushContext(rw, srk) //nolint:errcheck
+
+	handle, _, err := tpm2.Load(rw, srk, "", sealed.Public, sealed.Private)
+	if err != nil {
+		return nil, fmt.Errorf("error loading sealed key: %w", err)
+	}
+
+	defer tpm2.FlushContext(rw, handle) //nolint:errcheck
+
+	// PCRs are taken from the sealed key, as they might have been changed in the config since
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: sealed.PCRs}
+
+	key, err := pcrPolicy(rw, tpm2.SessionPolicy, sel, func(session tpmutil.Handle) ([]byte, error) {
+		return tpm2.UnsealWithSession(rw, session, handle, "")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error unsealing the key: %w", err)
+	}
+
+	return key, nil
+}
+
+func createSRK(rw io.ReadWriter) (tpmutil.Handle, error) {
+	srk, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner, tpm2.PCRSelection{}, "", "", srkTemplate)
+	if err != nil {
+		return 0, fmt.Errorf("error creating SRK: %w", err)
+	}
+
+	return srk, nil
+}
+
+// pcrPolicy starts a session, applies PCR policy to it and calls f with the session.
+func pcrPolicy(rw io.ReadWriter, sessionType tpm2.SessionType, sel tpm2.PCRSelection, f func(session tpmutil.Handle) ([]byte, error)) ([]byte, error) {
+	nonce := make([]byte, 16)
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	session, _, err := tpm2.StartAuthSession(rw, tpm2.HandleNull, tpm2.HandleNull, nonce, nil, sessionType, tpm2.AlgNull, tpm2.AlgSHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tpm2.FlushContext(rw, session) //nolint:errcheck
+
+	// nil expected digest means current PCR values are used
+	if err = tpm2.PolicyPCR(rw, session, nil, sel); err != nil {
+		return nil, err
+	}
+
+	return f(session)
+}
@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys //nolint:testpackage
+
+import (
+	"io"
+	"testing"
+
+	"github.com/google/go-tpm-tools/simulator"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nopCloser keeps the simulator running between TPM sessions.
+type nopCloser struct {
+	io.ReadWriter
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func newSimulatedTPMKeyHandler(t *testing.T, sim *simulator.Simulator, pcrs []int) *TPMKeyHandler {
+	t.Helper()
+
+	h, err := NewTPMKeyHandler(pcrs)
+	require.NoError(t, err)
+
+	h.openTPM = func() (io.ReadWriteCloser, error) {
+		return nopCloser{sim}, nil
+	}
+
+	return h
+}
+
+func TestTPMKeyHandler(t *testing.T) {
+	sim, err := simulator.Get()
+	require.NoError(t, err)
+
+	defer sim.Close() //nolint:errcheck
+
+	h := newSimulatedTPMKeyHandler(t, sim, []int{7})
+
+	key, sealedKey, err := h.NewKey()
+	require.NoError(t, err)
+	assert.Len(t, key, tpmKeySize)
+
+	// sealed key is required to get the key
+	_, err = h.GetKey()
+	require.ErrorIs(t, err, ErrSealedKeyNotFound)
+
+	unsealed, err := h.GetKey(WithSealedKey(sealedKey))
+	require.NoError(t, err)
+	assert.Equal(t, key, unsealed)
+
+	// PCRs are taken from the sealed key
+	unsealed, err = newSimulatedTPMKeyHandler(t, sim, []int{0, 1}).GetKey(WithSealedKey(sealedKey))
+	require.NoError(t, err)
+	assert.Equal(t, key, unsealed)
+
+	// each new key is random
+	key2, _, err := h.NewKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, key2)
+
+	// changing bound PCR value prevents unsealing
+	require.NoError(t, tpm2.PCREvent(sim, 7, []byte("secureboot disabled")))
+
+	_, err = h.GetKey(WithSealedKey(sealedKey))
+	require.Error(t, err)
+}
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/luks"
+	"github.com/talos-systems/go-cmd/pkg/cmd"
+	"golang.org/x/sys/unix"
+)
+
+// sealedKeyTokenType is the LUKS2 token type used to store sealed keys.
+const sealedKeyTokenType = "talos-sealed-key"
+
+// sealedKeyToken is the LUKS2 token which holds the sealed key for the keyslot.
+//
+// Token ID matches the keyslot number.
+type sealedKeyToken struct {
+	Type      string   `json:"type"`
+	Keyslots  []string `json:"keyslots"`
+	SealedKey []byte   `json:"sealedKey"`
+}
+
+// readTokens reads sealed key tokens from the LUKS2 header.
+func readTokens(path string) (map[int]*sealedKeyToken, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	sb := &luks.SuperBlock{}
+
+	if err = binary.Read(f, binary.BigEndian, sb); err != nil {
+		return nil, err
+	}
+
+	size := binary.Size(sb)
+
+	jsonArea := make([]byte, int(sb.HeaderSize)-size)
+
+	if _, err = f.ReadAt(jsonArea, int64(size)); err != nil {
+		return nil, err
+	}
+
+	jsonArea = bytes.Trim(bytes.TrimSpace(jsonArea), "\x00")
+
+	var header struct {
+		Tokens map[string]json.RawMessage `json:"tokens"`
+	}
+
+	if err = json.Unmarshal(jsonArea, &header); err != nil {
+		return nil, err
+	}
+
+	tokens := map[int]*sealedKeyToken{}
+
+	for id, raw := range header.Tokens {
+		var token sealedKeyToken
+
+		if err = json.Unmarshal(raw, &token); err != nil || token.Type != sealedKeyTokenType {
+			continue
+		}
+
+		tokenID, err := strconv.Atoi(id)
+		if err != nil {
+			continue
+		}
+
+		tokens[tokenID] = &token
+	}
+
+	return tokens, nil
+}
+
+// writeToken stores the sealed key for the keyslot replacing the existing token.
+func writeToken(path string, slot int, sealedKey []byte) error {
+	token, err := json.Marshal(&sealedKeyToken{
+		Type:      sealedKeyTokenType,
+		Keyslots:  []string{strconv.Itoa(slot)},
+		SealedKey: sealedKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = removeToken(path, slot); err != nil {
+		return err
+	}
+
+	return runCryptsetup(token, "token", "import", "--token-id", strconv.Itoa(slot), "--json-file", "-", path)
+}
+
+// removeToken removes the sealed key token for the keyslot if it exists.
+func removeToken(path string, slot int) error {
+	tokens, err := readTokens(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[slot]; !ok {
+		return nil
+	}
+
+	return runCryptsetup(nil, "token", "remove", "--token-id", strconv.Itoa(slot), path)
+}
+
+func runCryptsetup(stdin []byte, args ...string) error {
+	if _, err := cmd.RunContext(cmd.WithStdin(context.Background(), bytes.NewReader(stdin)), "cryptsetup", args...); err != nil {
+		return fmt.Errorf("failed to call cryptsetup: %w", err)
+	}
+
+	return nil
+}
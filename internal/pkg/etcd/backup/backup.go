@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package backup implements scheduled etcd snapshots.
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup/crypt"
+)
+
+const (
+	snapshotPrefix  = "etcd-"
+	snapshotSuffix  = ".snapshot"
+	encryptedSuffix = ".enc"
+
+	timeFormat = "20060102T150405Z"
+)
+
+// SnapshotName returns the name of the snapshot taken at the specified time.
+func SnapshotName(t time.Time, encrypted bool) string {
+	name := snapshotPrefix + t.UTC().Format(timeFormat) + snapshotSuffix
+
+	if encrypted {
+		name += encryptedSuffix
+	}
+
+	return name
+}
+
+// SnapshotTime parses the time the snapshot was taken from the snapshot name.
+func SnapshotTime(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, snapshotPrefix) {
+		return time.Time{}, false
+	}
+
+	ts := strings.TrimPrefix(name, snapshotPrefix)
+	ts = strings.TrimSuffix(ts, encryptedSuffix)
+
+	if !strings.HasSuffix(ts, snapshotSuffix) {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(timeFormat, strings.TrimSuffix(ts, snapshotSuffix))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// Save stores the snapshot in the directory, encrypting it if the key is set.
+//
+// Save returns the size of the stored snapshot.
+func Save(dir, name string, snapshot io.Reader, key []byte) (int64, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, err
+	}
+
+	tmpPath := filepath.Join(dir, "."+name+".tmp")
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.Remove(tmpPath) //nolint:errcheck
+
+	defer f.Close() //nolint:errcheck
+
+	if err = write(f, snapshot, key); err != nil {
+		return 0, fmt.Errorf("error writing snapshot: %w", err)
+	}
+
+	if err = f.Sync(); err != nil {
+		return 0, err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = f.Close(); err != nil {
+		return 0, err
+	}
+
+	if err = os.Rename(tmpPath, filepath.Join(dir, name)); err != nil {
+		return 0, err
+	}
+
+	return st.Size(), nil
+}
+
+func write(w io.Writer, snapshot io.Reader, key []byte) error {
+	if key == nil {
+		_, err := io.Copy(w, snapshot)
+
+		return err
+	}
+
+	encrypted, err := crypt.NewEncryptingWriter(w, key)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(encrypted, snapshot); err != nil {
+		return err
+	}
+
+	return encrypted.Close()
+}
+
+// List returns the names of the snapshots stored in the directory.
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		if _, ok := SnapshotTime(entry.Name()); ok {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// Latest returns the time of the most recent snapshot, or zero time if there are no snapshots.
+func Latest(names []string) time.Time {
+	var latest time.Time
+
+	for _, name := range names {
+		if t, ok := SnapshotTime(name); ok && t.After(latest) {
+			latest = t
+		}
+	}
+
+	return latest
+}
+
+// Prune removes the snapshots from the directory according to the retention policy.
+func Prune(dir string, count int, maxAge time.Duration, now time.Time) error {
+	names, err := List(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range Expired(names, count, maxAge, now) {
+		if err = os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Expired returns the names of the snapshots which should be removed according to the retention policy.
+//
+// Only the count most recent snapshots are kept, and snapshots older than maxAge are removed
+// (zero value disables the corresponding policy).
+// The most recent snapshot is never removed.
+func Expired(names []string, count int, maxAge time.Duration, now time.Time) []string {
+	type snapshot struct {
+		name string
+		t    time.Time
+	}
+
+	snapshots := make([]snapshot, 0, len(names))
+
+	for _, name := range names {
+		if t, ok := SnapshotTime(name); ok {
+			snapshots = append(snapshots, snapshot{name: name, t: t})
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].t.After(snapshots[j].t)
+	})
+
+	var expired []string
+
+	for i, s := range snapshots {
+		if i == 0 {
+			continue
+		}
+
+		if (count > 0 && i >= count) || (maxAge > 0 && now.Sub(s.t) > maxAge) {
+			expired = append(expired, s.name)
+		}
+	}
+
+	return expired
+}
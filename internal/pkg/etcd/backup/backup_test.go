@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup"
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup/crypt"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+
+	_, err := io.ReadFull(rand.Reader, key)
+	require.NoError(t, err)
+
+	return key
+}
+
+func decrypt(encrypted, key []byte) ([]byte, error) {
+	r, err := crypt.NewDecryptingReader(bytes.NewReader(encrypted), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+func TestSnapshotName(t *testing.T) {
+	ts := time.Date(2022, 10, 5, 13, 45, 10, 0, time.UTC)
+
+	for _, encrypted := range []bool{false, true} {
+		name := backup.SnapshotName(ts, encrypted)
+
+		parsed, ok := backup.SnapshotTime(name)
+		require.True(t, ok, name)
+		assert.Equal(t, ts, parsed)
+	}
+
+	assert.Equal(t, "etcd-20221005T134510Z.snapshot", backup.SnapshotName(ts, false))
+	assert.Equal(t, "etcd-20221005T134510Z.snapshot.enc", backup.SnapshotName(ts, true))
+
+	for _, name := range []string{"etcd.snapshot", "etcd-foo.snapshot", "etcd-20221005T134510Z.db", ".etcd-20221005T134510Z.snapshot.tmp"} {
+		_, ok := backup.SnapshotTime(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestLatest(t *testing.T) {
+	assert.True(t, backup.Latest(nil).IsZero())
+
+	assert.Equal(t, time.Date(2022, 10, 5, 13, 45, 10, 0, time.UTC), backup.Latest([]string{
+		"etcd-20221005T124510Z.snapshot",
+		"etcd-20221005T134510Z.snapshot.enc",
+		"etcd-20221004T134510Z.snapshot",
+		"etcd.snapshot",
+	}))
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2022, 10, 5, 12, 0, 0, 0, time.UTC)
+
+	names := make([]string, 0, 5)
+
+	for i := 4; i >= 0; i-- {
+		names = append(names, backup.SnapshotName(now.Add(-time.Duration(i)*time.Hour), false))
+	}
+
+	names = append(names, "unrelated")
+
+	for _, tt := range []struct {
+		name     string
+		count    int
+		maxAge   time.Duration
+		expected []string
+	}{
+		{
+			name:     "no policy",
+			expected: nil,
+		},
+		{
+			name:     "count",
+			count:    2,
+			expected: []string{names[2], names[1], names[0]},
+		},
+		{
+			name:     "max age",
+			maxAge:   90 * time.Minute,
+			expected: []string{names[2], names[1], names[0]},
+		},
+		{
+			name:     "count and max age",
+			count:    4,
+			maxAge:   150 * time.Minute,
+			expected: []string{names[1], names[0]},
+		},
+		{
+			name:     "keep latest",
+			count:    1,
+			maxAge:   time.Second,
+			expected: []string{names[3], names[2], names[1], names[0]},
+		},
+		{
+			name:     "keep latest when everything is too old",
+			maxAge:   time.Second,
+			expected: []string{names[3], names[2], names[1], names[0]},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, backup.Expired(names, tt.count, tt.maxAge, now.Add(time.Minute)))
+		})
+	}
+}
+
+func TestSaveAndPrune(t *testing.T) {
+	dir := t.TempDir()
+	key := newKey(t)
+	now := time.Date(2022, 10, 5, 12, 0, 0, 0, time.UTC)
+
+	data := []byte("snapshot contents")
+
+	for i := 0; i < 3; i++ {
+		ts := now.Add(time.Duration(i) * time.Hour)
+		name := backup.SnapshotName(ts, true)
+
+		size, err := backup.Save(dir, name, bytes.NewReader(data), key)
+		require.NoError(t, err)
+
+		contents, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+
+		assert.EqualValues(t, len(contents), size)
+
+		decrypted, err := decrypt(contents, key)
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted)
+	}
+
+	plainName := backup.SnapshotName(now.Add(3*time.Hour), false)
+
+	_, err := backup.Save(dir, plainName, bytes.NewReader(data), nil)
+	require.NoError(t, err)
+
+	contents, err := os.ReadFile(filepath.Join(dir, plainName))
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+
+	names, err := backup.List(dir)
+	require.NoError(t, err)
+	assert.Len(t, names, 4)
+
+	require.NoError(t, backup.Prune(dir, 2, 0, now.Add(4*time.Hour)))
+
+	names, err = backup.List(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{backup.SnapshotName(now.Add(2*time.Hour), true), plainName}, names)
+
+	names, err = backup.List(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package crypt implements encryption of the etcd snapshots.
+//
+// The package is kept separate from the backup package, so that the clients (talosctl)
+// can decrypt the snapshots without pulling the object storage dependencies.
+package crypt
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Encrypted snapshots are split into chunks, each chunk is sealed with XChaCha20-Poly1305.
+//
+// The nonce of each chunk is the random prefix from the header followed by the chunk counter,
+// the last chunk has the most significant bit of the counter set, so that truncation is detected.
+const (
+	chunkSize = 64 * 1024
+
+	noncePrefixSize = chacha20poly1305.NonceSizeX - 8
+	lastChunkFlag   = 1 << 63
+)
+
+var encryptedMagic = []byte("talos-etcd-bk-v1")
+
+// ErrDecrypt is returned when the encrypted snapshot can't be decrypted.
+var ErrDecrypt = errors.New("error decrypting snapshot: wrong key, truncated or corrupted data")
+
+// IsEncrypted checks whether the data starts with the encrypted snapshot header.
+func IsEncrypted(header []byte) bool {
+	return bytes.HasPrefix(header, encryptedMagic)
+}
+
+type encryptingWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	nonce  []byte
+	buf    []byte
+	out    []byte
+	sealed uint64
+}
+
+// NewEncryptingWriter returns a writer which encrypts the data with the 32-byte key.
+//
+// Close should be called to write the final chunk, it doesn't close the underlying writer.
+func NewEncryptingWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+
+	if _, err = io.ReadFull(rand.Reader, nonce[:noncePrefixSize]); err != nil {
+		return nil, err
+	}
+
+	if _, err = w.Write(encryptedMagic); err != nil {
+		return nil, err
+	}
+
+	if _, err = w.Write(nonce[:noncePrefixSize]); err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		w:     w,
+		aead:  aead,
+		nonce: nonce,
+		buf:   make([]byte, 0, chunkSize),
+		out:   make([]byte, 0, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	n := 0
+
+	for len(p) > 0 {
+		// full chunk is flushed only when more data arrives, as the last chunk should be marked
+		if len(e.buf) == chunkSize {
+			if err := e.seal(false); err != nil {
+				return n, err
+			}
+		}
+
+		l := copy(e.buf[len(e.buf):chunkSize], p)
+		e.buf = e.buf[:len(e.buf)+l]
+		p = p[l:]
+		n += l
+	}
+
+	return n, nil
+}
+
+func (e *encryptingWriter) Close() error {
+	return e.seal(true)
+}
+
+func (e *encryptingWriter) seal(last bool) error {
+	counter := e.sealed
+	if last {
+		counter |= lastChunkFlag
+	}
+
+	binary.BigEndian.PutUint64(e.nonce[noncePrefixSize:], counter)
+
+	e.out = e.aead.Seal(e.out[:0], e.nonce, e.buf, nil)
+	e.buf = e.buf[:0]
+	e.sealed++
+
+	_, err := e.w.Write(e.out)
+
+	return err
+}
+
+type decryptingReader struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	nonce  []byte
+	in     []byte
+	buf    []byte
+	opened uint64
+	done   bool
+}
+
+// NewDecryptingReader returns a reader which decrypts the data encrypted with NewEncryptingWriter.
+func NewDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(encryptedMagic)+noncePrefixSize)
+
+	if _, err = io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("error reading encrypted snapshot header: %w", err)
+	}
+
+	if !IsEncrypted(header) {
+		return nil, fmt.Errorf("snapshot is not encrypted")
+	}
+
+	nonce := make([]byte, chacha20poly1305.NonceSizeX)
+	copy(nonce, header[len(encryptedMagic):])
+
+	return &decryptingReader{
+		r:     bufio.NewReaderSize(r, chunkSize+aead.Overhead()+1),
+		aead:  aead,
+		nonce: nonce,
+		in:    make([]byte, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+
+	return n, nil
+}
+
+func (d *decryptingReader) open() error {
+	n, err := io.ReadFull(d.r, d.in)
+
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		d.done = true
+	case err != nil:
+		return err
+	default:
+		// the full-sized chunk is the last one if nothing follows it
+		if _, err = d.r.Peek(1); errors.Is(err, io.EOF) {
+			d.done = true
+		}
+	}
+
+	counter := d.opened
+	if d.done {
+		counter |= lastChunkFlag
+	}
+
+	binary.BigEndian.PutUint64(d.nonce[noncePrefixSize:], counter)
+
+	d.buf, err = d.aead.Open(d.in[:0], d.nonce, d.in[:n], nil)
+	if err != nil {
+		return ErrDecrypt
+	}
+
+	d.opened++
+
+	return nil
+}
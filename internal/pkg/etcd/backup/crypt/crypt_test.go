@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package crypt_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd/backup/crypt"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+
+	_, err := io.ReadFull(rand.Reader, key)
+	require.NoError(t, err)
+
+	return key
+}
+
+func encrypt(t *testing.T, data, key []byte) []byte {
+	var buf bytes.Buffer
+
+	w, err := crypt.NewEncryptingWriter(&buf, key)
+	require.NoError(t, err)
+
+	_, err = w.Write(data)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func decrypt(encrypted, key []byte) ([]byte, error) {
+	r, err := crypt.NewDecryptingReader(bytes.NewReader(encrypted), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	key := newKey(t)
+
+	for _, size := range []int{0, 1, 64*1024 - 1, 64 * 1024, 64*1024 + 1, 3*64*1024 + 17} {
+		data := make([]byte, size)
+
+		_, err := io.ReadFull(rand.Reader, data)
+		require.NoError(t, err)
+
+		encrypted := encrypt(t, data, key)
+		assert.True(t, crypt.IsEncrypted(encrypted))
+		assert.False(t, crypt.IsEncrypted(data))
+
+		decrypted, err := decrypt(encrypted, key)
+		require.NoError(t, err, "size %d", size)
+
+		assert.Equal(t, data, decrypted, "size %d", size)
+	}
+}
+
+func TestEncryptionTampered(t *testing.T) {
+	key := newKey(t)
+
+	data := make([]byte, 2*64*1024)
+
+	_, err := io.ReadFull(rand.Reader, data)
+	require.NoError(t, err)
+
+	encrypted := encrypt(t, data, key)
+
+	_, err = decrypt(encrypted, newKey(t))
+	assert.ErrorIs(t, err, crypt.ErrDecrypt)
+
+	// truncating the stream at the chunk boundary drops the last chunk
+	_, err = decrypt(encrypted[:len(encrypted)-100], key)
+	assert.ErrorIs(t, err, crypt.ErrDecrypt)
+
+	corrupted := append([]byte(nil), encrypted...)
+	corrupted[len(corrupted)/2] ^= 0xff
+
+	_, err = decrypt(corrupted, key)
+	assert.ErrorIs(t, err, crypt.ErrDecrypt)
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package backup
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// S3Store stores the snapshots in the S3-compatible storage.
+type S3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+// NewS3Store initializes the S3Store from the config.
+func NewS3Store(cfg config.EtcdBackupS3) (*S3Store, error) {
+	awsConfig := aws.NewConfig().
+		WithRegion(cfg.Region()).
+		WithCredentials(credentials.NewStaticCredentials(cfg.AccessKeyID(), cfg.SecretAccessKey(), "")).
+		WithS3ForcePathStyle(cfg.ForcePathStyle())
+
+	if cfg.Endpoint() != "" {
+		awsConfig = awsConfig.WithEndpoint(cfg.Endpoint())
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Store{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   cfg.Bucket(),
+		prefix:   cfg.Prefix(),
+	}, nil
+}
+
+// Upload uploads the snapshot.
+func (s *S3Store) Upload(ctx context.Context, name string, snapshot io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+		Body:   snapshot,
+	})
+
+	return err
+}
+
+// List returns the names of the uploaded snapshots.
+func (s *S3Store) List(ctx context.Context) ([]string, error) {
+	var names []string
+
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			name := strings.TrimPrefix(aws.StringValue(obj.Key), s.prefix)
+
+			if _, ok := SnapshotTime(name); ok {
+				names = append(names, name)
+			}
+		}
+
+		return true
+	})
+
+	return names, err
+}
+
+// Prune removes the uploaded snapshots according to the retention policy.
+func (s *S3Store) Prune(ctx context.Context, count int, maxAge time.Duration, now time.Time) error {
+	names, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range Expired(names, count, maxAge, now) {
+		if _, err = s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(s.prefix + name),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
@@ -69,6 +70,18 @@ func NewLocalClient() (client *Client, err error) {
 	return NewClient([]string{nethelpers.JoinHostPort("localhost", constants.EtcdClientPort)})
 }
 
+// NewVoterClient initializes and returns etcd client configured to talk to the voting members.
+//
+// Voting nodes talk to the local member, while learner and etcd-less nodes use the external endpoints,
+// as learners serve only Status and serializable Range requests.
+func NewVoterClient(etcdConfig config.Etcd) (client *Client, err error) {
+	if etcdConfig.Role() == constants.EtcdRoleVoter {
+		return NewLocalClient()
+	}
+
+	return NewClient(etcdConfig.ExternalEndpoints())
+}
+
 // NewClientFromControlPlaneIPs initializes and returns an etcd client
 // configured to talk to all members.
 func NewClientFromControlPlaneIPs(ctx context.Context, resources state.State) (client *Client, err error) {
@@ -138,6 +151,22 @@ func (c *Client) ValidateQuorum(ctx context.Context) (err error) {
 	return nil
 }
 
+// ValidateLearner validates that the member is up and serves requests.
+//
+// Learner members don't serve linearizable reads, so the check uses a serializable read
+// which is served by the member itself without going through the consensus.
+func (c *Client) ValidateLearner(ctx context.Context) (err error) {
+	checkCtx, cancel := context.WithTimeout(ctx, QuorumCheckTimeout)
+	defer cancel()
+
+	_, err = c.Get(checkCtx, "health", clientv3.WithSerializable())
+	if err == rpctypes.ErrPermissionDenied {
+		err = nil
+	}
+
+	return err
+}
+
 func validateMemberHealth(ctx context.Context, memberURIs []string) (err error) {
 	c, err := NewClient(memberURIs)
 	if err != nil {
@@ -200,6 +229,34 @@ func (c *Client) RemoveMember(ctx context.Context, hostname string) error {
 	return nil
 }
 
+// QuotaBackendBytes returns the backend quota of the etcd member configured via extra args.
+func QuotaBackendBytes(extraArgs map[string]string) int64 {
+	if v, ok := extraArgs["quota-backend-bytes"]; ok {
+		if quota, err := strconv.ParseInt(v, 10, 64); err == nil && quota > 0 {
+			return quota
+		}
+	}
+
+	return constants.EtcdDefaultQuotaBackendBytes
+}
+
+// IsLeader checks whether the member the client is connected to is the etcd cluster leader.
+//
+// For the local client, this checks whether the local member is the leader.
+func (c *Client) IsLeader(ctx context.Context) (bool, error) {
+	endpoints := c.Endpoints()
+	if len(endpoints) == 0 {
+		return false, fmt.Errorf("no etcd endpoints configured")
+	}
+
+	status, err := c.Status(ctx, endpoints[0])
+	if err != nil {
+		return false, fmt.Errorf("failed to get etcd member status: %w", err)
+	}
+
+	return status.Header.GetMemberId() == status.Leader, nil
+}
+
 // ForfeitLeadership transfers leadership from the current member to another
 // member.
 //
@@ -10,11 +10,15 @@ import (
 
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
 )
 
 // WithLock executes the given function exclusively by acquiring an Etcd lock with the given key.
-func WithLock(ctx context.Context, key string, logger *zap.Logger, f func() error) error {
-	etcdClient, err := NewLocalClient()
+//
+// The lock is acquired via the voting members, see NewVoterClient.
+func WithLock(ctx context.Context, etcdConfig config.Etcd, key string, logger *zap.Logger, f func() error) error {
+	etcdClient, err := NewVoterClient(etcdConfig)
 	if err != nil {
 		return fmt.Errorf("error creating etcd client: %w", err)
 	}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ethtool provides ethtool ioctl calls which are not covered by the ethtool libraries.
+package ethtool
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Rings describes the ring buffer sizes of the link (struct ethtool_ringparam).
+type Rings struct {
+	RXMax      uint32
+	RXMiniMax  uint32
+	RXJumboMax uint32
+	TXMax      uint32
+	RX         uint32
+	RXMini     uint32
+	RXJumbo    uint32
+	TX         uint32
+}
+
+type ringParam struct {
+	cmd uint32
+	Rings
+}
+
+type ifreq struct {
+	name [unix.IFNAMSIZ]byte
+	data uintptr
+}
+
+// Handle is an ethtool ioctl socket.
+type Handle struct {
+	fd int
+}
+
+// New opens an ethtool ioctl socket.
+func New() (*Handle, error) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening socket: %w", err)
+	}
+
+	return &Handle{fd: fd}, nil
+}
+
+// Close the socket.
+func (h *Handle) Close() error {
+	return unix.Close(h.fd)
+}
+
+// Rings returns the ring buffer sizes of the link.
+func (h *Handle) Rings(linkName string) (Rings, error) {
+	param := ringParam{
+		cmd: unix.ETHTOOL_GRINGPARAM,
+	}
+
+	if err := h.ioctl(linkName, unsafe.Pointer(&param)); err != nil {
+		return Rings{}, err
+	}
+
+	return param.Rings, nil
+}
+
+// SetRings sets the ring buffer sizes of the link.
+//
+// Rings should be fetched first with Rings, as the kernel updates all values at once.
+func (h *Handle) SetRings(linkName string, rings Rings) error {
+	param := ringParam{
+		cmd:   unix.ETHTOOL_SRINGPARAM,
+		Rings: rings,
+	}
+
+	return h.ioctl(linkName, unsafe.Pointer(&param))
+}
+
+func (h *Handle) ioctl(linkName string, data unsafe.Pointer) error {
+	if len(linkName) >= unix.IFNAMSIZ {
+		return fmt.Errorf("link name %q is too long", linkName)
+	}
+
+	var req ifreq
+
+	copy(req.name[:], linkName)
+	req.data = uintptr(data)
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(h.fd), unix.SIOCETHTOOL, uintptr(unsafe.Pointer(&req))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
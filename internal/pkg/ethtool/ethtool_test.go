@@ -0,0 +1,34 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ethtool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/pkg/ethtool"
+)
+
+func TestRingsErrors(t *testing.T) {
+	h, err := ethtool.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, h.Close())
+	})
+
+	_, err = h.Rings("nosuchlink0")
+	assert.ErrorIs(t, err, unix.ENODEV)
+
+	_, err = h.Rings("averyveryverylonglinkname")
+	assert.EqualError(t, err, `link name "averyveryverylonglinkname" is too long`)
+
+	// loopback doesn't support ring parameters
+	_, err = h.Rings("lo")
+	assert.ErrorIs(t, err, unix.EOPNOTSUPP)
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kms provides the reference implementation of the key management server.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+// MasterKeySize is the size of the master key (AES-256).
+const MasterKeySize = 32
+
+// Server implements the KMS service.
+//
+// Keys are sealed with AES-256-GCM using the master key, node UUID is used as the additional data,
+// so that the sealed key can only be unsealed for the node it was sealed for.
+//
+// Node UUID in the request should match the identity of the client certificate (common name or DNS SAN),
+// so that the node can't unseal the keys of other nodes.
+type Server struct {
+	kmsapi.UnimplementedKMSServiceServer
+
+	aead cipher.AEAD
+}
+
+// NewServer creates new Server with the master key.
+func NewServer(masterKey []byte) (*Server, error) {
+	if len(masterKey) != MasterKeySize {
+		return nil, fmt.Errorf("master key should be %d bytes long, got %d", MasterKeySize, len(masterKey))
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		aead: aead,
+	}, nil
+}
+
+// Seal implements kmsapi.KMSServiceServer interface.
+func (srv *Server) Seal(ctx context.Context, req *kmsapi.Request) (*kmsapi.Response, error) {
+	if req.NodeUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "node UUID is required")
+	}
+
+	if err := authorizeNode(ctx, req.NodeUuid); err != nil {
+		return nil, err
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "data is required")
+	}
+
+	nonce := make([]byte, srv.aead.NonceSize(), srv.aead.NonceSize()+len(req.Data)+srv.aead.Overhead())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, status.Errorf(codes.Internal, "error generating nonce: %s", err)
+	}
+
+	return &kmsapi.Response{
+		Data: srv.aead.Seal(nonce, nonce, req.Data, []byte(req.NodeUuid)),
+	}, nil
+}
+
+// Unseal implements kmsapi.KMSServiceServer interface.
+func (srv *Server) Unseal(ctx context.Context, req *kmsapi.Request) (*kmsapi.Response, error) {
+	if req.NodeUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "node UUID is required")
+	}
+
+	if err := authorizeNode(ctx, req.NodeUuid); err != nil {
+		return nil, err
+	}
+
+	if len(req.Data) < srv.aead.NonceSize()+srv.aead.Overhead() {
+		return nil, status.Error(codes.InvalidArgument, "sealed data is too short")
+	}
+
+	nonce, sealed := req.Data[:srv.aead.NonceSize()], req.Data[srv.aead.NonceSize():]
+
+	data, err := srv.aead.Open(nil, nonce, sealed, []byte(req.NodeUuid))
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "failed to unseal the key")
+	}
+
+	return &kmsapi.Response{
+		Data: data,
+	}, nil
+}
+
+// authorizeNode verifies that the client certificate was issued for the node UUID.
+func authorizeNode(ctx context.Context, nodeUUID string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "peer information is not available")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "verified client certificate is required")
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+
+	for _, identity := range append([]string{cert.Subject.CommonName}, cert.DNSNames...) {
+		if strings.EqualFold(identity, nodeUUID) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "client certificate is not issued for the node %q", nodeUUID)
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kms_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/kms"
+	kmsapi "github.com/talos-systems/talos/pkg/machinery/api/kms"
+)
+
+func TestServer(t *testing.T) {
+	_, err := kms.NewServer([]byte("short"))
+	require.Error(t, err)
+
+	masterKey := make([]byte, kms.MasterKeySize)
+
+	_, err = rand.Read(masterKey)
+	require.NoError(t, err)
+
+	srv, err := kms.NewServer(masterKey)
+	require.NoError(t, err)
+
+	ctx := nodeContext("node-1")
+
+	sealed, err := srv.Seal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: []byte("secret")})
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed.Data), "secret")
+
+	unsealed, err := srv.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: sealed.Data})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unsealed.Data)
+
+	// the key is bound to the node UUID
+	_, err = srv.Unseal(nodeContext("node-2"), &kmsapi.Request{NodeUuid: "node-2", Data: sealed.Data})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the node UUID should match the client certificate
+	_, err = srv.Unseal(nodeContext("node-2"), &kmsapi.Request{NodeUuid: "node-1", Data: sealed.Data})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.Seal(nodeContext("node-2"), &kmsapi.Request{NodeUuid: "node-1", Data: []byte("secret")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.Unseal(context.Background(), &kmsapi.Request{NodeUuid: "node-1", Data: sealed.Data})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the key is bound to the master key
+	_, err = rand.Read(masterKey)
+	require.NoError(t, err)
+
+	otherSrv, err := kms.NewServer(masterKey)
+	require.NoError(t, err)
+
+	_, err = otherSrv.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: sealed.Data})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	for _, req := range []*kmsapi.Request{
+		{Data: []byte("secret")},
+		{NodeUuid: "node-1"},
+	} {
+		_, err = srv.Seal(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err = srv.Unseal(ctx, &kmsapi.Request{NodeUuid: "node-1", Data: []byte("short")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// nodeContext returns the context of the gRPC call with the verified client certificate issued for the node.
+func nodeContext(nodeUUID string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{
+					{
+						{Subject: pkix.Name{CommonName: nodeUUID}},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,455 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package miniprocfs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// Socket table names under /proc/<pid>/net.
+const (
+	ProtocolTCP      = "tcp"
+	ProtocolTCP6     = "tcp6"
+	ProtocolUDP      = "udp"
+	ProtocolUDP6     = "udp6"
+	ProtocolUDPLite  = "udplite"
+	ProtocolUDPLite6 = "udplite6"
+	ProtocolRaw      = "raw"
+	ProtocolRaw6     = "raw6"
+	ProtocolUnix     = "unix"
+)
+
+// unix socket flag which is set on listening sockets (__SO_ACCEPTCON).
+const unixFlagAcceptCon = 1 << 16
+
+// unix socket state of connected sockets (SS_CONNECTED).
+const unixStateConnected = 3
+
+// NetNamespace describes a network namespace and a process running in it.
+type NetNamespace struct {
+	// Inode of the network namespace.
+	Inode uint64
+	// PID of (any) process running in the network namespace.
+	PID int
+}
+
+// Netstat reads socket tables and socket ownership information under /proc.
+type Netstat struct {
+	RootPath string
+}
+
+// NewNetstat initializes socket table reader with path /proc.
+func NewNetstat() *Netstat {
+	return NewNetstatWithPath("/proc")
+}
+
+// NewNetstatWithPath initializes socket table reader with non-default path.
+func NewNetstatWithPath(rootPath string) *Netstat {
+	return &Netstat{
+		RootPath: rootPath,
+	}
+}
+
+// NamespaceInode returns the inode of the network namespace of the process.
+func (n *Netstat) NamespaceInode(pid int) (uint64, error) {
+	return fileInode(fmt.Sprintf("%s/%d/ns/net", n.RootPath, pid))
+}
+
+// NetNamespaces lists network namespaces of all running processes.
+//
+// Each namespace is returned once, with the PID of the first process found in it.
+func (n *Netstat) NetNamespaces() ([]NetNamespace, error) {
+	pids, err := n.pids()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[uint64]struct{}{}
+
+	var namespaces []NetNamespace
+
+	for _, pid := range pids {
+		inode, err := n.NamespaceInode(pid)
+		if err != nil {
+			// process might have exited, or it's a kernel thread
+			continue
+		}
+
+		if _, ok := seen[inode]; ok {
+			continue
+		}
+
+		seen[inode] = struct{}{}
+
+		namespaces = append(namespaces, NetNamespace{
+			Inode: inode,
+			PID:   pid,
+		})
+	}
+
+	return namespaces, nil
+}
+
+// NamedNetNamespaces maps inodes of the named network namespaces (e.g. under /var/run/netns) to their names.
+func NamedNetNamespaces(dir string) (map[uint64]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	namespaces := make(map[uint64]string, len(entries))
+
+	for _, entry := range entries {
+		inode, err := fileInode(dir + "/" + entry.Name())
+		if err != nil {
+			continue
+		}
+
+		namespaces[inode] = entry.Name()
+	}
+
+	return namespaces, nil
+}
+
+// SocketOwners builds a map of socket inodes to the processes which hold them open.
+func (n *Netstat) SocketOwners() (map[uint64]*machine.ConnectRecord_Process, error) {
+	pids, err := n.pids()
+	if err != nil {
+		return nil, err
+	}
+
+	owners := map[uint64]*machine.ConnectRecord_Process{}
+
+	for _, pid := range pids {
+		path := fmt.Sprintf("%s/%d/", n.RootPath, pid)
+
+		fds, err := os.ReadDir(path + "fd")
+		if err != nil {
+			// process might have exited
+			continue
+		}
+
+		var process *machine.ConnectRecord_Process
+
+		for _, fd := range fds {
+			target, err := os.Readlink(path + "fd/" + fd.Name())
+			if err != nil {
+				continue
+			}
+
+			if !strings.HasPrefix(target, "socket:[") || !strings.HasSuffix(target, "]") {
+				continue
+			}
+
+			inode, err := strconv.ParseUint(target[len("socket:["):len(target)-1], 10, 64)
+			if err != nil {
+				continue
+			}
+
+			if process == nil {
+				comm, err := os.ReadFile(path + "comm")
+				if err != nil {
+					break
+				}
+
+				process = &machine.ConnectRecord_Process{
+					Pid:  uint32(pid),
+					Name: strings.TrimSpace(string(comm)),
+				}
+			}
+
+			owners[inode] = process
+		}
+	}
+
+	return owners, nil
+}
+
+// Sockets parses the socket table of the specified protocol as seen by the process.
+//
+// Socket tables are per network namespace, so the result contains all sockets in the
+// network namespace of the process.
+func (n *Netstat) Sockets(pid int, protocol string) ([]*machine.ConnectRecord, error) {
+	f, err := os.Open(fmt.Sprintf("%s/%d/net/%s", n.RootPath, pid, protocol))
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	scanner := bufio.NewScanner(f)
+
+	// skip the header
+	scanner.Scan()
+
+	var records []*machine.ConnectRecord
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		var record *machine.ConnectRecord
+
+		if protocol == ProtocolUnix {
+			record, err = parseUnixSocket(fields)
+		} else {
+			record, err = parseInetSocket(fields)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s socket table: %w", protocol, err)
+		}
+
+		record.L4Proto = protocol
+
+		records = append(records, record)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, f.Close()
+}
+
+func (n *Netstat) pids() ([]int, error) {
+	entries, err := os.ReadDir(n.RootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make([]int, 0, len(entries))
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		pids = append(pids, pid)
+	}
+
+	return pids, nil
+}
+
+// parseInetSocket parses a line of /proc/net/{tcp,udp,raw}[6]:
+//
+//	sl local_address rem_address st tx_queue:rx_queue tr:tm->when retrnsmt uid timeout inode ref pointer ...
+//
+//nolint:gocyclo
+func parseInetSocket(fields []string) (*machine.ConnectRecord, error) {
+	if len(fields) < 12 {
+		return nil, fmt.Errorf("unexpected number of fields: %d", len(fields))
+	}
+
+	localIP, localPort, err := parseHexAddress(fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	remoteIP, remotePort, err := parseHexAddress(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := strconv.ParseUint(fields[3], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	txQueue, rxQueue, err := parseHexPair(fields[4])
+	if err != nil {
+		return nil, err
+	}
+
+	timerActive, timerWhen, err := parseHexPair(fields[5])
+	if err != nil {
+		return nil, err
+	}
+
+	retransmits, err := strconv.ParseUint(fields[6], 16, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := strconv.ParseUint(fields[7], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := strconv.ParseUint(fields[8], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	inode, err := strconv.ParseUint(fields[9], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := strconv.ParseUint(fields[10], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	pointer, err := strconv.ParseUint(fields[11], 16, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.ConnectRecord{
+		Localip:    localIP.String(),
+		Localport:  uint32(localPort),
+		Remoteip:   remoteIP.String(),
+		Remoteport: uint32(remotePort),
+		State:      machine.ConnectRecord_State(state),
+		Txqueue:    txQueue,
+		Rxqueue:    rxQueue,
+		Tr:         machine.ConnectRecord_TimerActive(timerActive),
+		Timerwhen:  timerWhen,
+		Retrnsmt:   retransmits,
+		Uid:        uint32(uid),
+		Timeout:    timeout,
+		Inode:      inode,
+		Ref:        ref,
+		Pointer:    pointer,
+	}, nil
+}
+
+// parseUnixSocket parses a line of /proc/net/unix:
+//
+//	Num RefCount Protocol Flags Type St Inode [Path]
+func parseUnixSocket(fields []string) (*machine.ConnectRecord, error) {
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("unexpected number of fields: %d", len(fields))
+	}
+
+	pointer, err := strconv.ParseUint(strings.TrimSuffix(fields[0], ":"), 16, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := strconv.ParseUint(fields[1], 16, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := strconv.ParseUint(fields[3], 16, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := strconv.ParseUint(fields[5], 16, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	inode, err := strconv.ParseUint(fields[6], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	state := machine.ConnectRecord_CLOSE
+
+	switch {
+	case flags&unixFlagAcceptCon != 0:
+		state = machine.ConnectRecord_LISTEN
+	case st == unixStateConnected:
+		state = machine.ConnectRecord_ESTABLISHED
+	}
+
+	var path string
+
+	if len(fields) > 7 {
+		path = strings.Join(fields[7:], " ")
+	}
+
+	return &machine.ConnectRecord{
+		State:   state,
+		Inode:   inode,
+		Ref:     ref,
+		Pointer: pointer,
+		Path:    path,
+	}, nil
+}
+
+// parseHexAddress parses address in the format of the socket tables, e.g. 0100007F:0050.
+//
+// IP address is printed as a sequence of 32-bit words in host byte order.
+func parseHexAddress(s string) (netip.Addr, uint16, error) {
+	ipHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return netip.Addr{}, 0, fmt.Errorf("invalid address %q", s)
+	}
+
+	ip, err := hex.DecodeString(ipHex)
+	if err != nil {
+		return netip.Addr{}, 0, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+
+	if len(ip) != 4 && len(ip) != 16 {
+		return netip.Addr{}, 0, fmt.Errorf("invalid address %q", s)
+	}
+
+	for i := 0; i < len(ip); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.LittleEndian.Uint32(ip[i:]))
+	}
+
+	addr, _ := netip.AddrFromSlice(ip)
+
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return netip.Addr{}, 0, fmt.Errorf("invalid port %q: %w", s, err)
+	}
+
+	return addr, uint16(port), nil
+}
+
+func parseHexPair(s string) (uint64, uint64, error) {
+	first, second, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	a, err := strconv.ParseUint(first, 16, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	b, err := strconv.ParseUint(second, 16, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return a, b, nil
+}
+
+func fileInode(path string) (uint64, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+
+	sys, ok := st.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, fmt.Errorf("unsupported stat for %q", path)
+	}
+
+	return sys.Ino, nil
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package miniprocfs_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/miniprocfs"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestNetstatLive(t *testing.T) {
+	netstat := miniprocfs.NewNetstat()
+
+	namespaces, err := netstat.NetNamespaces()
+	require.NoError(t, err)
+
+	if len(namespaces) == 0 {
+		t.Skip("no access to network namespaces")
+	}
+
+	_, err = netstat.Sockets(os.Getpid(), miniprocfs.ProtocolTCP)
+	require.NoError(t, err)
+
+	_, err = netstat.SocketOwners()
+	require.NoError(t, err)
+}
+
+func TestNetstatMock(t *testing.T) {
+	netstat := miniprocfs.NewNetstatWithPath("testdata")
+
+	tcp, err := netstat.Sockets(1920080, miniprocfs.ProtocolTCP)
+	require.NoError(t, err)
+	require.Len(t, tcp, 2)
+
+	assert.Equal(t, "tcp", tcp[0].L4Proto)
+	assert.Equal(t, "0.0.0.0", tcp[0].Localip)
+	assert.EqualValues(t, 50051, tcp[0].Localport)
+	assert.Equal(t, machine.ConnectRecord_LISTEN, tcp[0].State)
+	assert.EqualValues(t, 31650, tcp[0].Inode)
+
+	assert.Equal(t, "127.0.0.1", tcp[1].Localip)
+	assert.EqualValues(t, 80, tcp[1].Localport)
+	assert.Equal(t, "127.0.0.2", tcp[1].Remoteip)
+	assert.EqualValues(t, 54321, tcp[1].Remoteport)
+	assert.Equal(t, machine.ConnectRecord_ESTABLISHED, tcp[1].State)
+	assert.EqualValues(t, 0x10, tcp[1].Txqueue)
+	assert.EqualValues(t, 0x20, tcp[1].Rxqueue)
+	assert.Equal(t, machine.ConnectRecord_KEEPALIVE, tcp[1].Tr)
+	assert.EqualValues(t, 0x49a, tcp[1].Timerwhen)
+	assert.EqualValues(t, 1000, tcp[1].Uid)
+	assert.EqualValues(t, 2, tcp[1].Ref)
+	assert.Equal(t, uint64(0xffff8e2b5c2a0000), tcp[1].Pointer)
+
+	tcp6, err := netstat.Sockets(1920080, miniprocfs.ProtocolTCP6)
+	require.NoError(t, err)
+	require.Len(t, tcp6, 2)
+
+	assert.Equal(t, "::", tcp6[0].Localip)
+	assert.Equal(t, "2001:db8::1", tcp6[1].Localip)
+	assert.EqualValues(t, 80, tcp6[1].Localport)
+	assert.Equal(t, "::1", tcp6[1].Remoteip)
+
+	udp, err := netstat.Sockets(1920080, miniprocfs.ProtocolUDP)
+	require.NoError(t, err)
+	require.Len(t, udp, 1)
+
+	assert.Equal(t, "127.0.0.53", udp[0].Localip)
+	assert.EqualValues(t, 53, udp[0].Localport)
+	assert.Equal(t, machine.ConnectRecord_CLOSE, udp[0].State)
+
+	unix, err := netstat.Sockets(1920080, miniprocfs.ProtocolUnix)
+	require.NoError(t, err)
+	require.Len(t, unix, 2)
+
+	assert.Equal(t, "unix", unix[0].L4Proto)
+	assert.Equal(t, machine.ConnectRecord_LISTEN, unix[0].State)
+	assert.Equal(t, "/system/run/machined/machine.sock", unix[0].Path)
+	assert.EqualValues(t, 31655, unix[0].Inode)
+	assert.Equal(t, machine.ConnectRecord_ESTABLISHED, unix[1].State)
+	assert.Empty(t, unix[1].Path)
+
+	owners, err := netstat.SocketOwners()
+	require.NoError(t, err)
+
+	require.Contains(t, owners, uint64(31650))
+	assert.EqualValues(t, 1920080, owners[31650].Pid)
+	assert.Equal(t, "fish", owners[31650].Name)
+	assert.Contains(t, owners, uint64(31655))
+	assert.NotContains(t, owners, uint64(31651))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 	"golang.org/x/sys/unix"
 
+	"github.com/talos-systems/talos/internal/pkg/encryption"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/makefs"
 )
@@ -176,6 +177,8 @@ type Point struct {
 	flags  uintptr
 	data   string
 	*Options
+
+	encryptionHandler *encryption.Handler
 }
 
 // PointMap represents a unique set of mount points.
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// ProcSwaps is the path to the list of active swap areas.
+const ProcSwaps = "/proc/swaps"
+
+// Swap flags (see linux/swap.h).
+const (
+	swapFlagPrefer   = 0x8000
+	swapFlagPrioMask = 0x7fff
+)
+
+// SwapArea describes an active swap area as reported by /proc/swaps.
+type SwapArea struct {
+	Filename  string
+	Type      string
+	SizeBytes uint64
+	UsedBytes uint64
+	Priority  int
+}
+
+// Swapon enables swapping on the device.
+//
+// Negative priority means the kernel default priority.
+func Swapon(device string, priority int) error {
+	path, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	var flags uintptr
+
+	if priority >= 0 {
+		flags = swapFlagPrefer | uintptr(priority&swapFlagPrioMask)
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPON, uintptr(unsafe.Pointer(path)), flags, 0); errno != 0 {
+		return fmt.Errorf("error enabling swap on %q: %w", device, errno)
+	}
+
+	return nil
+}
+
+// Swapoff disables swapping on the device.
+func Swapoff(device string) error {
+	path, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPOFF, uintptr(unsafe.Pointer(path)), 0, 0); errno != 0 {
+		return fmt.Errorf("error disabling swap on %q: %w", device, errno)
+	}
+
+	return nil
+}
+
+// ReadSwaps returns the list of active swap areas.
+func ReadSwaps() ([]SwapArea, error) {
+	f, err := os.Open(ProcSwaps)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return ParseSwaps(f)
+}
+
+// ParseSwaps parses the list of active swap areas in /proc/swaps format.
+func ParseSwaps(r io.Reader) ([]SwapArea, error) {
+	var areas []SwapArea
+
+	scanner := bufio.NewScanner(r)
+
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		if lineNo == 0 {
+			// skip the header
+			continue
+		}
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected swaps line %q", scanner.Text())
+		}
+
+		size, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap size: %w", err)
+		}
+
+		used, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap usage: %w", err)
+		}
+
+		priority, err := strconv.Atoi(fields[4])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap priority: %w", err)
+		}
+
+		areas = append(areas, SwapArea{
+			// spaces in the filename are escaped as \040
+			Filename:  strings.ReplaceAll(fields[0], `\040`, " "),
+			Type:      fields[1],
+			SizeBytes: size * 1024,
+			UsedBytes: used * 1024,
+			Priority:  priority,
+		})
+	}
+
+	return areas, scanner.Err()
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/mount"
+)
+
+func TestParseSwaps(t *testing.T) {
+	areas, err := mount.ParseSwaps(strings.NewReader(`Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	1048572		2048		100
+/var/swap\040file                       file		524284		0		-2
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []mount.SwapArea{
+		{
+			Filename:  "/dev/zram0",
+			Type:      "partition",
+			SizeBytes: 1048572 * 1024,
+			UsedBytes: 2048 * 1024,
+			Priority:  100,
+		},
+		{
+			Filename:  "/var/swap file",
+			Type:      "file",
+			SizeBytes: 524284 * 1024,
+			Priority:  -2,
+		},
+	}, areas)
+
+	areas, err = mount.ParseSwaps(strings.NewReader("Filename\tType\tSize\tUsed\tPriority\n"))
+	require.NoError(t, err)
+	assert.Empty(t, areas)
+
+	_, err = mount.ParseSwaps(strings.NewReader("Filename\tType\tSize\tUsed\tPriority\n/dev/zram0 partition\n"))
+	require.Error(t, err)
+}
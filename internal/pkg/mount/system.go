@@ -106,8 +106,10 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 
 	preMountHooks := []Hook{}
 
+	var encryptionHandler *encryption.Handler
+
 	if o.Encryption != nil {
-		encryptionHandler, err := encryption.NewHandler(
+		encryptionHandler, err = encryption.NewHandler(
 			device,
 			part,
 			o.Encryption,
@@ -176,6 +178,7 @@ func SystemMountPointForLabel(device *blockdevice.BlockDevice, label string, opt
 	opts = append(opts, WithPreMountHooks(preMountHooks...))
 
 	mountpoint = NewMountPoint(partPath, target, fsType, unix.MS_NOATIME, "", opts...)
+	mountpoint.encryptionHandler = encryptionHandler
 
 	return mountpoint, nil
 }
@@ -243,6 +246,23 @@ func SystemPartitionMount(r runtime.Runtime, logger *log.Logger, label string, o
 	return nil
 }
 
+// SystemPartitionSyncEncryptionKeys syncs the encryption keys of the mounted system partition with the encryption config.
+func SystemPartitionSyncEncryptionKeys(label string, encryptionConfig config.Encryption) (*encryption.SyncResult, error) {
+	mountpointsMutex.RLock()
+	mountpoint, ok := mountpoints[label]
+	mountpointsMutex.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("system partition %q is not mounted", label)
+	}
+
+	if mountpoint.encryptionHandler == nil {
+		return nil, fmt.Errorf("system partition %q is not encrypted", label)
+	}
+
+	return mountpoint.encryptionHandler.SyncKeys(encryptionConfig)
+}
+
 // SystemPartitionUnmount unmounts a system partition by the label.
 func SystemPartitionUnmount(r runtime.Runtime, logger *log.Logger, label string) (err error) {
 	mountpointsMutex.RLock()
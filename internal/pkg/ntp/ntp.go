@@ -22,6 +22,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/talos-systems/talos/internal/pkg/ntp/nts"
 	"github.com/talos-systems/talos/internal/pkg/timex"
 )
 
@@ -33,11 +34,15 @@ type Syncer struct {
 	timeServers    []string
 	lastSyncServer string
 
+	syncServer        string
+	syncAuthenticated bool
+
 	timeSyncNotified bool
 	timeSynced       chan struct{}
 
-	restartSyncCh chan struct{}
-	epochChangeCh chan struct{}
+	restartSyncCh  chan struct{}
+	epochChangeCh  chan struct{}
+	statusChangeCh chan struct{}
 
 	firstSync bool
 
@@ -51,6 +56,7 @@ type Syncer struct {
 	// these functions are overridden in tests for mocking support
 	CurrentTime CurrentTimeFunc
 	NTPQuery    QueryFunc
+	NTSQuery    QueryFunc
 	AdjustTime  AdjustTimeFunc
 }
 
@@ -71,6 +77,8 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 		restartSyncCh: make(chan struct{}, 1),
 		epochChangeCh: make(chan struct{}, 1),
 
+		statusChangeCh: make(chan struct{}, 1),
+
 		firstSync: true,
 
 		samples: make([]sample, sampleCount),
@@ -81,6 +89,7 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 
 		CurrentTime: time.Now,
 		NTPQuery:    ntp.Query,
+		NTSQuery:    nts.NewClient().Query,
 		AdjustTime:  timex.Adjtimex,
 	}
 
@@ -97,6 +106,36 @@ func (syncer *Syncer) EpochChange() <-chan struct{} {
 	return syncer.epochChangeCh
 }
 
+// StatusChange returns a channel which receives a value each time sync server changes.
+func (syncer *Syncer) StatusChange() <-chan struct{} {
+	return syncer.statusChangeCh
+}
+
+// SyncServer returns the server used for the last successful sync, and whether the time was authenticated (NTS).
+func (syncer *Syncer) SyncServer() (server string, authenticated bool) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	return syncer.syncServer, syncer.syncAuthenticated
+}
+
+func (syncer *Syncer) setSyncServer(server string) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	if syncer.syncServer == server {
+		return
+	}
+
+	syncer.syncServer = server
+	syncer.syncAuthenticated = nts.IsNTSServer(server)
+
+	select {
+	case syncer.statusChangeCh <- struct{}{}:
+	default:
+	}
+}
+
 func (syncer *Syncer) getTimeServers() []string {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
@@ -272,6 +311,8 @@ func (syncer *Syncer) Run(ctx context.Context) {
 			err = syncer.adjustTime(resp.ClockOffset, resp.Leap, lastSyncServer, pollInterval)
 
 			if err == nil {
+				syncer.setSyncServer(lastSyncServer)
+
 				if !syncer.timeSyncNotified {
 					// successful first time sync, notify about it
 					close(syncer.timeSynced)
@@ -350,6 +391,13 @@ func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
 	var serverList []string
 
 	for _, server := range syncer.getTimeServers() {
+		if nts.IsNTSServer(server) {
+			// NTS-KE server provides the NTP server address, so it is resolved on key exchange
+			serverList = append(serverList, server)
+
+			continue
+		}
+
 		ips, err := net.LookupIP(server)
 		if err != nil {
 			syncer.logger.Warn(fmt.Sprintf("failed looking up %q, ignored", server), zap.Error(err))
@@ -370,7 +418,13 @@ func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
 }
 
 func (syncer *Syncer) queryServer(server string) (*ntp.Response, error) {
-	resp, err := syncer.NTPQuery(server)
+	query := syncer.NTPQuery
+
+	if nts.IsNTSServer(server) {
+		query = syncer.NTSQuery
+	}
+
+	resp, err := query(server)
 	if err != nil {
 		return nil, err
 	}
@@ -476,3 +476,56 @@ func (suite *NTPSuite) TestSyncSwitchTimeservers() {
 		suite.Assert().Equal(2*time.Millisecond, suite.clockAdjustments[i])
 	}
 }
+
+func (suite *NTPSuite) TestSyncNTS() {
+	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{"nts://ntp1.invalid", "nts://ntp2.invalid"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = func(host string) (*beevikntp.Response, error) {
+		return nil, fmt.Errorf("unexpected NTP query to %q", host)
+	}
+	syncer.NTSQuery = func(host string) (*beevikntp.Response, error) {
+		switch host {
+		case "nts://ntp1.invalid":
+			return nil, fmt.Errorf("key exchange failed")
+		case "nts://ntp2.invalid":
+			return suite.fakeQuery("127.0.0.3")
+		default:
+			return nil, fmt.Errorf("unknown host %q", host)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	select {
+	case <-syncer.StatusChange():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("status change timeout")
+	}
+
+	server, authenticated := syncer.SyncServer()
+	suite.Assert().Equal("nts://ntp2.invalid", server)
+	suite.Assert().True(authenticated)
+
+	cancel()
+
+	wg.Wait()
+}
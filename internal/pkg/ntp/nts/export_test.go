@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+// SIVSeal is exported for the test server.
+func SIVSeal(key, nonce, plaintext, ad []byte) ([]byte, error) {
+	s, err := newSIV(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Seal(nonce, plaintext, ad), nil
+}
+
+// SIVOpen is exported for the test server.
+func SIVOpen(key, nonce, ciphertext, ad []byte) ([]byte, error) {
+	s, err := newSIV(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Open(nonce, ciphertext, ad)
+}
+
+// ToNTPTime is exported for the test server.
+var ToNTPTime = toNTPTime
@@ -0,0 +1,289 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// NTS-KE record types (RFC 8915, section 4).
+const (
+	recordEndOfMessage  uint16 = 0
+	recordNextProtocol  uint16 = 1
+	recordError         uint16 = 2
+	recordWarning       uint16 = 3
+	recordAEADAlgorithm uint16 = 4
+	recordNewCookie     uint16 = 5
+	recordNTPv4Server   uint16 = 6
+	recordNTPv4Port     uint16 = 7
+	recordCriticalBit   uint16 = 0x8000
+	protocolNTPv4       uint16 = 0
+	aeadAESSIVCMAC256   uint16 = 15
+	keyExporterLabel           = "EXPORTER-network-time-security"
+	alpnProtocol               = "ntske/1"
+	keyExporterC2S      byte   = 0
+	keyExporterS2C      byte   = 1
+)
+
+type record struct {
+	critical bool
+	typ      uint16
+	body     []byte
+}
+
+func writeRecord(w io.Writer, rec record) error {
+	var hdr [4]byte
+
+	typ := rec.typ
+	if rec.critical {
+		typ |= recordCriticalBit
+	}
+
+	binary.BigEndian.PutUint16(hdr[0:], typ)
+	binary.BigEndian.PutUint16(hdr[2:], uint16(len(rec.body)))
+
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(rec.body)
+
+	return err
+}
+
+func readRecord(r io.Reader) (record, error) {
+	var hdr [4]byte
+
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return record{}, err
+	}
+
+	typ := binary.BigEndian.Uint16(hdr[0:])
+
+	rec := record{
+		critical: typ&recordCriticalBit != 0,
+		typ:      typ &^ recordCriticalBit,
+		body:     make([]byte, binary.BigEndian.Uint16(hdr[2:])),
+	}
+
+	_, err := io.ReadFull(r, rec.body)
+
+	return rec, err
+}
+
+func uint16Body(values ...uint16) []byte {
+	body := make([]byte, 2*len(values))
+
+	for i, v := range values {
+		binary.BigEndian.PutUint16(body[2*i:], v)
+	}
+
+	return body
+}
+
+// exportKeys derives the C2S and S2C keys from the TLS session (RFC 8915, section 5.1).
+func exportKeys(state tls.ConnectionState) (c2s, s2c []byte, err error) {
+	exportKey := func(direction byte) ([]byte, error) {
+		context := []byte{0, 0, 0, 0, direction}
+
+		binary.BigEndian.PutUint16(context[0:], protocolNTPv4)
+		binary.BigEndian.PutUint16(context[2:], aeadAESSIVCMAC256)
+
+		return state.ExportKeyingMaterial(keyExporterLabel, context, sivKeySize)
+	}
+
+	if c2s, err = exportKey(keyExporterC2S); err != nil {
+		return nil, nil, err
+	}
+
+	if s2c, err = exportKey(keyExporterS2C); err != nil {
+		return nil, nil, err
+	}
+
+	return c2s, s2c, nil
+}
+
+// keyExchange performs NTS key establishment with the NTS-KE server.
+//
+//nolint:gocyclo,cyclop
+func keyExchange(ctx context.Context, host string, port int, tlsConfig *tls.Config) (*session, error) {
+	sess := &session{}
+
+	cfg := tlsConfig.Clone()
+	cfg.ServerName = host
+	cfg.NextProtos = []string{alpnProtocol}
+	cfg.MinVersion = tls.VersionTLS13
+
+	if !cfg.InsecureSkipVerify {
+		roots := cfg.RootCAs
+
+		// the certificate is verified in VerifyConnection with the validity period checked against the server time
+		cfg.InsecureSkipVerify = true //nolint:gosec
+		cfg.VerifyConnection = func(state tls.ConnectionState) error {
+			return sess.verifyCertificate(state, roots, host)
+		}
+	}
+
+	dialer := tls.Dialer{
+		Config: cfg,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NTS-KE server: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline) //nolint:errcheck
+	}
+
+	tlsConn := conn.(*tls.Conn)
+
+	if tlsConn.ConnectionState().NegotiatedProtocol != alpnProtocol {
+		return nil, fmt.Errorf("NTS-KE server doesn't support %q", alpnProtocol)
+	}
+
+	w := bufio.NewWriter(tlsConn)
+
+	for _, rec := range []record{
+		{critical: true, typ: recordNextProtocol, body: uint16Body(protocolNTPv4)},
+		{typ: recordAEADAlgorithm, body: uint16Body(aeadAESSIVCMAC256)},
+		{critical: true, typ: recordEndOfMessage},
+	} {
+		if err = writeRecord(w, rec); err != nil {
+			return nil, fmt.Errorf("error writing NTS-KE request: %w", err)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return nil, fmt.Errorf("error writing NTS-KE request: %w", err)
+	}
+
+	var (
+		ntpHost       = host
+		ntpPort       = defaultNTPPort
+		protocolOK    bool
+		aeadOK        bool
+		r             = bufio.NewReader(tlsConn)
+		endOfResponse bool
+	)
+
+	for !endOfResponse {
+		rec, err := readRecord(r)
+		if err != nil {
+			return nil, fmt.Errorf("error reading NTS-KE response: %w", err)
+		}
+
+		switch rec.typ {
+		case recordEndOfMessage:
+			endOfResponse = true
+		case recordNextProtocol:
+			protocolOK = len(rec.body) == 2 && binary.BigEndian.Uint16(rec.body) == protocolNTPv4
+		case recordAEADAlgorithm:
+			aeadOK = len(rec.body) == 2 && binary.BigEndian.Uint16(rec.body) == aeadAESSIVCMAC256
+		case recordError:
+			if len(rec.body) != 2 {
+				return nil, errors.New("NTS-KE server returned malformed error")
+			}
+
+			return nil, fmt.Errorf("NTS-KE server returned error %d", binary.BigEndian.Uint16(rec.body))
+		case recordWarning:
+			// warnings are ignored
+		case recordNewCookie:
+			sess.cookies = append(sess.cookies, rec.body)
+		case recordNTPv4Server:
+			ntpHost = string(rec.body)
+		case recordNTPv4Port:
+			if len(rec.body) != 2 {
+				return nil, errors.New("NTS-KE server returned malformed port")
+			}
+
+			ntpPort = int(binary.BigEndian.Uint16(rec.body))
+		default:
+			if rec.critical {
+				return nil, fmt.Errorf("NTS-KE server returned unsupported critical record %d", rec.typ)
+			}
+		}
+	}
+
+	switch {
+	case !protocolOK:
+		return nil, errors.New("NTS-KE server doesn't support NTPv4")
+	case !aeadOK:
+		return nil, errors.New("NTS-KE server doesn't support AEAD_AES_SIV_CMAC_256")
+	case len(sess.cookies) == 0:
+		return nil, errors.New("NTS-KE server returned no cookies")
+	}
+
+	sess.ntpServer = net.JoinHostPort(ntpHost, strconv.Itoa(ntpPort))
+
+	c2s, s2c, err := exportKeys(tlsConn.ConnectionState())
+	if err != nil {
+		return nil, fmt.Errorf("error exporting NTS keys: %w", err)
+	}
+
+	if sess.c2s, err = newSIV(c2s); err != nil {
+		return nil, err
+	}
+
+	if sess.s2c, err = newSIV(s2c); err != nil {
+		return nil, err
+	}
+
+	return sess, nil
+}
+
+// verifyCertificate verifies the NTS-KE server certificate chain and the hostname.
+//
+// The clock is not synchronized before the first sync (e.g. RTC is reset), so the chain is verified at the start of
+// the leaf certificate validity period instead of the local time, and the validity period of the chain is recorded
+// to be checked against the time in the authenticated NTP responses (see session.checkValidity).
+func (sess *session) verifyCertificate(state tls.ConnectionState, roots *x509.CertPool, host string) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("NTS-KE server didn't present a certificate")
+	}
+
+	leaf := state.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       host,
+		CurrentTime:   leaf.NotBefore,
+	})
+	if err != nil {
+		return fmt.Errorf("error verifying NTS-KE server certificate: %w", err)
+	}
+
+	sess.notBefore, sess.notAfter = leaf.NotBefore, leaf.NotAfter
+
+	for _, cert := range chains[0] {
+		if cert.NotBefore.After(sess.notBefore) {
+			sess.notBefore = cert.NotBefore
+		}
+
+		if cert.NotAfter.Before(sess.notAfter) {
+			sess.notAfter = cert.NotAfter
+		}
+	}
+
+	return nil
+}
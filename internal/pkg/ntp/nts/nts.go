@@ -0,0 +1,329 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nts implements Network Time Security (RFC 8915) client for NTPv4.
+package nts
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// Scheme is the URL scheme of NTS time servers.
+const Scheme = "nts"
+
+// Default ports.
+const (
+	DefaultKEPort  = 4460
+	defaultNTPPort = 123
+)
+
+// DefaultTimeout is the default timeout of the key exchange and NTP query.
+const DefaultTimeout = 5 * time.Second
+
+// cookiePoolSize is the number of cookies the client tries to keep (RFC 8915, section 5.7).
+const cookiePoolSize = 8
+
+// IsNTSServer returns true if the time server should be queried with NTS.
+func IsNTSServer(server string) bool {
+	return strings.HasPrefix(server, Scheme+"://")
+}
+
+// ParseServer parses nts://host[:port] into host and NTS-KE port.
+func ParseServer(server string) (host string, port int, err error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if u.Scheme != Scheme || u.Hostname() == "" {
+		return "", 0, fmt.Errorf("invalid NTS server %q", server)
+	}
+
+	port = DefaultKEPort
+
+	if u.Port() != "" {
+		if port, err = strconv.Atoi(u.Port()); err != nil {
+			return "", 0, fmt.Errorf("invalid NTS server port %q: %w", server, err)
+		}
+	}
+
+	return u.Hostname(), port, nil
+}
+
+// session is the result of the key exchange.
+type session struct {
+	c2s, s2c  *siv
+	cookies   [][]byte
+	ntpServer string
+
+	// validity period of the NTS-KE server certificate chain, zero if the certificate is not verified
+	notBefore, notAfter time.Time
+}
+
+// Client performs authenticated NTP queries to NTS servers.
+//
+// Client keeps the sessions (keys and cookies) established with the NTS-KE servers,
+// and re-runs the key exchange when the cookies are exhausted or the query fails.
+//
+// The local clock can't be trusted before the first sync, so the validity period of the NTS-KE server certificate
+// is not checked against the local time during the TLS handshake. Instead, the time in each authenticated NTP response
+// should be within the validity period of the certificate chain, otherwise the response is rejected.
+type Client struct {
+	// TLSConfig is the base TLS configuration for NTS-KE (e.g. to override root CAs).
+	TLSConfig *tls.Config
+	Timeout   time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+// NewClient creates a new NTS client.
+func NewClient() *Client {
+	return &Client{
+		TLSConfig: &tls.Config{},
+		Timeout:   DefaultTimeout,
+		sessions:  map[string]*session{},
+	}
+}
+
+// Query performs an authenticated NTP query to the NTS server nts://host[:port].
+func (c *Client) Query(server string) (*ntp.Response, error) {
+	host, port, err := ParseServer(server)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sess := c.sessions[server]
+
+	if sess == nil || len(sess.cookies) == 0 {
+		sess, err = keyExchange(ctx, host, port, c.TLSConfig)
+		if err != nil {
+			delete(c.sessions, server)
+
+			return nil, err
+		}
+
+		c.sessions[server] = sess
+	}
+
+	resp, err := sess.query(ctx)
+	if err != nil {
+		// force new key exchange on the next query
+		delete(c.sessions, server)
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// query sends an authenticated NTP request consuming one cookie.
+//
+//nolint:gocyclo,cyclop
+func (sess *session) query(ctx context.Context) (*ntp.Response, error) {
+	cookie := sess.cookies[0]
+	sess.cookies = sess.cookies[1:]
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", sess.ntpServer)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline) //nolint:errcheck
+	}
+
+	uniqueID := make([]byte, uniqueIdentifierSize)
+	nonce := make([]byte, nonceSize)
+
+	if _, err := rand.Read(uniqueID); err != nil {
+		return nil, err
+	}
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	req := make([]byte, ntpHeaderLength)
+	req[0] = ntpVersion<<3 | ntpModeClient
+
+	// transmit timestamp is used to match the response (origin timestamp),
+	// it should be set before the packet is authenticated
+	sent := time.Now()
+	xmt := toNTPTime(sent)
+	binary.BigEndian.PutUint64(req[40:], xmt)
+
+	req = appendExtensionField(req, extensionField{typ: extUniqueIdentifier, body: uniqueID})
+	req = appendExtensionField(req, extensionField{typ: extCookie, body: cookie})
+
+	// request more cookies to refill the pool
+	for i := len(sess.cookies) + 1; i < cookiePoolSize; i++ {
+		req = appendExtensionField(req, extensionField{typ: extCookiePlaceholder, body: make([]byte, len(cookie))})
+	}
+
+	req = appendExtensionField(req, extensionField{typ: extAuthenticator, body: authenticatorBody(nonce, sess.c2s.Seal(nonce, nil, req))})
+
+	if _, err = conn.Write(req); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 65536)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		received := time.Now()
+
+		if n < ntpHeaderLength || binary.BigEndian.Uint64(buf[24:]) != xmt {
+			// not a response to our request
+			continue
+		}
+
+		resp, err := sess.processResponse(buf[:n], uniqueID, sent, received)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (sess *session) processResponse(packet, uniqueID []byte, sent, received time.Time) (*ntp.Response, error) {
+	if mode := packet[0] & 0x7; mode != ntpModeServer {
+		return nil, fmt.Errorf("unexpected NTP mode %d", mode)
+	}
+
+	stratum := packet[1]
+	referenceID := binary.BigEndian.Uint32(packet[12:])
+
+	if stratum == 0 {
+		// Kiss-o'-Death: NTSN means the server can't process the cookie
+		return nil, fmt.Errorf("kiss of death received: %s", string(bytes.TrimRight(packet[12:16], "\x00")))
+	}
+
+	fields, offsets, err := parseExtensionFields(packet[ntpHeaderLength:], ntpHeaderLength)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		uniqueIDOK    bool
+		authenticated bool
+	)
+
+	for i, field := range fields {
+		switch field.typ {
+		case extUniqueIdentifier:
+			uniqueIDOK = bytes.Equal(field.body, uniqueID)
+		case extAuthenticator:
+			nonce, ciphertext, err := parseAuthenticatorBody(field.body)
+			if err != nil {
+				return nil, err
+			}
+
+			plaintext, err := sess.s2c.Open(nonce, ciphertext, packet[:offsets[i]])
+			if err != nil {
+				return nil, fmt.Errorf("error authenticating NTP response: %w", err)
+			}
+
+			encryptedFields, _, err := parseExtensionFields(plaintext, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, encrypted := range encryptedFields {
+				if encrypted.typ == extCookie {
+					sess.cookies = append(sess.cookies, append([]byte(nil), encrypted.body...))
+				}
+			}
+
+			authenticated = true
+		}
+
+		if authenticated {
+			// fields after the authenticator are not authenticated, so they are ignored
+			break
+		}
+	}
+
+	switch {
+	case !authenticated:
+		return nil, errors.New("NTP response is not authenticated")
+	case !uniqueIDOK:
+		return nil, errors.New("NTP response unique identifier mismatch")
+	}
+
+	org := sent
+	rec := fromNTPTime(binary.BigEndian.Uint64(packet[32:]))
+	xmt := fromNTPTime(binary.BigEndian.Uint64(packet[40:]))
+	dst := received
+
+	resp := &ntp.Response{
+		Time:           xmt,
+		ClockOffset:    (rec.Sub(org) + xmt.Sub(dst)) / 2,
+		RTT:            dst.Sub(org) - xmt.Sub(rec),
+		Precision:      toInterval(int8(packet[3])),
+		Stratum:        stratum,
+		ReferenceID:    referenceID,
+		ReferenceTime:  fromNTPTime(binary.BigEndian.Uint64(packet[16:])),
+		RootDelay:      fromNTPShort(binary.BigEndian.Uint32(packet[4:])),
+		RootDispersion: fromNTPShort(binary.BigEndian.Uint32(packet[8:])),
+		Leap:           ntp.LeapIndicator(packet[0] >> 6),
+		Poll:           toInterval(int8(packet[2])),
+	}
+
+	if resp.RTT < 0 {
+		resp.RTT = 0
+	}
+
+	if err = sess.checkValidity(resp.Time); err != nil {
+		return nil, err
+	}
+
+	resp.RootDistance = (resp.RTT+resp.RootDelay)/2 + resp.RootDispersion
+
+	return resp, nil
+}
+
+// checkValidity checks that the NTS-KE server certificate is valid at the server time.
+func (sess *session) checkValidity(serverTime time.Time) error {
+	if sess.notAfter.IsZero() {
+		return nil
+	}
+
+	if serverTime.Before(sess.notBefore) || serverTime.After(sess.notAfter) {
+		return fmt.Errorf("NTS-KE server certificate is not valid at the server time %s (valid from %s to %s)",
+			serverTime.UTC().Format(time.RFC3339), sess.notBefore.UTC().Format(time.RFC3339), sess.notAfter.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,431 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts_test
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/ntp/nts"
+)
+
+// testServer is a minimal NTS-KE and NTS-protected NTP server.
+type testServer struct {
+	t *testing.T
+
+	keListener net.Listener
+	ntpConn    net.PacketConn
+	roots      *x509.CertPool
+
+	mu           sync.Mutex
+	cookies      map[string][2][]byte
+	keExchanges  int
+	ntpQueries   int
+	cookiesPerKE int
+	kissOfDeath  bool
+	clockOffset  time.Duration
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	return newTestServerWithValidity(t, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+}
+
+// newTestServerWithValidity creates a test server with the certificate valid in the given period.
+func newTestServerWithValidity(t *testing.T, notBefore, notAfter time.Time) *testServer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "nts.test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	keListener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		NextProtos:   []string{"ntske/1"},
+		MinVersion:   tls.VersionTLS13,
+	})
+	require.NoError(t, err)
+
+	ntpConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &testServer{
+		t:            t,
+		keListener:   keListener,
+		ntpConn:      ntpConn,
+		roots:        roots,
+		cookies:      map[string][2][]byte{},
+		cookiesPerKE: 8,
+	}
+
+	go srv.serveKE()
+	go srv.serveNTP()
+
+	t.Cleanup(func() {
+		keListener.Close() //nolint:errcheck
+		ntpConn.Close()    //nolint:errcheck
+	})
+
+	return srv
+}
+
+func (srv *testServer) server() string {
+	return fmt.Sprintf("nts://%s", srv.keListener.Addr().String())
+}
+
+func (srv *testServer) newCookie(c2s, s2c []byte) []byte {
+	cookie := make([]byte, 64)
+
+	_, err := rand.Read(cookie)
+	assert.NoError(srv.t, err)
+
+	srv.cookies[string(cookie)] = [2][]byte{c2s, s2c}
+
+	return cookie
+}
+
+func writeRecord(w io.Writer, typ uint16, body []byte) {
+	var hdr [4]byte
+
+	binary.BigEndian.PutUint16(hdr[0:], typ)
+	binary.BigEndian.PutUint16(hdr[2:], uint16(len(body)))
+
+	w.Write(hdr[:]) //nolint:errcheck
+	w.Write(body)   //nolint:errcheck
+}
+
+func uint16Body(v uint16) []byte {
+	return binary.BigEndian.AppendUint16(nil, v)
+}
+
+func (srv *testServer) serveKE() {
+	for {
+		conn, err := srv.keListener.Accept()
+		if err != nil {
+			return
+		}
+
+		srv.handleKE(conn.(*tls.Conn))
+	}
+}
+
+func (srv *testServer) handleKE(conn *tls.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	r := bufio.NewReader(conn)
+
+	// read the request till the end of message
+	for {
+		var hdr [4]byte
+
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			return
+		}
+
+		if _, err := io.CopyN(io.Discard, r, int64(binary.BigEndian.Uint16(hdr[2:]))); err != nil {
+			return
+		}
+
+		if binary.BigEndian.Uint16(hdr[0:])&0x7fff == 0 {
+			break
+		}
+	}
+
+	state := conn.ConnectionState()
+
+	c2s, err := state.ExportKeyingMaterial("EXPORTER-network-time-security", []byte{0, 0, 0, 15, 0}, 32)
+	assert.NoError(srv.t, err)
+
+	s2c, err := state.ExportKeyingMaterial("EXPORTER-network-time-security", []byte{0, 0, 0, 15, 1}, 32)
+	assert.NoError(srv.t, err)
+
+	_, portStr, _ := net.SplitHostPort(srv.ntpConn.LocalAddr().String()) //nolint:errcheck
+	port, _ := strconv.Atoi(portStr)                                     //nolint:errcheck
+
+	w := bufio.NewWriter(conn)
+
+	writeRecord(w, 0x8001, uint16Body(0))
+	writeRecord(w, 4, uint16Body(15))
+	writeRecord(w, 6, []byte("127.0.0.1"))
+	writeRecord(w, 7, uint16Body(uint16(port)))
+
+	srv.mu.Lock()
+	srv.keExchanges++
+
+	for i := 0; i < srv.cookiesPerKE; i++ {
+		writeRecord(w, 5, srv.newCookie(c2s, s2c))
+	}
+	srv.mu.Unlock()
+
+	writeRecord(w, 0x8000, nil)
+
+	w.Flush() //nolint:errcheck
+}
+
+func (srv *testServer) serveNTP() {
+	buf := make([]byte, 65536)
+
+	for {
+		n, addr, err := srv.ntpConn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		if resp := srv.handleNTP(buf[:n]); resp != nil {
+			srv.ntpConn.WriteTo(resp, addr) //nolint:errcheck
+		}
+	}
+}
+
+func appendField(b []byte, typ uint16, body []byte) []byte {
+	length := (4 + len(body) + 3) &^ 3
+	if length < 16 {
+		length = 16
+	}
+
+	b = binary.BigEndian.AppendUint16(b, typ)
+	b = binary.BigEndian.AppendUint16(b, uint16(length))
+	b = append(b, body...)
+
+	return append(b, make([]byte, length-4-len(body))...)
+}
+
+//nolint:gocyclo,cyclop
+func (srv *testServer) handleNTP(req []byte) []byte {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.ntpQueries++
+
+	var (
+		uniqueID []byte
+		keys     [2][]byte
+		found    bool
+		requests int
+	)
+
+	for offset := 48; offset+4 <= len(req); {
+		typ := binary.BigEndian.Uint16(req[offset:])
+		length := int(binary.BigEndian.Uint16(req[offset+2:]))
+		body := req[offset+4 : offset+length]
+
+		switch typ {
+		case 0x0104:
+			uniqueID = body
+		case 0x0204:
+			keys, found = srv.cookies[string(body)]
+			delete(srv.cookies, string(body))
+
+			requests++
+		case 0x0304:
+			requests++
+		case 0x0404:
+			nonceLength := int(binary.BigEndian.Uint16(body[0:]))
+			ciphertextLength := int(binary.BigEndian.Uint16(body[2:]))
+			nonce := body[4 : 4+nonceLength]
+			ciphertext := body[4+((nonceLength+3)&^3) : 4+((nonceLength+3)&^3)+ciphertextLength]
+
+			if !found {
+				break
+			}
+
+			if _, err := nts.SIVOpen(keys[0], nonce, ciphertext, req[:offset]); err != nil {
+				return nil
+			}
+		}
+
+		offset += length
+	}
+
+	resp := make([]byte, 48)
+
+	if !found || srv.kissOfDeath {
+		resp[0] = 4<<3 | 4
+		copy(resp[12:], "NTSN")
+		copy(resp[24:], req[40:48])
+
+		return resp
+	}
+
+	now := time.Now().Add(srv.clockOffset)
+
+	resp[0] = 4<<3 | 4
+	resp[1] = 2
+	resp[2] = 6
+	resp[3] = 0xec
+	copy(resp[12:], "TEST")
+	binary.BigEndian.PutUint64(resp[16:], nts.ToNTPTime(now.Add(-time.Second)))
+	copy(resp[24:], req[40:48])
+	binary.BigEndian.PutUint64(resp[32:], nts.ToNTPTime(now))
+	binary.BigEndian.PutUint64(resp[40:], nts.ToNTPTime(now))
+
+	resp = appendField(resp, 0x0104, uniqueID)
+
+	var plaintext []byte
+
+	for i := 0; i < requests; i++ {
+		plaintext = appendField(plaintext, 0x0204, srv.newCookie(keys[0], keys[1]))
+	}
+
+	nonce := make([]byte, 16)
+
+	_, err := rand.Read(nonce)
+	assert.NoError(srv.t, err)
+
+	ciphertext, err := nts.SIVSeal(keys[1], nonce, plaintext, resp)
+	assert.NoError(srv.t, err)
+
+	auth := binary.BigEndian.AppendUint16(nil, uint16(len(nonce)))
+	auth = binary.BigEndian.AppendUint16(auth, uint16(len(ciphertext)))
+	auth = append(auth, nonce...)
+	auth = append(auth, ciphertext...)
+	auth = append(auth, make([]byte, ((len(ciphertext)+3)&^3)-len(ciphertext))...)
+
+	return appendField(resp, 0x0404, auth)
+}
+
+func newClient(srv *testServer) *nts.Client {
+	client := nts.NewClient()
+	client.TLSConfig.RootCAs = srv.roots
+
+	return client
+}
+
+func TestQuery(t *testing.T) {
+	srv := newTestServer(t)
+	client := newClient(srv)
+
+	for i := 0; i < 20; i++ {
+		resp, err := client.Query(srv.server())
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 2, resp.Stratum)
+		assert.Equal(t, 64*time.Second, resp.Poll)
+		assert.Less(t, resp.ClockOffset.Abs(), time.Second)
+		assert.NoError(t, resp.Validate())
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	// cookies are refilled on every query, so a single key exchange is enough
+	assert.Equal(t, 1, srv.keExchanges)
+	assert.Equal(t, 20, srv.ntpQueries)
+}
+
+func TestQueryRekey(t *testing.T) {
+	srv := newTestServer(t)
+	srv.cookiesPerKE = 1
+
+	client := newClient(srv)
+
+	_, err := client.Query(srv.server())
+	require.NoError(t, err)
+
+	srv.mu.Lock()
+	srv.kissOfDeath = true
+	srv.mu.Unlock()
+
+	_, err = client.Query(srv.server())
+	require.Error(t, err)
+
+	srv.mu.Lock()
+	srv.kissOfDeath = false
+	srv.mu.Unlock()
+
+	_, err = client.Query(srv.server())
+	require.NoError(t, err)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	// session is dropped after NTSN, so the key exchange is performed again
+	assert.Equal(t, 2, srv.keExchanges)
+}
+
+func TestQueryUntrusted(t *testing.T) {
+	srv := newTestServer(t)
+	client := nts.NewClient()
+
+	_, err := client.Query(srv.server())
+	require.Error(t, err)
+}
+
+func TestQueryUnsyncedClock(t *testing.T) {
+	// the local clock is a year behind the server time, so the certificate is not valid yet according to the local clock
+	srv := newTestServerWithValidity(t, time.Now().Add(365*24*time.Hour-time.Hour), time.Now().Add(365*24*time.Hour+time.Hour))
+	srv.clockOffset = 365 * 24 * time.Hour
+
+	client := newClient(srv)
+
+	resp, err := client.Query(srv.server())
+	require.NoError(t, err)
+
+	assert.InDelta(t, srv.clockOffset, resp.ClockOffset, float64(time.Second))
+}
+
+func TestQueryCertificateExpiredAtServerTime(t *testing.T) {
+	// the certificate expired according to the server time
+	srv := newTestServerWithValidity(t, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	client := newClient(srv)
+
+	_, err := client.Query(srv.server())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not valid at the server time")
+}
+
+func TestParseServer(t *testing.T) {
+	host, port, err := nts.ParseServer("nts://time.cloudflare.com")
+	require.NoError(t, err)
+	assert.Equal(t, "time.cloudflare.com", host)
+	assert.Equal(t, nts.DefaultKEPort, port)
+
+	host, port, err = nts.ParseServer("nts://[2001:db8::1]:1234")
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8::1", host)
+	assert.Equal(t, 1234, port)
+
+	_, _, err = nts.ParseServer("time.cloudflare.com")
+	require.Error(t, err)
+
+	assert.True(t, nts.IsNTSServer("nts://time.cloudflare.com"))
+	assert.False(t, nts.IsNTSServer("time.cloudflare.com"))
+}
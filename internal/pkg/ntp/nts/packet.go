@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NTP extension field types (RFC 8915, section 5.7).
+const (
+	extUniqueIdentifier     uint16 = 0x0104
+	extCookie               uint16 = 0x0204
+	extCookiePlaceholder    uint16 = 0x0304
+	extAuthenticator        uint16 = 0x0404
+	ntpHeaderLength                = 48
+	nonceSize                      = 16
+	uniqueIdentifierSize           = 32
+	ntpModeClient                  = 3
+	ntpModeServer                  = 4
+	ntpVersion                     = 4
+	minExtensionFieldLength        = 16
+)
+
+type extensionField struct {
+	typ  uint16
+	body []byte
+}
+
+func padLength(n int) int {
+	return (n + 3) &^ 3
+}
+
+// appendExtensionField appends the extension field padding the body to a word boundary.
+func appendExtensionField(b []byte, ext extensionField) []byte {
+	length := padLength(4 + len(ext.body))
+	if length < minExtensionFieldLength {
+		length = minExtensionFieldLength
+	}
+
+	var hdr [4]byte
+
+	binary.BigEndian.PutUint16(hdr[0:], ext.typ)
+	binary.BigEndian.PutUint16(hdr[2:], uint16(length))
+
+	b = append(b, hdr[:]...)
+	b = append(b, ext.body...)
+
+	return append(b, make([]byte, length-4-len(ext.body))...)
+}
+
+// parseExtensionFields returns the extension fields and their offsets in the buffer.
+func parseExtensionFields(b []byte, base int) ([]extensionField, []int, error) {
+	var (
+		fields  []extensionField
+		offsets []int
+	)
+
+	for offset := 0; offset < len(b); {
+		if len(b)-offset < 4 {
+			return nil, nil, errors.New("truncated extension field")
+		}
+
+		typ := binary.BigEndian.Uint16(b[offset:])
+		length := int(binary.BigEndian.Uint16(b[offset+2:]))
+
+		if length < 4 || length%4 != 0 || offset+length > len(b) {
+			return nil, nil, fmt.Errorf("malformed extension field %#x", typ)
+		}
+
+		fields = append(fields, extensionField{typ: typ, body: b[offset+4 : offset+length]})
+		offsets = append(offsets, base+offset)
+
+		offset += length
+	}
+
+	return fields, offsets, nil
+}
+
+// authenticatorBody builds the body of NTS Authenticator and Encrypted Extension Fields extension field.
+func authenticatorBody(nonce, ciphertext []byte) []byte {
+	body := make([]byte, 4, 4+padLength(len(nonce))+padLength(len(ciphertext)))
+
+	binary.BigEndian.PutUint16(body[0:], uint16(len(nonce)))
+	binary.BigEndian.PutUint16(body[2:], uint16(len(ciphertext)))
+
+	body = append(body, nonce...)
+	body = append(body, make([]byte, padLength(len(nonce))-len(nonce))...)
+	body = append(body, ciphertext...)
+
+	return append(body, make([]byte, padLength(len(ciphertext))-len(ciphertext))...)
+}
+
+func parseAuthenticatorBody(body []byte) (nonce, ciphertext []byte, err error) {
+	if len(body) < 4 {
+		return nil, nil, errors.New("truncated authenticator")
+	}
+
+	nonceLength := int(binary.BigEndian.Uint16(body[0:]))
+	ciphertextLength := int(binary.BigEndian.Uint16(body[2:]))
+
+	if 4+padLength(nonceLength)+padLength(ciphertextLength) > len(body) {
+		return nil, nil, errors.New("malformed authenticator")
+	}
+
+	nonce = body[4 : 4+nonceLength]
+	ciphertext = body[4+padLength(nonceLength) : 4+padLength(nonceLength)+ciphertextLength]
+
+	return nonce, ciphertext, nil
+}
+
+var ntpEpoch = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// toNTPTime converts time to the 64-bit NTP timestamp.
+func toNTPTime(t time.Time) uint64 {
+	d := t.Sub(ntpEpoch)
+	sec := uint64(d / time.Second)
+	frac := (uint64(d%time.Second) << 32) / uint64(time.Second)
+
+	return sec<<32 | frac
+}
+
+// fromNTPTime converts the 64-bit NTP timestamp to time.
+func fromNTPTime(ts uint64) time.Time {
+	sec := time.Duration(ts>>32) * time.Second
+	frac := time.Duration(((ts & 0xffffffff) * uint64(time.Second)) >> 32)
+
+	return ntpEpoch.Add(sec + frac)
+}
+
+// fromNTPShort converts the 32-bit NTP short format to duration.
+func fromNTPShort(v uint32) time.Duration {
+	return time.Duration(v>>16)*time.Second + time.Duration((uint64(v&0xffff)*uint64(time.Second))>>16)
+}
+
+// toInterval converts log2 seconds to duration.
+func toInterval(t int8) time.Duration {
+	switch {
+	case t > 0:
+		return time.Second << uint(t)
+	case t < 0:
+		return time.Second >> uint(-t)
+	default:
+		return time.Second
+	}
+}
@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+// sivKeySize is the key size of AEAD_AES_SIV_CMAC_256.
+const sivKeySize = 32
+
+// siv implements AEAD_AES_SIV_CMAC_256 as defined in RFC 5297.
+type siv struct {
+	mac cipher.Block // K1, used for S2V
+	ctr cipher.Block // K2, used for CTR encryption
+}
+
+var errOpen = errors.New("message authentication failed")
+
+func newSIV(key []byte) (*siv, error) {
+	if len(key) != sivKeySize {
+		return nil, fmt.Errorf("invalid SIV key size %d", len(key))
+	}
+
+	mac, err := aes.NewCipher(key[:sivKeySize/2])
+	if err != nil {
+		return nil, err
+	}
+
+	ctr, err := aes.NewCipher(key[sivKeySize/2:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &siv{mac: mac, ctr: ctr}, nil
+}
+
+// Seal encrypts and authenticates plaintext with the associated data and the nonce.
+//
+// The nonce is the last component of the associated data vector (RFC 5297, section 3).
+func (s *siv) Seal(nonce, plaintext, ad []byte) []byte {
+	return s.seal([][]byte{ad, nonce}, plaintext)
+}
+
+// Open decrypts and authenticates ciphertext with the associated data and the nonce.
+func (s *siv) Open(nonce, ciphertext, ad []byte) ([]byte, error) {
+	return s.open([][]byte{ad, nonce}, ciphertext)
+}
+
+func (s *siv) seal(ad [][]byte, plaintext []byte) []byte {
+	v := s.s2v(ad, plaintext)
+
+	out := make([]byte, aes.BlockSize+len(plaintext))
+	copy(out, v[:])
+
+	s.xorCTR(out[aes.BlockSize:], plaintext, v)
+
+	return out
+}
+
+func (s *siv) open(ad [][]byte, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aes.BlockSize {
+		return nil, errOpen
+	}
+
+	var v [aes.BlockSize]byte
+
+	copy(v[:], ciphertext)
+
+	plaintext := make([]byte, len(ciphertext)-aes.BlockSize)
+
+	s.xorCTR(plaintext, ciphertext[aes.BlockSize:], v)
+
+	t := s.s2v(ad, plaintext)
+
+	if subtle.ConstantTimeCompare(t[:], v[:]) != 1 {
+		return nil, errOpen
+	}
+
+	return plaintext, nil
+}
+
+func (s *siv) xorCTR(dst, src []byte, v [aes.BlockSize]byte) {
+	// clear out the 31st and 63rd (rightmost) bits
+	v[8] &= 0x7f
+	v[12] &= 0x7f
+
+	cipher.NewCTR(s.ctr, v[:]).XORKeyStream(dst, src)
+}
+
+// s2v implements the S2V construction.
+func (s *siv) s2v(ad [][]byte, plaintext []byte) [aes.BlockSize]byte {
+	var zero [aes.BlockSize]byte
+
+	d := s.cmac(zero[:])
+
+	for _, component := range ad {
+		d = dbl(d)
+		xorBlock(&d, s.cmac(component))
+	}
+
+	var t []byte
+
+	if len(plaintext) >= aes.BlockSize {
+		t = append([]byte(nil), plaintext...)
+
+		// xorend
+		for i := range d {
+			t[len(t)-aes.BlockSize+i] ^= d[i]
+		}
+	} else {
+		d = dbl(d)
+
+		var padded [aes.BlockSize]byte
+
+		copy(padded[:], plaintext)
+		padded[len(plaintext)] = 0x80
+
+		xorBlock(&d, padded)
+
+		t = d[:]
+	}
+
+	return s.cmac(t)
+}
+
+// cmac implements AES-CMAC as defined in RFC 4493.
+func (s *siv) cmac(msg []byte) [aes.BlockSize]byte {
+	var l, k1, k2 [aes.BlockSize]byte
+
+	s.mac.Encrypt(l[:], l[:])
+	k1 = dbl(l)
+	k2 = dbl(k1)
+
+	var x [aes.BlockSize]byte
+
+	for len(msg) > aes.BlockSize {
+		for i := range x {
+			x[i] ^= msg[i]
+		}
+
+		s.mac.Encrypt(x[:], x[:])
+
+		msg = msg[aes.BlockSize:]
+	}
+
+	var last [aes.BlockSize]byte
+
+	copy(last[:], msg)
+
+	if len(msg) == aes.BlockSize {
+		xorBlock(&last, k1)
+	} else {
+		last[len(msg)] = 0x80
+
+		xorBlock(&last, k2)
+	}
+
+	xorBlock(&x, last)
+
+	s.mac.Encrypt(x[:], x[:])
+
+	return x
+}
+
+// dbl implements multiplication by x in GF(2^128).
+func dbl(b [aes.BlockSize]byte) [aes.BlockSize]byte {
+	var out [aes.BlockSize]byte
+
+	carry := b[0] >> 7
+
+	for i := 0; i < aes.BlockSize-1; i++ {
+		out[i] = b[i]<<1 | b[i+1]>>7
+	}
+
+	out[aes.BlockSize-1] = b[aes.BlockSize-1]<<1 ^ (0x87 * carry)
+
+	return out
+}
+
+func xorBlock(dst *[aes.BlockSize]byte, src [aes.BlockSize]byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	require.NoError(t, err)
+
+	return b
+}
+
+// Test vectors from RFC 5297, Appendix A.
+func TestSIV(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		key        string
+		ad         []string
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			name:       "deterministic",
+			key:        "fffefdfc fbfaf9f8 f7f6f5f4 f3f2f1f0 f0f1f2f3 f4f5f6f7 f8f9fafb fcfdfeff",
+			ad:         []string{"10111213 14151617 18191a1b 1c1d1e1f 20212223 24252627"},
+			plaintext:  "11223344 55667788 99aabbcc ddee",
+			ciphertext: "85632d07 c6e8f37f 950acd32 0a2ecc93 40c02b96 90c4dc04 daef7f6a fe5c",
+		},
+		{
+			name: "nonce-based",
+			key:  "7f7e7d7c 7b7a7978 77767574 73727170 40414243 44454647 48494a4b 4c4d4e4f",
+			ad: []string{
+				"00112233 44556677 8899aabb ccddeeff deaddada deaddada ffeeddcc bbaa9988 77665544 33221100",
+				"10203040 50607080 90a0",
+				"09f91102 9d74e35b d84156c5 635688c0",
+			},
+			plaintext: "74686973 20697320 736f6d65 20706c61 696e7465 78742074 6f20656e 63727970 74207573 696e6720 5349562d 414553",
+			ciphertext: "7bdb6e3b 432667eb 06f4d14b ff2fbd0f cb900f2f ddbe4043 26601965 c889bf17 " +
+				"dba77ceb 094fa663 b7a3f748 ba8af829 ea64ad54 4a272e9c 485b62a3 fd5c0d",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			s, err := newSIV(unhex(t, test.key))
+			require.NoError(t, err)
+
+			ad := make([][]byte, 0, len(test.ad))
+
+			for _, component := range test.ad {
+				ad = append(ad, unhex(t, component))
+			}
+
+			ciphertext := s.seal(ad, unhex(t, test.plaintext))
+			assert.Equal(t, unhex(t, test.ciphertext), ciphertext)
+
+			plaintext, err := s.open(ad, ciphertext)
+			require.NoError(t, err)
+			assert.Equal(t, unhex(t, test.plaintext), plaintext)
+
+			ciphertext[len(ciphertext)-1] ^= 1
+
+			_, err = s.open(ad, ciphertext)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -14,6 +14,7 @@ const (
 	EFISystemPartition  Type = "C12A7328-F81F-11D2-BA4B-00A0C93EC93B"
 	BIOSBootPartition   Type = "21686148-6449-6E6F-744E-656564454649"
 	LinuxFilesystemData Type = "0FC63DAF-8483-4772-8E79-3D69D8477DE4"
+	LinuxSwap           Type = "0657FD6D-A4AB-43C4-84E5-0933C84B4F4F"
 )
 
 // FileSystemType is used to format partitions.
@@ -24,6 +25,7 @@ const (
 	FilesystemTypeNone FileSystemType = "none"
 	FilesystemTypeXFS  FileSystemType = "xfs"
 	FilesystemTypeVFAT FileSystemType = "vfat"
+	FilesystemTypeSwap FileSystemType = "swap"
 )
 
 // Partition default sizes.
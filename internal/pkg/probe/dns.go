@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// DNS probe defaults.
+const (
+	DefaultDNSType    = "A"
+	DefaultDNSTimeout = 2 * time.Second
+)
+
+// DNSQueryType converts the record type name (e.g. AAAA) to the DNS query type.
+func DNSQueryType(name string) (uint16, error) {
+	if name == "" {
+		name = DefaultDNSType
+	}
+
+	qtype, ok := dns.StringToType[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unsupported DNS record type %q", name)
+	}
+
+	return qtype, nil
+}
+
+// DNS resolves the name via each of the DNS servers and reports the answers.
+//
+// Servers are specified as host or host:port, the port defaults to 53.
+func DNS(ctx context.Context, req *machine.ProbeDNSRequest, servers []string, send SendFunc) error {
+	qtype, err := DNSQueryType(req.Type)
+	if err != nil {
+		return err
+	}
+
+	timeout := durationOrDefault(req.Timeout, DefaultDNSTimeout)
+
+	name := req.Name
+
+	if qtype == dns.TypePTR {
+		// allow to specify IP address for reverse lookups
+		if reverse, err := dns.ReverseAddr(name); err == nil {
+			name = reverse
+		}
+	}
+
+	for i, server := range servers {
+		if _, _, err = net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(server, "53")
+		}
+
+		result := dnsProbe(ctx, dns.Fqdn(name), qtype, server, timeout)
+		result.Seq = uint32(i + 1)
+
+		if err = send(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dnsProbe(ctx context.Context, name string, qtype uint16, server string, timeout time.Duration) *machine.ProbeResult {
+	result := &machine.ProbeResult{
+		Address: server,
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(name, qtype)
+
+	client := &dns.Client{
+		Timeout: timeout,
+	}
+
+	reply, rtt, err := client.ExchangeContext(ctx, msg, server)
+	if err == nil && reply.Truncated {
+		// retry over TCP to get the full answer
+		client.Net = "tcp"
+
+		reply, rtt, err = client.ExchangeContext(ctx, msg, server)
+	}
+
+	if err != nil {
+		result.Error = probeError(err)
+
+		return result
+	}
+
+	result.Rtt = durationpb.New(rtt)
+
+	if reply.Rcode != dns.RcodeSuccess {
+		result.Error = dns.RcodeToString[reply.Rcode]
+
+		return result
+	}
+
+	for _, rr := range reply.Answer {
+		result.Records = append(result.Records, rr.String())
+	}
+
+	return result
+}
@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"net"
+	"net/netip"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+
+	ipv6HeaderLen = 40
+	icmpEchoLen   = 8
+)
+
+// errDestinationUnreachable is reported when the destination unreachable ICMP message is received.
+var errDestinationUnreachable = errors.New("destination unreachable")
+
+// icmpConn is a raw ICMP endpoint for a single address family.
+type icmpConn struct {
+	*icmp.PacketConn
+
+	proto int
+	ipv6  bool
+
+	echoRequest  icmp.Type
+	echoReply    icmp.Type
+	timeExceeded icmp.Type
+	dstUnreach   icmp.Type
+
+	// ICMP type of the echo request as it appears on the wire.
+	echoRequestType byte
+}
+
+// icmpReply describes the ICMP message received in response to the echo request.
+type icmpReply struct {
+	peer netip.Addr
+	// set if the echo reply was received from the destination.
+	reached bool
+	// set if the destination was reported to be unreachable.
+	err error
+}
+
+func listenICMP(dst netip.Addr) (*icmpConn, error) {
+	if dst.Is4() {
+		conn, err := icmp.ListenPacket("ip4:icmp", "0.0.0.0")
+		if err != nil {
+			return nil, err
+		}
+
+		return &icmpConn{
+			PacketConn:      conn,
+			proto:           protocolICMP,
+			echoRequest:     ipv4.ICMPTypeEcho,
+			echoReply:       ipv4.ICMPTypeEchoReply,
+			timeExceeded:    ipv4.ICMPTypeTimeExceeded,
+			dstUnreach:      ipv4.ICMPTypeDestinationUnreachable,
+			echoRequestType: byte(ipv4.ICMPTypeEcho),
+		}, nil
+	}
+
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+
+	return &icmpConn{
+		PacketConn:      conn,
+		proto:           protocolIPv6ICMP,
+		ipv6:            true,
+		echoRequest:     ipv6.ICMPTypeEchoRequest,
+		echoReply:       ipv6.ICMPTypeEchoReply,
+		timeExceeded:    ipv6.ICMPTypeTimeExceeded,
+		dstUnreach:      ipv6.ICMPTypeDestinationUnreachable,
+		echoRequestType: byte(ipv6.ICMPTypeEchoRequest),
+	}, nil
+}
+
+// newEchoID generates a random echo identifier.
+//
+// Raw ICMP sockets receive all ICMP messages, so the identifier is used to filter out replies to other probes.
+func newEchoID() int {
+	return rand.Intn(0xffff) + 1 //nolint:gosec
+}
+
+func (c *icmpConn) setTTL(ttl int) error {
+	if c.ipv6 {
+		return c.IPv6PacketConn().SetHopLimit(ttl)
+	}
+
+	return c.IPv4PacketConn().SetTTL(ttl)
+}
+
+func (c *icmpConn) sendEcho(dst netip.Addr, id, seq int, payload []byte) error {
+	msg := icmp.Message{
+		Type: c.echoRequest,
+		Body: &icmp.Echo{
+			ID:   id,
+			Seq:  seq,
+			Data: payload,
+		},
+	}
+
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WriteTo(b, &net.IPAddr{IP: dst.AsSlice(), Zone: dst.Zone()})
+
+	return err
+}
+
+// waitReply waits for the reply to the echo request with the specified id and seq until the deadline.
+//
+//nolint:gocyclo
+func (c *icmpConn) waitReply(id, seq int, deadline time.Time) (icmpReply, error) {
+	if err := c.SetReadDeadline(deadline); err != nil {
+		return icmpReply{}, err
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, peer, err := c.ReadFrom(buf)
+		if err != nil {
+			return icmpReply{}, err
+		}
+
+		msg, err := icmp.ParseMessage(c.proto, buf[:n])
+		if err != nil {
+			continue
+		}
+
+		var peerAddr netip.Addr
+
+		if ipAddr, ok := peer.(*net.IPAddr); ok {
+			peerAddr, _ = netip.AddrFromSlice(ipAddr.IP)
+			peerAddr = peerAddr.Unmap().WithZone(ipAddr.Zone)
+		}
+
+		switch msg.Type {
+		case c.echoReply:
+			if echo, ok := msg.Body.(*icmp.Echo); ok && uint16(echo.ID) == uint16(id) && uint16(echo.Seq) == uint16(seq) {
+				return icmpReply{peer: peerAddr, reached: true}, nil
+			}
+		case c.timeExceeded:
+			if body, ok := msg.Body.(*icmp.TimeExceeded); ok && c.matchEmbeddedEcho(body.Data, id, seq) {
+				return icmpReply{peer: peerAddr}, nil
+			}
+		case c.dstUnreach:
+			if body, ok := msg.Body.(*icmp.DstUnreach); ok && c.matchEmbeddedEcho(body.Data, id, seq) {
+				return icmpReply{peer: peerAddr, err: errDestinationUnreachable}, nil
+			}
+		}
+	}
+}
+
+// matchEmbeddedEcho checks whether the original datagram embedded into the ICMP error message is our echo request.
+func (c *icmpConn) matchEmbeddedEcho(data []byte, id, seq int) bool {
+	headerLen := ipv6HeaderLen
+
+	if !c.ipv6 {
+		if len(data) < 1 {
+			return false
+		}
+
+		headerLen = int(data[0]&0x0f) << 2
+	}
+
+	if len(data) < headerLen+icmpEchoLen {
+		return false
+	}
+
+	echo := data[headerLen:]
+
+	return echo[0] == c.echoRequestType &&
+		binary.BigEndian.Uint16(echo[4:6]) == uint16(id) &&
+		binary.BigEndian.Uint16(echo[6:8]) == uint16(seq)
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// Ping defaults.
+const (
+	DefaultPingCount    = 4
+	DefaultPingInterval = time.Second
+	DefaultPingTimeout  = time.Second
+	DefaultPingSize     = 56
+)
+
+// Ping sends ICMP echo requests to the host and reports each reply (or lack of it).
+func Ping(ctx context.Context, req *machine.ProbePingRequest, send SendFunc) error {
+	count := valueOrDefault(req.Count, DefaultPingCount)
+	size := valueOrDefault(req.Size, DefaultPingSize)
+	interval := durationOrDefault(req.Interval, DefaultPingInterval)
+	timeout := durationOrDefault(req.Timeout, DefaultPingTimeout)
+
+	dst, err := resolveHost(ctx, req.Host)
+	if err != nil {
+		return fmt.Errorf("error resolving %q: %w", req.Host, err)
+	}
+
+	conn, err := listenICMP(dst)
+	if err != nil {
+		return fmt.Errorf("error opening ICMP socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	id := newEchoID()
+	payload := make([]byte, size)
+
+	for seq := uint32(1); seq <= count; seq++ {
+		if seq > 1 {
+			if err = sleep(ctx, interval); err != nil {
+				return err
+			}
+		}
+
+		result := &machine.ProbeResult{
+			Seq:     seq,
+			Address: dst.String(),
+		}
+
+		start := time.Now()
+
+		if err = conn.sendEcho(dst, id, int(seq), payload); err != nil {
+			result.Error = probeError(err)
+		} else {
+			reply, err := conn.waitReply(id, int(seq), start.Add(timeout))
+
+			switch {
+			case err != nil:
+				result.Error = probeError(err)
+			case reply.err != nil:
+				result.Address = reply.peer.String()
+				result.Error = reply.err.Error()
+			default:
+				result.Address = reply.peer.String()
+				result.Rtt = durationpb.New(time.Since(start))
+			}
+		}
+
+		if err = send(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package probe implements network connectivity probes run from the node.
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// SendFunc is called for each probe result.
+type SendFunc func(*machine.ProbeResult) error
+
+// durationOrDefault returns the duration set in the request or the default value.
+func durationOrDefault(d *durationpb.Duration, def time.Duration) time.Duration {
+	if v := d.AsDuration(); v > 0 {
+		return v
+	}
+
+	return def
+}
+
+// valueOrDefault returns the value set in the request or the default value.
+func valueOrDefault(v, def uint32) uint32 {
+	if v > 0 {
+		return v
+	}
+
+	return def
+}
+
+// sleep waits for the duration or until the context is canceled.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// resolveHost resolves the host to a single IP address, preferring IPv4.
+func resolveHost(ctx context.Context, host string) (netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if len(addrs) == 0 {
+		return netip.Addr{}, fmt.Errorf("no addresses found for %q", host)
+	}
+
+	for _, addr := range addrs {
+		if addr.Unmap().Is4() {
+			return addr.Unmap(), nil
+		}
+	}
+
+	return addrs[0], nil
+}
+
+// probeError converts the error to the message reported in the probe result.
+func probeError(err error) string {
+	if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+
+	return err.Error()
+}
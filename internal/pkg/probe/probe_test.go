@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/internal/pkg/probe"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func collect(results *[]*machine.ProbeResult) probe.SendFunc {
+	return func(result *machine.ProbeResult) error {
+		*results = append(*results, result)
+
+		return nil
+	}
+}
+
+func TestTCP(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	addr := srv.Listener.Addr().String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var results []*machine.ProbeResult
+
+	require.NoError(t, probe.TCP(ctx, &machine.ProbeTCPRequest{
+		Address:               addr,
+		Count:                 2,
+		Interval:              durationpb.New(10 * time.Millisecond),
+		Tls:                   true,
+		TlsInsecureSkipVerify: true,
+	}, collect(&results)))
+
+	require.Len(t, results, 2)
+
+	for i, result := range results {
+		assert.EqualValues(t, i+1, result.Seq)
+		assert.Equal(t, addr, result.Address)
+		assert.Empty(t, result.Error)
+		assert.NotNil(t, result.Rtt)
+		assert.NotNil(t, result.TlsHandshake)
+	}
+
+	// certificate verification fails for the self-signed certificate
+	results = nil
+
+	require.NoError(t, probe.TCP(ctx, &machine.ProbeTCPRequest{
+		Address: addr,
+		Tls:     true,
+	}, collect(&results)))
+
+	require.Len(t, results, 1)
+	assert.NotNil(t, results[0].Rtt)
+	assert.Nil(t, results[0].TlsHandshake)
+	assert.Contains(t, results[0].Error, "certificate")
+
+	// connection refused
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	closedAddr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	results = nil
+
+	require.NoError(t, probe.TCP(ctx, &machine.ProbeTCPRequest{
+		Address: closedAddr,
+	}, collect(&results)))
+
+	require.Len(t, results, 1)
+	assert.Nil(t, results[0].Rtt)
+	assert.Contains(t, results[0].Error, "connection refused")
+}
+
+func TestDNS(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	mux := dns.NewServeMux()
+	mux.HandleFunc("example.com.", func(w dns.ResponseWriter, r *dns.Msg) {
+		msg := new(dns.Msg)
+		msg.SetReply(r)
+
+		rr, err := dns.NewRR("example.com. 300 IN A 192.0.2.1")
+		if err == nil {
+			msg.Answer = append(msg.Answer, rr)
+		}
+
+		w.WriteMsg(msg) //nolint:errcheck
+	})
+	mux.HandleFunc(".", func(w dns.ResponseWriter, r *dns.Msg) {
+		msg := new(dns.Msg)
+		msg.SetRcode(r, dns.RcodeNameError)
+
+		w.WriteMsg(msg) //nolint:errcheck
+	})
+
+	server := &dns.Server{PacketConn: pc, Handler: mux}
+
+	go server.ActivateAndServe() //nolint:errcheck
+
+	defer server.Shutdown() //nolint:errcheck
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var results []*machine.ProbeResult
+
+	require.NoError(t, probe.DNS(ctx, &machine.ProbeDNSRequest{
+		Name: "example.com",
+	}, []string{pc.LocalAddr().String()}, collect(&results)))
+
+	require.Len(t, results, 1)
+	assert.EqualValues(t, 1, results[0].Seq)
+	assert.Equal(t, pc.LocalAddr().String(), results[0].Address)
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, []string{"example.com.\t300\tIN\tA\t192.0.2.1"}, results[0].Records)
+
+	results = nil
+
+	require.NoError(t, probe.DNS(ctx, &machine.ProbeDNSRequest{
+		Name: "example.org",
+		Type: "aaaa",
+	}, []string{pc.LocalAddr().String()}, collect(&results)))
+
+	require.Len(t, results, 1)
+	assert.Equal(t, "NXDOMAIN", results[0].Error)
+
+	assert.Error(t, probe.DNS(ctx, &machine.ProbeDNSRequest{
+		Name: "example.com",
+		Type: "FOO",
+	}, []string{pc.LocalAddr().String()}, collect(&results)))
+}
+
+func TestPing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var results []*machine.ProbeResult
+
+	err := probe.Ping(ctx, &machine.ProbePingRequest{
+		Host:     "127.0.0.1",
+		Count:    2,
+		Interval: durationpb.New(10 * time.Millisecond),
+	}, collect(&results))
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("raw sockets are not available")
+	}
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	for i, result := range results {
+		assert.EqualValues(t, i+1, result.Seq)
+		assert.Equal(t, "127.0.0.1", result.Address)
+		assert.Empty(t, result.Error)
+		assert.NotNil(t, result.Rtt)
+	}
+}
+
+func TestTraceroute(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var results []*machine.ProbeResult
+
+	err := probe.Traceroute(ctx, &machine.ProbeTracerouteRequest{
+		Host: "127.0.0.1",
+	}, collect(&results))
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("raw sockets are not available")
+	}
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	assert.EqualValues(t, 1, results[0].Seq)
+	assert.Equal(t, "127.0.0.1", results[0].Address)
+	assert.True(t, results[0].Reached)
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// TCP probe defaults.
+const (
+	DefaultTCPCount    = 1
+	DefaultTCPInterval = time.Second
+	DefaultTCPTimeout  = 5 * time.Second
+)
+
+// TCP connects to the endpoint (optionally performing TLS handshake) and reports the timings of each attempt.
+func TCP(ctx context.Context, req *machine.ProbeTCPRequest, send SendFunc) error {
+	count := valueOrDefault(req.Count, DefaultTCPCount)
+	interval := durationOrDefault(req.Interval, DefaultTCPInterval)
+	timeout := durationOrDefault(req.Timeout, DefaultTCPTimeout)
+
+	serverName := req.TlsServerName
+
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(req.Address)
+		if err != nil {
+			return err
+		}
+
+		serverName = host
+	}
+
+	for seq := uint32(1); seq <= count; seq++ {
+		if seq > 1 {
+			if err := sleep(ctx, interval); err != nil {
+				return err
+			}
+		}
+
+		result := tcpProbe(ctx, req, serverName, timeout)
+		result.Seq = seq
+
+		if err := send(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func tcpProbe(ctx context.Context, req *machine.ProbeTCPRequest, serverName string, timeout time.Duration) *machine.ProbeResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := &machine.ProbeResult{
+		Address: req.Address,
+	}
+
+	var d net.Dialer
+
+	start := time.Now()
+
+	conn, err := d.DialContext(ctx, "tcp", req.Address)
+	if err != nil {
+		result.Error = probeError(err)
+
+		return result
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	result.Address = conn.RemoteAddr().String()
+	result.Rtt = durationpb.New(time.Since(start))
+
+	if !req.Tls {
+		return result
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: req.TlsInsecureSkipVerify, //nolint:gosec
+	})
+
+	start = time.Now()
+
+	if err = tlsConn.HandshakeContext(ctx); err != nil {
+		result.Error = probeError(err)
+
+		return result
+	}
+
+	result.TlsHandshake = durationpb.New(time.Since(start))
+
+	return result
+}
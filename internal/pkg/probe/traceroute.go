@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+// Traceroute defaults.
+const (
+	DefaultTracerouteMaxHops = 30
+	DefaultTracerouteTimeout = time.Second
+
+	traceroutePayloadSize = 32
+)
+
+// Traceroute sends ICMP echo requests with increasing TTL and reports the address of each hop.
+//
+// Tracing stops when the destination replies or reports to be unreachable, or when the maximum number of hops is reached.
+func Traceroute(ctx context.Context, req *machine.ProbeTracerouteRequest, send SendFunc) error {
+	maxHops := valueOrDefault(req.MaxHops, DefaultTracerouteMaxHops)
+	timeout := durationOrDefault(req.Timeout, DefaultTracerouteTimeout)
+
+	dst, err := resolveHost(ctx, req.Host)
+	if err != nil {
+		return fmt.Errorf("error resolving %q: %w", req.Host, err)
+	}
+
+	conn, err := listenICMP(dst)
+	if err != nil {
+		return fmt.Errorf("error opening ICMP socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	id := newEchoID()
+	payload := make([]byte, traceroutePayloadSize)
+
+	for hop := uint32(1); hop <= maxHops; hop++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if err = conn.setTTL(int(hop)); err != nil {
+			return fmt.Errorf("error setting TTL: %w", err)
+		}
+
+		result := &machine.ProbeResult{
+			Seq: hop,
+		}
+
+		start := time.Now()
+
+		var reply icmpReply
+
+		if err = conn.sendEcho(dst, id, int(hop), payload); err != nil {
+			result.Error = probeError(err)
+		} else {
+			reply, err = conn.waitReply(id, int(hop), start.Add(timeout))
+			if err != nil {
+				result.Error = probeError(err)
+			} else {
+				result.Address = reply.peer.String()
+				result.Rtt = durationpb.New(time.Since(start))
+				result.Reached = reply.reached
+
+				if reply.err != nil {
+					result.Error = reply.err.Error()
+				}
+			}
+		}
+
+		if err = send(result); err != nil {
+			return err
+		}
+
+		if reply.reached || reply.err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
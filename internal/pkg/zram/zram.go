@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package zram provides helpers to configure zram block devices.
+package zram
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultSysfsPath is the path to the block devices in sysfs.
+const DefaultSysfsPath = "/sys/block"
+
+// Device is a zram block device.
+type Device struct {
+	// Name of the device, e.g. zram0.
+	Name string
+	// SysfsPath is the path to the block devices in sysfs.
+	SysfsPath string
+}
+
+// NewDevice returns zram device with the specified name.
+func NewDevice(name string) *Device {
+	return &Device{
+		Name:      name,
+		SysfsPath: DefaultSysfsPath,
+	}
+}
+
+// Path returns the path to the device node.
+func (d *Device) Path() string {
+	return filepath.Join("/dev", d.Name)
+}
+
+// DiskSize returns the configured size of the device, zero means the device is not initialized.
+func (d *Device) DiskSize() (uint64, error) {
+	contents, err := d.read("disksize")
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(contents, 10, 64)
+}
+
+// Algorithm returns the active compression algorithm.
+func (d *Device) Algorithm() (string, error) {
+	contents, err := d.read("comp_algorithm")
+	if err != nil {
+		return "", err
+	}
+
+	// active algorithm is marked with brackets: lzo [lzo-rle] lz4 zstd
+	for _, algorithm := range strings.Fields(contents) {
+		if strings.HasPrefix(algorithm, "[") && strings.HasSuffix(algorithm, "]") {
+			return strings.Trim(algorithm, "[]"), nil
+		}
+	}
+
+	return "", fmt.Errorf("failed to find active compression algorithm for %q", d.Name)
+}
+
+// Matches checks whether the device is configured with the specified size and compression algorithm.
+//
+// Empty algorithm matches any algorithm.
+func (d *Device) Matches(size uint64, algorithm string) (bool, error) {
+	diskSize, err := d.DiskSize()
+	if err != nil {
+		return false, err
+	}
+
+	if diskSize != PageAlign(size) {
+		return false, nil
+	}
+
+	if algorithm == "" {
+		return true, nil
+	}
+
+	active, err := d.Algorithm()
+	if err != nil {
+		return false, err
+	}
+
+	return active == algorithm, nil
+}
+
+// PageAlign rounds the size up to the page size, as the kernel does for the configured disk size.
+func PageAlign(size uint64) uint64 {
+	pageSize := uint64(os.Getpagesize())
+
+	return (size + pageSize - 1) / pageSize * pageSize
+}
+
+// Configure initializes the device with the specified size and compression algorithm.
+//
+// Empty algorithm keeps the kernel default.
+// Device should be reset before it can be re-configured.
+func (d *Device) Configure(size uint64, algorithm string) error {
+	if algorithm != "" {
+		if err := d.write("comp_algorithm", algorithm); err != nil {
+			return err
+		}
+	}
+
+	return d.write("disksize", strconv.FormatUint(size, 10))
+}
+
+// Reset the device freeing the allocated memory.
+func (d *Device) Reset() error {
+	return d.write("reset", "1")
+}
+
+func (d *Device) read(attribute string) (string, error) {
+	contents, err := os.ReadFile(filepath.Join(d.SysfsPath, d.Name, attribute))
+	if err != nil {
+		return "", fmt.Errorf("error reading zram attribute %q: %w", attribute, err)
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+func (d *Device) write(attribute, value string) error {
+	if err := os.WriteFile(filepath.Join(d.SysfsPath, d.Name, attribute), []byte(value), 0o200); err != nil {
+		return fmt.Errorf("error writing zram attribute %q: %w", attribute, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package zram_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/zram"
+)
+
+func TestDevice(t *testing.T) {
+	sysfs := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(sysfs, "zram0"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sysfs, "zram0", "disksize"), []byte("0\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sysfs, "zram0", "comp_algorithm"), []byte("lzo [lzo-rle] lz4 zstd\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sysfs, "zram0", "reset"), nil, 0o644))
+
+	dev := zram.NewDevice("zram0")
+	dev.SysfsPath = sysfs
+
+	assert.Equal(t, "/dev/zram0", dev.Path())
+
+	size, err := dev.DiskSize()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, size)
+
+	algorithm, err := dev.Algorithm()
+	require.NoError(t, err)
+	assert.Equal(t, "lzo-rle", algorithm)
+
+	require.NoError(t, dev.Configure(1024*1024, "zstd"))
+
+	size, err = dev.DiskSize()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1024*1024, size)
+
+	contents, err := os.ReadFile(filepath.Join(sysfs, "zram0", "comp_algorithm"))
+	require.NoError(t, err)
+	assert.Equal(t, "zstd", string(contents))
+
+	require.NoError(t, dev.Reset())
+
+	contents, err = os.ReadFile(filepath.Join(sysfs, "zram0", "reset"))
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(contents))
+}
+
+func TestDeviceMatches(t *testing.T) {
+	sysfs := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(sysfs, "zram0"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sysfs, "zram0", "comp_algorithm"), []byte("lzo lzo-rle lz4 [zstd]\n"), 0o644))
+
+	dev := zram.NewDevice("zram0")
+	dev.SysfsPath = sysfs
+
+	pageSize := uint64(os.Getpagesize())
+
+	// kernel rounds the configured size up to the page size
+	requested := 100*pageSize + 1
+	require.NoError(t, os.WriteFile(filepath.Join(sysfs, "zram0", "disksize"), []byte(strconv.FormatUint(101*pageSize, 10)+"\n"), 0o644))
+
+	for _, test := range []struct {
+		name      string
+		size      uint64
+		algorithm string
+
+		expected bool
+	}{
+		{
+			name:     "unaligned size",
+			size:     requested,
+			expected: true,
+		},
+		{
+			name:     "aligned size",
+			size:     101 * pageSize,
+			expected: true,
+		},
+		{
+			name:     "smaller size",
+			size:     100 * pageSize,
+			expected: false,
+		},
+		{
+			name:      "same algorithm",
+			size:      requested,
+			algorithm: "zstd",
+			expected:  true,
+		},
+		{
+			name:      "different algorithm",
+			size:      requested,
+			algorithm: "lz4",
+			expected:  false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			matches, err := dev.Matches(test.size, test.algorithm)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, matches)
+		})
+	}
+}
+
+func TestPageAlign(t *testing.T) {
+	pageSize := uint64(os.Getpagesize())
+
+	assert.EqualValues(t, 0, zram.PageAlign(0))
+	assert.EqualValues(t, pageSize, zram.PageAlign(1))
+	assert.EqualValues(t, pageSize, zram.PageAlign(pageSize))
+	assert.EqualValues(t, 2*pageSize, zram.PageAlign(pageSize+1))
+}
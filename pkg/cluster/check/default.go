@@ -15,10 +15,10 @@ import (
 // DefaultClusterChecks returns a set of default Talos cluster readiness checks.
 func DefaultClusterChecks() []ClusterCheck {
 	return []ClusterCheck{
-		// wait for etcd to be healthy on all control plane nodes
+		// wait for etcd to be healthy on all control plane nodes running etcd
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("etcd to be healthy", func(ctx context.Context) error {
-				return ServiceHealthAssertion(ctx, cluster, "etcd", WithNodeTypes(machine.TypeInit, machine.TypeControlPlane))
+				return EtcdHealthAssertion(ctx, cluster)
 			}, 5*time.Minute, 5*time.Second)
 		},
 
@@ -15,11 +15,26 @@ import (
 	"github.com/siderolabs/gen/slices"
 
 	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/conditions"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 )
 
+// EtcdHealthAssertion checks that etcd is healthy on all control plane nodes which run etcd.
+func EtcdHealthAssertion(ctx context.Context, cl ClusterInfo) error {
+	nodes, err := etcdNodes(ctx, cl)
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) == 0 {
+		return conditions.ErrSkipAssertion
+	}
+
+	return ServiceHealthAssertion(ctx, cl, "etcd", WithNodes(nodes...))
+}
+
 // EtcdConsistentAssertion checks that etcd membership is consistent across nodes.
 //
 //nolint:gocyclo
@@ -29,13 +44,14 @@ func EtcdConsistentAssertion(ctx context.Context, cl ClusterInfo) error {
 		return err
 	}
 
-	var nodes []cluster.NodeInfo
-
-	initNodes := cl.NodesByType(machine.TypeInit)
-	nodes = append(nodes, initNodes...)
-	controlPlaneNodes := cl.NodesByType(machine.TypeControlPlane)
+	nodes, err := etcdNodes(ctx, cl)
+	if err != nil {
+		return err
+	}
 
-	nodes = append(nodes, controlPlaneNodes...)
+	if len(nodes) == 0 {
+		return conditions.ErrSkipAssertion
+	}
 
 	nodesCtx := client.WithNodes(ctx, mapIPsToStrings(mapNodeInfosToInternalIPs(nodes))...)
 
@@ -100,7 +116,17 @@ func EtcdControlPlaneNodesAssertion(ctx context.Context, cl ClusterInfo) error {
 
 	nodes := append(cl.NodesByType(machine.TypeInit), cl.NodesByType(machine.TypeControlPlane)...)
 
-	resp, err := cli.EtcdMemberList(ctx, &machineapi.EtcdMemberListRequest{})
+	nodesWithEtcd, err := etcdNodes(ctx, cl)
+	if err != nil {
+		return err
+	}
+
+	if len(nodesWithEtcd) == 0 {
+		// all control plane nodes use external etcd
+		return conditions.ErrSkipAssertion
+	}
+
+	resp, err := cli.EtcdMemberList(client.WithNode(ctx, nodesWithEtcd[0].InternalIP.String()), &machineapi.EtcdMemberListRequest{})
 	if err != nil {
 		return err
 	}
@@ -129,3 +155,42 @@ func EtcdControlPlaneNodesAssertion(ctx context.Context, cl ClusterInfo) error {
 
 	return nil
 }
+
+// etcdNodes returns the control plane nodes which run etcd.
+//
+// Control plane nodes with etcd role 'none' have control plane services (trustd) registered,
+// but they don't have etcd service.
+func etcdNodes(ctx context.Context, cl ClusterInfo) ([]cluster.NodeInfo, error) {
+	cli, err := cl.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := append(cl.NodesByType(machine.TypeInit), cl.NodesByType(machine.TypeControlPlane)...)
+
+	nodesCtx := client.WithNodes(ctx, mapIPsToStrings(mapNodeInfosToInternalIPs(nodes))...)
+
+	resp, err := cli.ServiceList(nodesCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	etcdLess := map[string]struct{}{}
+
+	for _, message := range resp.GetMessages() {
+		services := slices.ToSet(slices.Map(message.GetServices(), (*machineapi.ServiceInfo).GetId))
+
+		_, trustd := services["trustd"]
+		_, etcd := services["etcd"]
+
+		if trustd && !etcd {
+			etcdLess[message.GetMetadata().GetHostname()] = struct{}{}
+		}
+	}
+
+	return slices.Filter(nodes, func(node cluster.NodeInfo) bool {
+		_, skip := etcdLess[node.InternalIP.String()]
+
+		return !skip
+	}), nil
+}
@@ -4,7 +4,10 @@
 
 package check
 
-import "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+import (
+	"github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
 
 // Option represents functional option.
 type Option func(o *Options) error
@@ -18,9 +21,19 @@ func WithNodeTypes(t ...machine.Type) Option {
 	}
 }
 
+// WithNodes sets the explicit list of nodes for a check, overriding the node types.
+func WithNodes(nodes ...cluster.NodeInfo) Option {
+	return func(o *Options) error {
+		o.Nodes = nodes
+
+		return nil
+	}
+}
+
 // Options describes ClusterCheck parameters.
 type Options struct {
 	Types []machine.Type
+	Nodes []cluster.NodeInfo
 }
 
 // DefaultOptions returns the default options.
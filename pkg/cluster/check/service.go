@@ -86,11 +86,14 @@ func ServiceHealthAssertion(ctx context.Context, cl ClusterInfo, service string,
 
 	var nodes []cluster.NodeInfo
 
-	if len(opts.Types) > 0 {
+	switch {
+	case len(opts.Nodes) > 0:
+		nodes = opts.Nodes
+	case len(opts.Types) > 0:
 		for _, t := range opts.Types {
 			nodes = append(nodes, cl.NodesByType(t)...)
 		}
-	} else {
+	default:
 		nodes = cl.Nodes()
 	}
 
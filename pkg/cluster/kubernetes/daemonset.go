@@ -13,6 +13,8 @@ import (
 	"github.com/talos-systems/go-retry/retry"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/kubernetes"
@@ -21,8 +23,12 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
-//nolint:gocyclo
-func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds string, updateFunc func(daemonset *appsv1.DaemonSet) error) error {
+// updateDaemonset patches the daemonset and waits for the rollout.
+//
+// In dry-run mode, the patch is applied with server-side dry-run, and the diff is logged.
+//
+//nolint:gocyclo,cyclop
+func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds string, options UpgradeOptions, updateFunc func(daemonset *appsv1.DaemonSet) error) error {
 	daemonset, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, ds, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("error fetching daemonset: %w", err)
@@ -47,13 +53,31 @@ func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds st
 		return fmt.Errorf("failed to create two way merge patch: %w", err)
 	}
 
-	_, err = clientset.AppsV1().DaemonSets(namespace).Patch(ctx, daemonset.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{
+	patchOptions := metav1.PatchOptions{
 		FieldManager: "talos",
-	})
+	}
+
+	if options.DryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	patched, err := clientset.AppsV1().DaemonSets(namespace).Patch(ctx, daemonset.Name, types.StrategicMergePatchType, patchBytes, patchOptions)
 	if err != nil {
 		return fmt.Errorf("error patching deployment: %w", err)
 	}
 
+	if options.DryRun {
+		var diff string
+
+		if diff, err = daemonsetDiff(oldData, patched); err != nil {
+			return err
+		}
+
+		options.Log(" < apply skipped in dry run, diff:\n%s", diff)
+
+		return nil
+	}
+
 	// give k8s some time
 	time.Sleep(10 * time.Second)
 
@@ -90,13 +114,7 @@ func updateDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds st
 func upgradeDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds string, options UpgradeOptions) error {
 	options.Log("updating daemonset %q to version %q", ds, options.ToVersion)
 
-	if options.DryRun {
-		options.Log("skipped in dry-run")
-
-		return nil
-	}
-
-	return updateDaemonset(ctx, clientset, ds, func(daemonset *appsv1.DaemonSet) error {
+	return updateDaemonset(ctx, clientset, ds, options, func(daemonset *appsv1.DaemonSet) error {
 		if len(daemonset.Spec.Template.Spec.Containers) != 1 {
 			return fmt.Errorf("unexpected number of containers: %d", len(daemonset.Spec.Template.Spec.Containers))
 		}
@@ -117,3 +135,19 @@ func upgradeDaemonset(ctx context.Context, clientset *kubernetes.Clientset, ds s
 		return nil
 	})
 }
+
+// daemonsetDiff returns the diff between the original (JSON-encoded) and the updated daemonset.
+func daemonsetDiff(oldData []byte, updated *appsv1.DaemonSet) (string, error) {
+	var current map[string]interface{}
+
+	if err := json.Unmarshal(oldData, &current); err != nil {
+		return "", fmt.Errorf("error unmarshaling daemonset: %w", err)
+	}
+
+	newObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(updated)
+	if err != nil {
+		return "", fmt.Errorf("error converting daemonset: %w", err)
+	}
+
+	return objectDiff(&unstructured.Unstructured{Object: current}, &unstructured.Unstructured{Object: newObj})
+}
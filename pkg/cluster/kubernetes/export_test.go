@@ -0,0 +1,20 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+// ResolveState is exported for testing.
+func ResolveState(state *UpgradeState, clusterID, statePath string, options *UpgradeOptions) *UpgradeState {
+	options.statePath = statePath
+
+	return resolveState(state, clusterID, options)
+}
+
+// RunUpgradeStep is exported for testing.
+func RunUpgradeStep(options UpgradeOptions, state *UpgradeState, statePath, component string, step func() error) error {
+	options.state = state
+	options.statePath = statePath
+
+	return runUpgradeStep(options, component, step)
+}
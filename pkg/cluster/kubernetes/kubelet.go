@@ -8,7 +8,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -29,25 +28,39 @@ import (
 const kubelet = "kubelet"
 
 func upgradeKubelet(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) error {
-	if !options.UpgradeKubelet {
-		options.Log("skipped updating kubelet")
-
-		return nil
-	}
-
 	options.Log("updating kubelet to version %q", options.ToVersion)
 
+	componentState := options.state.Component(ComponentKubelet)
+
 	for _, node := range append(append([]string(nil), options.controlPlaneNodes...), options.workerNodes...) {
-		if err := upgradeKubeletOnNode(ctx, cluster, options, node); err != nil {
+		if componentState.NodeUpgraded(node) {
+			options.Log(" > %q: skipped, already updated", node)
+
+			continue
+		}
+
+		if err := upgradeKubeletOnNode(ctx, cluster, options, node, fmt.Sprintf("%s:v%s", constants.KubeletImage, options.ToVersion)); err != nil {
 			return fmt.Errorf("error updating node %q: %w", node, err)
 		}
+
+		if options.DryRun {
+			continue
+		}
+
+		componentState.MarkNodeUpgraded(node)
+
+		if err := options.saveState(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// upgradeKubeletOnNode updates kubelet on the node to the image.
+//
 //nolint:gocyclo,cyclop
-func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, node string) error {
+func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, node, image string) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -95,9 +108,18 @@ func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options
 		return fmt.Errorf("error fetching kubelet spec: %w", err)
 	}
 
+	if !options.rollback && !options.DryRun && kubeletSpec.TypedSpec().Image != image {
+		// record the current version before patching the config, so that it's available for the rollback even if the update fails
+		options.state.Component(ComponentKubelet).RecordPreviousImage(node, kubeletSpec.TypedSpec().Image)
+
+		if err = options.saveState(); err != nil {
+			return err
+		}
+	}
+
 	skipWait := false
 
-	err = patchNodeConfig(ctx, cluster, node, upgradeKubeletPatcher(options, kubeletSpec))
+	err = patchNodeConfig(ctx, cluster, node, options, upgradeKubeletPatcher(options, kubeletSpec, image))
 	if err != nil {
 		if errors.Is(err, errUpdateSkipped) {
 			skipWait = true
@@ -155,7 +177,7 @@ func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options
 
 	if err = retry.Constant(3*time.Minute, retry.WithUnits(10*time.Second)).Retry(
 		func() error {
-			return checkNodeKubeletVersion(ctx, cluster, node, "v"+options.ToVersion)
+			return checkNodeKubeletVersion(ctx, cluster, node, "v"+imageVersion(image, options.ToVersion))
 		},
 	); err != nil {
 		return err
@@ -166,9 +188,11 @@ func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options
 	return nil
 }
 
+// upgradeKubeletPatcher returns the patch which sets the kubelet image.
 func upgradeKubeletPatcher(
 	options UpgradeOptions,
 	kubeletSpec *k8s.KubeletSpec,
+	image string,
 ) func(config *v1alpha1config.Config) error {
 	return func(config *v1alpha1config.Config) error {
 		if config.MachineConfig == nil {
@@ -181,34 +205,11 @@ func upgradeKubeletPatcher(
 
 		oldImage := kubeletSpec.TypedSpec().Image
 
-		logUpdate := func(oldImage string) {
-			parts := strings.Split(oldImage, ":")
-			version := options.FromVersion
-
-			if len(parts) > 1 {
-				version = parts[1]
-			}
-
-			version = strings.TrimLeft(version, "v")
-
-			options.Log(" > update %s: %s -> %s", kubelet, version, options.ToVersion)
-
-			if options.DryRun {
-				options.Log(" > skipped in dry-run")
-			}
-		}
-
-		image := fmt.Sprintf("%s:v%s", constants.KubeletImage, options.ToVersion)
-
 		if oldImage == image {
 			return errUpdateSkipped
 		}
 
-		logUpdate(oldImage)
-
-		if options.DryRun {
-			return errUpdateSkipped
-		}
+		options.Log(" > update %s: %s -> %s", kubelet, imageVersion(oldImage, options.FromVersion), imageVersion(image, options.ToVersion))
 
 		config.MachineConfig.MachineKubelet.KubeletImage = image
 
@@ -10,17 +10,21 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	v1alpha1config "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 )
 
 // patchNodeConfig updates node configuration by means of patch function.
 //
-//nolint:gocyclo
-func patchNodeConfig(ctx context.Context, cluster UpgradeProvider, node string, patchFunc func(config *v1alpha1config.Config) error) error {
+// In dry-run mode, the diff of the machine configuration is logged, and errUpdateSkipped is returned.
+//
+//nolint:gocyclo,cyclop
+func patchNodeConfig(ctx context.Context, cluster UpgradeProvider, node string, options UpgradeOptions, patchFunc func(config *v1alpha1config.Config) error) error {
 	c, err := cluster.Client()
 	if err != nil {
 		return fmt.Errorf("error building Talos API client: %w", err)
@@ -42,10 +46,29 @@ func patchNodeConfig(ctx context.Context, cluster UpgradeProvider, node string,
 		return fmt.Errorf("config persistence is disabled, patching is not supported")
 	}
 
+	var oldCfgBytes []byte
+
+	if options.DryRun {
+		if oldCfgBytes, err = cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled)); err != nil {
+			return fmt.Errorf("error serializing config: %w", err)
+		}
+	}
+
 	if err = patchFunc(cfg); err != nil {
 		return fmt.Errorf("error patching config: %w", err)
 	}
 
+	if options.DryRun {
+		newCfgBytes, encodeErr := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+		if encodeErr != nil {
+			return fmt.Errorf("error serializing config: %w", encodeErr)
+		}
+
+		options.Log(" > skipped in dry-run, machine config diff:\n%s", cmp.Diff(string(oldCfgBytes), string(newCfgBytes)))
+
+		return errUpdateSkipped
+	}
+
 	cfgBytes, err := cfg.Bytes()
 	if err != nil {
 		return fmt.Errorf("error serializing config: %w", err)
@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// RollbackTalosManaged restores the versions of the control plane static pods and kubelet recorded in the upgrade state.
+//
+//nolint:gocyclo,cyclop
+func RollbackTalosManaged(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) error {
+	options.rollback = true
+
+	if options.StateDir == "" {
+		return fmt.Errorf("upgrade state directory is required for the rollback")
+	}
+
+	for _, component := range options.Components {
+		if !containsString(rollbackComponents, component) {
+			return fmt.Errorf("component %q can't be rolled back, supported components: %s", component, strings.Join(rollbackComponents, ", "))
+		}
+	}
+
+	clusterID, err := getClusterID(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	options.statePath = UpgradeStatePath(options.StateDir, clusterID)
+
+	state, err := LoadUpgradeState(options.statePath)
+	if err != nil {
+		return err
+	}
+
+	if state.ClusterID == "" {
+		return fmt.Errorf("no upgrade state found in %q", options.statePath)
+	}
+
+	if state.ClusterID != clusterID {
+		return fmt.Errorf("upgrade state %q was recorded for a different cluster", options.statePath)
+	}
+
+	options.state = state
+	options.FromVersion = state.ToVersion
+	options.ToVersion = state.FromVersion
+
+	rolledBack := false
+
+	for _, component := range rollbackComponents {
+		if !options.componentSelected(component) {
+			continue
+		}
+
+		componentState, ok := state.Components[component]
+		if !ok || len(componentState.PreviousImages) == 0 {
+			options.Log("skipped rolling back %s: no previous versions recorded", component)
+
+			continue
+		}
+
+		options.Log("rolling back %s", component)
+
+		nodes := make([]string, 0, len(componentState.PreviousImages))
+
+		for node := range componentState.PreviousImages {
+			nodes = append(nodes, node)
+		}
+
+		sort.Strings(nodes)
+
+		for _, node := range nodes {
+			image := componentState.PreviousImages[node]
+
+			if component == ComponentKubelet {
+				err = upgradeKubeletOnNode(ctx, cluster, options, node, image)
+			} else {
+				err = upgradeStaticPodOnNode(ctx, cluster, options, "kube-"+component, node, image)
+			}
+
+			if err != nil {
+				return fmt.Errorf("error rolling back %s on node %q: %w", component, node, err)
+			}
+
+			if options.DryRun {
+				continue
+			}
+
+			componentState.ForgetNode(node)
+			state.Finished = false
+
+			if err = options.saveState(); err != nil {
+				return err
+			}
+		}
+
+		rolledBack = true
+	}
+
+	if !rolledBack {
+		return fmt.Errorf("nothing to roll back")
+	}
+
+	if options.DryRun {
+		return nil
+	}
+
+	for _, componentState := range state.Components {
+		if len(componentState.PreviousImages) > 0 {
+			return nil
+		}
+	}
+
+	// everything was rolled back, the state is not needed anymore
+	if err = os.Remove(options.statePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing upgrade state: %w", err)
+	}
+
+	return nil
+}
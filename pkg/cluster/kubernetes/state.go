@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/gen/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// UpgradeState is the progress of the Kubernetes upgrade persisted between the runs.
+//
+// The state is used to resume the interrupted upgrade and to roll back the upgrade to the previous versions.
+type UpgradeState struct {
+	// ClusterID is the UID of the kube-system namespace, it protects from applying the state to the wrong cluster.
+	ClusterID   string `yaml:"clusterID"`
+	FromVersion string `yaml:"fromVersion"`
+	ToVersion   string `yaml:"toVersion"`
+	// Finished is set when the upgrade run completes successfully.
+	Finished bool `yaml:"finished"`
+
+	Components map[string]*ComponentUpgradeState `yaml:"components,omitempty"`
+}
+
+// ComponentUpgradeState is the upgrade progress of a single component.
+type ComponentUpgradeState struct {
+	// Completed is set when the component is upgraded on all nodes.
+	Completed bool `yaml:"completed"`
+	// UpgradedNodes lists the nodes the component is already upgraded on.
+	UpgradedNodes []string `yaml:"upgradedNodes,omitempty"`
+	// PreviousImages maps node address to the component image before the upgrade.
+	PreviousImages map[string]string `yaml:"previousImages,omitempty"`
+}
+
+// UpgradeStatePath returns the path to the upgrade state file of the cluster in the state directory.
+func UpgradeStatePath(stateDir, clusterID string) string {
+	return filepath.Join(stateDir, clusterID+".yaml")
+}
+
+// LoadUpgradeState reads the upgrade state from the file.
+//
+// If the file doesn't exist, empty state is returned.
+func LoadUpgradeState(path string) (*UpgradeState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &UpgradeState{}, nil
+		}
+
+		return nil, fmt.Errorf("error reading upgrade state: %w", err)
+	}
+
+	var state UpgradeState
+
+	if err = yaml.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("error decoding upgrade state %q: %w", path, err)
+	}
+
+	return &state, nil
+}
+
+// Save writes the upgrade state to the file.
+func (state *UpgradeState) Save(path string) error {
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("error encoding upgrade state: %w", err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating upgrade state directory: %w", err)
+	}
+
+	// write to the temporary file first, so that the interrupted write doesn't corrupt the state
+	tmp := path + ".tmp"
+
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing upgrade state: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Reset clears the progress of the upgrade, keeping the recorded previous images.
+func (state *UpgradeState) Reset() {
+	state.Finished = false
+
+	for _, component := range state.Components {
+		component.Completed = false
+		component.UpgradedNodes = nil
+	}
+}
+
+// Component returns the state of the component, creating it if needed.
+func (state *UpgradeState) Component(name string) *ComponentUpgradeState {
+	if state.Components == nil {
+		state.Components = map[string]*ComponentUpgradeState{}
+	}
+
+	component, ok := state.Components[name]
+	if !ok {
+		component = &ComponentUpgradeState{}
+		state.Components[name] = component
+	}
+
+	return component
+}
+
+// NodeUpgraded returns true if the component is already upgraded on the node.
+func (component *ComponentUpgradeState) NodeUpgraded(node string) bool {
+	return containsString(component.UpgradedNodes, node)
+}
+
+// MarkNodeUpgraded records that the component is upgraded on the node.
+func (component *ComponentUpgradeState) MarkNodeUpgraded(node string) {
+	if !component.NodeUpgraded(node) {
+		component.UpgradedNodes = append(component.UpgradedNodes, node)
+	}
+}
+
+// RecordPreviousImage records the image of the component on the node before the upgrade.
+//
+// The image recorded first wins, so that resuming the upgrade doesn't overwrite the original version.
+func (component *ComponentUpgradeState) RecordPreviousImage(node, image string) {
+	if image == "" {
+		return
+	}
+
+	if component.PreviousImages == nil {
+		component.PreviousImages = map[string]string{}
+	}
+
+	if _, ok := component.PreviousImages[node]; !ok {
+		component.PreviousImages[node] = image
+	}
+}
+
+// ForgetNode removes the recorded progress and the previous image of the component on the node.
+func (component *ComponentUpgradeState) ForgetNode(node string) {
+	delete(component.PreviousImages, node)
+
+	component.UpgradedNodes = slices.FilterInPlace(component.UpgradedNodes, func(n string) bool { return n != node })
+	component.Completed = false
+}
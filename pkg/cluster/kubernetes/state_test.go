@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/kubernetes"
+)
+
+func TestUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "state", "upgrade.yaml")
+
+	state, err := kubernetes.LoadUpgradeState(path)
+	require.NoError(t, err)
+	assert.Equal(t, &kubernetes.UpgradeState{}, state)
+
+	state.ClusterID = "cluster"
+	state.FromVersion = "1.25.2"
+	state.ToVersion = "1.26.0"
+
+	apiServer := state.Component(kubernetes.ComponentAPIServer)
+	apiServer.RecordPreviousImage("172.20.0.2", "registry.k8s.io/kube-apiserver:v1.25.2")
+	apiServer.RecordPreviousImage("172.20.0.2", "registry.k8s.io/kube-apiserver:v1.26.0")
+	apiServer.RecordPreviousImage("172.20.0.3", "registry.k8s.io/kube-apiserver:v1.25.2")
+	apiServer.MarkNodeUpgraded("172.20.0.2")
+	apiServer.MarkNodeUpgraded("172.20.0.2")
+
+	assert.True(t, apiServer.NodeUpgraded("172.20.0.2"))
+	assert.False(t, apiServer.NodeUpgraded("172.20.0.3"))
+
+	require.NoError(t, state.Save(path))
+
+	loaded, err := kubernetes.LoadUpgradeState(path)
+	require.NoError(t, err)
+	assert.Equal(t, state, loaded)
+
+	assert.Equal(t, map[string]string{
+		"172.20.0.2": "registry.k8s.io/kube-apiserver:v1.25.2",
+		"172.20.0.3": "registry.k8s.io/kube-apiserver:v1.25.2",
+	}, loaded.Components[kubernetes.ComponentAPIServer].PreviousImages)
+	assert.Equal(t, []string{"172.20.0.2"}, loaded.Components[kubernetes.ComponentAPIServer].UpgradedNodes)
+
+	loaded.Component(kubernetes.ComponentAPIServer).Completed = true
+	loaded.Finished = true
+	loaded.Reset()
+
+	assert.False(t, loaded.Finished)
+	assert.False(t, loaded.Components[kubernetes.ComponentAPIServer].Completed)
+	assert.Empty(t, loaded.Components[kubernetes.ComponentAPIServer].UpgradedNodes)
+	assert.Len(t, loaded.Components[kubernetes.ComponentAPIServer].PreviousImages, 2)
+
+	apiServer.ForgetNode("172.20.0.2")
+
+	assert.False(t, apiServer.NodeUpgraded("172.20.0.2"))
+	assert.Equal(t, map[string]string{
+		"172.20.0.3": "registry.k8s.io/kube-apiserver:v1.25.2",
+	}, apiServer.PreviousImages)
+}
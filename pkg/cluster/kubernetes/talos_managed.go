@@ -84,6 +84,14 @@ func UpgradeTalosManaged(ctx context.Context, cluster UpgradeProvider, options U
 	options.FromVersion = strings.TrimLeft(options.FromVersion, "v")
 	options.ToVersion = strings.TrimLeft(options.ToVersion, "v")
 
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	if err := loadState(ctx, cluster, &options); err != nil {
+		return err
+	}
+
 	switch path := options.Path(); path {
 	// nothing for all those
 	case "1.19->1.19":
@@ -126,7 +134,7 @@ func UpgradeTalosManaged(ctx context.Context, cluster UpgradeProvider, options U
 
 	options.Log("discovered controlplane nodes %q", options.controlPlaneNodes)
 
-	if options.UpgradeKubelet {
+	if options.UpgradeKubelet && options.componentSelected(ComponentKubelet) {
 		options.workerNodes, err = k8sClient.NodeIPs(ctx, machinetype.TypeWorker)
 		if err != nil {
 			return fmt.Errorf("error fetching worker nodes: %w", err)
@@ -141,7 +149,9 @@ func UpgradeTalosManaged(ctx context.Context, cluster UpgradeProvider, options U
 		}
 	}
 
-	if err = upgradeDaemonset(ctx, k8sClient.Clientset, kubeProxy, options); err != nil {
+	if err = runUpgradeStep(options, ComponentKubeProxy, func() error {
+		return upgradeDaemonset(ctx, k8sClient.Clientset, kubeProxy, options)
+	}); err != nil {
 		if apierrors.IsNotFound(err) {
 			options.Log("kube-proxy skipped as DaemonSet was not found")
 		} else {
@@ -149,28 +159,192 @@ func UpgradeTalosManaged(ctx context.Context, cluster UpgradeProvider, options U
 		}
 	}
 
-	if err = upgradeKubelet(ctx, cluster, options); err != nil {
+	if !options.UpgradeKubelet {
+		options.Log("skipped updating kubelet")
+	} else if err = runUpgradeStep(options, ComponentKubelet, func() error {
+		return upgradeKubelet(ctx, cluster, options)
+	}); err != nil {
 		return fmt.Errorf("failed upgrading kubelet: %w", err)
 	}
 
-	objects, err := getManifests(ctx, cluster)
+	if err = runUpgradeStep(options, ComponentManifests, func() error {
+		objects, manifestsErr := getManifests(ctx, cluster)
+		if manifestsErr != nil {
+			return manifestsErr
+		}
+
+		return syncManifests(ctx, objects, cluster, options)
+	}); err != nil {
+		return err
+	}
+
+	options.state.Finished = true
+
+	return options.saveState()
+}
+
+// loadState loads the persisted upgrade state of the cluster, resuming the interrupted upgrade to the same version.
+func loadState(ctx context.Context, cluster UpgradeProvider, options *UpgradeOptions) error {
+	if options.StateDir == "" {
+		// state is kept in memory only
+		options.state = &UpgradeState{
+			FromVersion: options.FromVersion,
+			ToVersion:   options.ToVersion,
+		}
+
+		return nil
+	}
+
+	clusterID, err := getClusterID(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	options.statePath = UpgradeStatePath(options.StateDir, clusterID)
+
+	state, err := LoadUpgradeState(options.statePath)
 	if err != nil {
 		return err
 	}
 
-	return syncManifests(ctx, objects, cluster, options)
+	options.state = resolveState(state, clusterID, options)
+
+	return options.saveState()
 }
 
-func upgradeStaticPod(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, service string) error {
-	options.Log("updating %q to version %q", service, options.ToVersion)
+// resolveState picks the upgrade state for the run: the loaded state is either resumed, reset or replaced.
+func resolveState(state *UpgradeState, clusterID string, options *UpgradeOptions) *UpgradeState {
+	switch {
+	case state.ClusterID == clusterID && state.ToVersion == options.ToVersion && !state.Finished:
+		options.Log("resuming the upgrade %s -> %s from the state %q", state.FromVersion, state.ToVersion, options.statePath)
 
-	for _, node := range options.controlPlaneNodes {
-		if err := upgradeStaticPodOnNode(ctx, cluster, options, service, node); err != nil {
-			return fmt.Errorf("error updating node %q: %w", node, err)
+		if state.FromVersion != "" {
+			options.FromVersion = state.FromVersion
 		}
+
+		return state
+	case state.ClusterID == clusterID && state.ToVersion == options.ToVersion:
+		// upgrade to the same version is re-run, keep the versions recorded by the previous run for the rollback
+		state.Reset()
+
+		return state
 	}
 
-	return nil
+	if state.ClusterID != "" && !state.Finished {
+		options.Log("WARNING: discarding the unfinished upgrade %s -> %s recorded in %q, the recorded versions can't be rolled back anymore",
+			state.FromVersion, state.ToVersion, options.statePath)
+	}
+
+	return &UpgradeState{
+		ClusterID:   clusterID,
+		FromVersion: options.FromVersion,
+		ToVersion:   options.ToVersion,
+	}
+}
+
+// getClusterID returns the UID of the kube-system namespace which identifies the cluster.
+func getClusterID(ctx context.Context, cluster UpgradeProvider) (string, error) {
+	k8sClient, err := cluster.K8sHelper(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	ns, err := k8sClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error fetching namespace %q: %w", namespace, err)
+	}
+
+	return string(ns.UID), nil
+}
+
+// runUpgradeStep runs the upgrade step of the component, unless the component is not selected or was already upgraded.
+func runUpgradeStep(options UpgradeOptions, component string, step func() error) error {
+	if !options.componentSelected(component) {
+		options.Log("skipped updating %s", component)
+
+		return nil
+	}
+
+	componentState := options.state.Component(component)
+
+	if componentState.Completed {
+		options.Log("skipped updating %s: already updated", component)
+
+		return nil
+	}
+
+	if err := step(); err != nil {
+		return err
+	}
+
+	if options.DryRun {
+		return nil
+	}
+
+	componentState.Completed = true
+
+	return options.saveState()
+}
+
+// staticPodComponent returns the upgrade component of the static pod service.
+func staticPodComponent(service string) string {
+	return strings.TrimPrefix(service, "kube-")
+}
+
+// staticPodImage returns the image of the static pod service for the Kubernetes version.
+func staticPodImage(service, version string) string {
+	switch service {
+	case kubeAPIServer:
+		return fmt.Sprintf("%s:v%s", constants.KubernetesAPIServerImage, version)
+	case kubeControllerManager:
+		return fmt.Sprintf("%s:v%s", constants.KubernetesControllerManagerImage, version)
+	case kubeScheduler:
+		return fmt.Sprintf("%s:v%s", constants.KubernetesSchedulerImage, version)
+	}
+
+	panic(fmt.Sprintf("unknown service ID %q", service))
+}
+
+// imageVersion returns the version (tag) of the image without the leading `v`, or the fallback if the image has no tag.
+func imageVersion(image, fallback string) string {
+	idx := strings.LastIndex(image, ":")
+	if idx == -1 || strings.Contains(image[idx:], "/") {
+		return fallback
+	}
+
+	return strings.TrimLeft(image[idx+1:], "v")
+}
+
+func upgradeStaticPod(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, service string) error {
+	return runUpgradeStep(options, staticPodComponent(service), func() error {
+		options.Log("updating %q to version %q", service, options.ToVersion)
+
+		componentState := options.state.Component(staticPodComponent(service))
+
+		for _, node := range options.controlPlaneNodes {
+			if componentState.NodeUpgraded(node) {
+				options.Log(" > %q: skipped, already updated", node)
+
+				continue
+			}
+
+			if err := upgradeStaticPodOnNode(ctx, cluster, options, service, node, staticPodImage(service, options.ToVersion)); err != nil {
+				return fmt.Errorf("error updating node %q: %w", node, err)
+			}
+
+			if options.DryRun {
+				continue
+			}
+
+			componentState.MarkNodeUpgraded(node)
+
+			if err := options.saveState(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func controlplaneConfigResourceType(service string) resource.Type {
@@ -186,8 +360,10 @@ func controlplaneConfigResourceType(service string) resource.Type {
 	panic(fmt.Sprintf("unknown service ID %q", service))
 }
 
+// upgradeStaticPodOnNode updates the static pod service on the node to the image.
+//
 //nolint:gocyclo
-func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, service, node string) error {
+func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, service, node, image string) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -208,7 +384,7 @@ func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, option
 
 	var (
 		expectedConfigVersion string
-		initialConfig         resource.Resource
+		configImage           string
 	)
 
 	select {
@@ -218,14 +394,26 @@ func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, option
 		}
 
 		expectedConfigVersion = ev.Resource.Metadata().Version().String()
-		initialConfig = ev.Resource
+
+		if configImage, err = staticPodConfigImage(ev.Resource); err != nil {
+			return err
+		}
 	case <-ctx.Done():
 		return ctx.Err()
 	}
 
+	if !options.rollback && !options.DryRun && configImage != image {
+		// record the current version before patching the config, so that it's available for the rollback even if the update fails
+		options.state.Component(staticPodComponent(service)).RecordPreviousImage(node, configImage)
+
+		if err = options.saveState(); err != nil {
+			return err
+		}
+	}
+
 	skipConfigWait := false
 
-	err = patchNodeConfig(ctx, cluster, node, upgradeStaticPodPatcher(options, service, initialConfig))
+	err = patchNodeConfig(ctx, cluster, node, options, upgradeStaticPodPatcher(options, service, configImage, image))
 	if err != nil {
 		if errors.Is(err, errUpdateSkipped) {
 			skipConfigWait = true
@@ -265,46 +453,32 @@ func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, option
 	return nil
 }
 
+// staticPodConfigImage returns the image from the static pod service config resource.
+func staticPodConfigImage(configResource resource.Resource) (string, error) {
+	switch r := configResource.(type) {
+	case *k8s.APIServerConfig:
+		return r.TypedSpec().Image, nil
+	case *k8s.ControllerManagerConfig:
+		return r.TypedSpec().Image, nil
+	case *k8s.SchedulerConfig:
+		return r.TypedSpec().Image, nil
+	default:
+		return "", fmt.Errorf("unsupported service config %T", configResource)
+	}
+}
+
 var errUpdateSkipped = fmt.Errorf("update skipped")
 
-//nolint:gocyclo,cyclop
-func upgradeStaticPodPatcher(options UpgradeOptions, service string, configResource resource.Resource) func(config *v1alpha1config.Config) error {
+// upgradeStaticPodPatcher returns the patch which sets the image of the static pod service.
+//
+// configImage is the image currently used by the service.
+func upgradeStaticPodPatcher(options UpgradeOptions, service, configImage, image string) func(config *v1alpha1config.Config) error {
 	return func(config *v1alpha1config.Config) error {
 		if config.ClusterConfig == nil {
 			config.ClusterConfig = &v1alpha1config.ClusterConfig{}
 		}
 
-		var configImage string
-
-		switch r := configResource.(type) {
-		case *k8s.APIServerConfig:
-			configImage = r.TypedSpec().Image
-		case *k8s.ControllerManagerConfig:
-			configImage = r.TypedSpec().Image
-		case *k8s.SchedulerConfig:
-			configImage = r.TypedSpec().Image
-		default:
-			return fmt.Errorf("unsupported service config %T", configResource)
-		}
-
-		logUpdate := func(oldImage string) {
-			parts := strings.Split(oldImage, ":")
-			version := options.FromVersion
-
-			if oldImage == "" {
-				version = options.FromVersion
-			}
-
-			if len(parts) > 1 {
-				version = parts[1]
-			}
-
-			options.Log(" > update %s: %s -> %s", service, version, options.ToVersion)
-
-			if options.DryRun {
-				options.Log(" > skipped in dry-run")
-			}
-		}
+		var containerImage *string
 
 		switch service {
 		case kubeAPIServer:
@@ -312,59 +486,31 @@ func upgradeStaticPodPatcher(options UpgradeOptions, service string, configResou
 				config.ClusterConfig.APIServerConfig = &v1alpha1config.APIServerConfig{}
 			}
 
-			image := fmt.Sprintf("%s:v%s", constants.KubernetesAPIServerImage, options.ToVersion)
-
-			if config.ClusterConfig.APIServerConfig.ContainerImage == image || configImage == image {
-				return errUpdateSkipped
-			}
-
-			logUpdate(config.ClusterConfig.APIServerConfig.ContainerImage)
-
-			if options.DryRun {
-				return errUpdateSkipped
-			}
-
-			config.ClusterConfig.APIServerConfig.ContainerImage = image
+			containerImage = &config.ClusterConfig.APIServerConfig.ContainerImage
 		case kubeControllerManager:
 			if config.ClusterConfig.ControllerManagerConfig == nil {
 				config.ClusterConfig.ControllerManagerConfig = &v1alpha1config.ControllerManagerConfig{}
 			}
 
-			image := fmt.Sprintf("%s:v%s", constants.KubernetesControllerManagerImage, options.ToVersion)
-
-			if config.ClusterConfig.ControllerManagerConfig.ContainerImage == image || configImage == image {
-				return errUpdateSkipped
-			}
-
-			logUpdate(config.ClusterConfig.ControllerManagerConfig.ContainerImage)
-
-			if options.DryRun {
-				return errUpdateSkipped
-			}
-
-			config.ClusterConfig.ControllerManagerConfig.ContainerImage = image
+			containerImage = &config.ClusterConfig.ControllerManagerConfig.ContainerImage
 		case kubeScheduler:
 			if config.ClusterConfig.SchedulerConfig == nil {
 				config.ClusterConfig.SchedulerConfig = &v1alpha1config.SchedulerConfig{}
 			}
 
-			image := fmt.Sprintf("%s:v%s", constants.KubernetesSchedulerImage, options.ToVersion)
-
-			if config.ClusterConfig.SchedulerConfig.ContainerImage == image || configImage == image {
-				return errUpdateSkipped
-			}
-
-			logUpdate(config.ClusterConfig.SchedulerConfig.ContainerImage)
-
-			if options.DryRun {
-				return errUpdateSkipped
-			}
-
-			config.ClusterConfig.SchedulerConfig.ContainerImage = image
+			containerImage = &config.ClusterConfig.SchedulerConfig.ContainerImage
 		default:
 			return fmt.Errorf("unsupported service %q", service)
 		}
 
+		if *containerImage == image || configImage == image {
+			return errUpdateSkipped
+		}
+
+		options.Log(" > update %s: %s -> %s", service, imageVersion(configImage, options.FromVersion), imageVersion(image, options.ToVersion))
+
+		*containerImage = image
+
 		return nil
 	}
 }
@@ -587,14 +733,21 @@ func getResourceDiff(ctx context.Context, dr dynamic.ResourceInterface, obj *uns
 		return "", err
 	}
 
+	return objectDiff(current, resp)
+}
+
+// objectDiff returns the diff of the YAML representation of the objects, ignoring the metadata.
+//
+// The objects are modified in place.
+func objectDiff(current, updated *unstructured.Unstructured) (string, error) {
 	ignoreKey := func(key string) {
 		delete(current.Object, key)
-		delete(resp.Object, key)
+		delete(updated.Object, key)
 	}
 
 	ignoreKey("metadata") // contains lots of dynamic data generated by kubernetes
 
-	if resp.GetKind() == "ServiceAccount" {
+	if updated.GetKind() == "ServiceAccount" {
 		ignoreKey("secrets") // injected by Kubernetes in ServiceAccount objects
 	}
 
@@ -603,7 +756,7 @@ func getResourceDiff(ctx context.Context, dr dynamic.ResourceInterface, obj *uns
 		return "", err
 	}
 
-	y, err := k8syaml.Marshal(resp)
+	y, err := k8syaml.Marshal(updated)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,266 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes_test
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/cluster/kubernetes"
+)
+
+func TestResolveState(t *testing.T) {
+	t.Parallel()
+
+	const clusterID = "cluster"
+
+	recorded := func(toVersion string, finished bool) *kubernetes.UpgradeState {
+		state := &kubernetes.UpgradeState{
+			ClusterID:   clusterID,
+			FromVersion: "1.25.2",
+			ToVersion:   toVersion,
+			Finished:    finished,
+		}
+
+		apiServer := state.Component(kubernetes.ComponentAPIServer)
+		apiServer.RecordPreviousImage("172.20.0.2", "registry.k8s.io/kube-apiserver:v1.25.2")
+		apiServer.MarkNodeUpgraded("172.20.0.2")
+		apiServer.Completed = true
+
+		return state
+	}
+
+	t.Run("Resume", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		state := recorded("1.26.0", false)
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.0",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(state, clusterID, "state.yaml", &options)
+
+		assert.Same(t, state, resolved)
+		assert.True(t, resolved.Components[kubernetes.ComponentAPIServer].Completed)
+		assert.Equal(t, []string{"172.20.0.2"}, resolved.Components[kubernetes.ComponentAPIServer].UpgradedNodes)
+
+		// the version the upgrade was started from is kept
+		assert.Equal(t, "1.25.2", options.FromVersion)
+		assert.Contains(t, log.String(), "resuming the upgrade 1.25.2 -> 1.26.0")
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		state := recorded("1.26.0", true)
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.26.0",
+			ToVersion:   "1.26.0",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(state, clusterID, "state.yaml", &options)
+
+		assert.Same(t, state, resolved)
+		assert.False(t, resolved.Finished)
+		assert.False(t, resolved.Components[kubernetes.ComponentAPIServer].Completed)
+		assert.Empty(t, resolved.Components[kubernetes.ComponentAPIServer].UpgradedNodes)
+
+		// the versions recorded by the previous run are kept for the rollback
+		assert.Equal(t, map[string]string{"172.20.0.2": "registry.k8s.io/kube-apiserver:v1.25.2"},
+			resolved.Components[kubernetes.ComponentAPIServer].PreviousImages)
+		assert.Equal(t, "1.26.0", options.FromVersion)
+		assert.Empty(t, log.String())
+	})
+
+	t.Run("ReplaceFinished", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.26.0",
+			ToVersion:   "1.26.1",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(recorded("1.26.0", true), clusterID, "state.yaml", &options)
+
+		assert.Equal(t, &kubernetes.UpgradeState{
+			ClusterID:   clusterID,
+			FromVersion: "1.26.0",
+			ToVersion:   "1.26.1",
+		}, resolved)
+		assert.Empty(t, log.String())
+	})
+
+	t.Run("ReplaceUnfinished", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.1",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(recorded("1.26.0", false), clusterID, "state.yaml", &options)
+
+		assert.Equal(t, &kubernetes.UpgradeState{
+			ClusterID:   clusterID,
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.1",
+		}, resolved)
+		assert.Contains(t, log.String(), `WARNING: discarding the unfinished upgrade 1.25.2 -> 1.26.0 recorded in "state.yaml"`)
+	})
+
+	t.Run("ReplaceOtherCluster", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.0",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(recorded("1.26.0", false), "other", "state.yaml", &options)
+
+		assert.Equal(t, &kubernetes.UpgradeState{
+			ClusterID:   "other",
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.0",
+		}, resolved)
+		assert.Contains(t, log.String(), "WARNING: discarding the unfinished upgrade")
+	})
+
+	t.Run("New", func(t *testing.T) {
+		t.Parallel()
+
+		var log bytes.Buffer
+
+		options := kubernetes.UpgradeOptions{
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.0",
+			LogOutput:   &log,
+		}
+
+		resolved := kubernetes.ResolveState(&kubernetes.UpgradeState{}, clusterID, "state.yaml", &options)
+
+		assert.Equal(t, &kubernetes.UpgradeState{
+			ClusterID:   clusterID,
+			FromVersion: "1.25.3",
+			ToVersion:   "1.26.0",
+		}, resolved)
+		assert.Empty(t, log.String())
+	})
+}
+
+func TestRunUpgradeStep(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		components []string
+		completed  bool
+		dryRun     bool
+		stepErr    error
+
+		expectedRun       bool
+		expectedCompleted bool
+		expectedLog       string
+	}{
+		{
+			name:              "run",
+			expectedRun:       true,
+			expectedCompleted: true,
+		},
+		{
+			name:              "selected",
+			components:        []string{kubernetes.ComponentScheduler, kubernetes.ComponentAPIServer},
+			expectedRun:       true,
+			expectedCompleted: true,
+		},
+		{
+			name:        "not selected",
+			components:  []string{kubernetes.ComponentScheduler},
+			expectedLog: "skipped updating apiserver",
+		},
+		{
+			name:              "already completed",
+			completed:         true,
+			expectedCompleted: true,
+			expectedLog:       "skipped updating apiserver: already updated",
+		},
+		{
+			name:        "dry run",
+			dryRun:      true,
+			expectedRun: true,
+		},
+		{
+			name:        "failed",
+			stepErr:     errors.New("failed"),
+			expectedRun: true,
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var log bytes.Buffer
+
+			statePath := filepath.Join(t.TempDir(), "state.yaml")
+
+			state := &kubernetes.UpgradeState{ClusterID: "cluster"}
+			state.Component(kubernetes.ComponentAPIServer).Completed = test.completed
+
+			run := false
+
+			err := kubernetes.RunUpgradeStep(kubernetes.UpgradeOptions{
+				Components: test.components,
+				DryRun:     test.dryRun,
+				LogOutput:  &log,
+			}, state, statePath, kubernetes.ComponentAPIServer, func() error {
+				run = true
+
+				return test.stepErr
+			})
+
+			if test.stepErr != nil {
+				assert.ErrorIs(t, err, test.stepErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedRun, run)
+			assert.Equal(t, test.expectedCompleted, state.Component(kubernetes.ComponentAPIServer).Completed)
+			assert.Equal(t, test.expectedLog, log.String())
+
+			// the state is persisted only when the step completes
+			saved, err := kubernetes.LoadUpgradeState(statePath)
+			require.NoError(t, err)
+
+			if test.expectedRun && test.expectedCompleted {
+				assert.Equal(t, state, saved)
+			} else {
+				assert.Equal(t, &kubernetes.UpgradeState{}, saved)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package kubernetes
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/coreos/go-semver/semver"
 	appsv1 "k8s.io/api/apps/v1"
@@ -21,6 +22,34 @@ const (
 	kubeProxy             = "kube-proxy"
 )
 
+// Components of the Kubernetes upgrade which can be selected with UpgradeOptions.Components.
+const (
+	ComponentAPIServer         = "apiserver"
+	ComponentControllerManager = "controller-manager"
+	ComponentScheduler         = "scheduler"
+	ComponentKubeProxy         = "kube-proxy"
+	ComponentKubelet           = "kubelet"
+	ComponentManifests         = "manifests"
+)
+
+// Components lists all the upgrade components in the order of the upgrade.
+var Components = []string{
+	ComponentAPIServer,
+	ComponentControllerManager,
+	ComponentScheduler,
+	ComponentKubeProxy,
+	ComponentKubelet,
+	ComponentManifests,
+}
+
+// rollbackComponents lists the components which can be rolled back in the order of the rollback.
+var rollbackComponents = []string{
+	ComponentKubelet,
+	ComponentScheduler,
+	ComponentControllerManager,
+	ComponentAPIServer,
+}
+
 // UpgradeOptions represents Kubernetes control plane upgrade settings.
 type UpgradeOptions struct {
 	FromVersion string
@@ -31,9 +60,19 @@ type UpgradeOptions struct {
 	UpgradeKubelet       bool
 	DryRun               bool
 
+	// Components limits the upgrade (or rollback) to the listed components, all components are upgraded if empty.
+	Components []string
+	// StateDir is the directory to persist the upgrade state to, the state is not persisted if empty.
+	//
+	// The state of each cluster is kept in a separate file, see UpgradeStatePath.
+	StateDir string
+
 	extraUpdaters     []daemonsetUpdater
 	controlPlaneNodes []string
 	workerNodes       []string
+	state             *UpgradeState
+	statePath         string
+	rollback          bool
 }
 
 // Path returns upgrade path in a form "FromMajor.FromMinor->ToMajor.ToMinor" (e.g. "1.20->1.21"),
@@ -49,6 +88,31 @@ func (options *UpgradeOptions) Path() string {
 	return fmt.Sprintf("%d.%d->%d.%d", from.Major, from.Minor, to.Major, to.Minor)
 }
 
+// Validate checks the upgrade options.
+func (options *UpgradeOptions) Validate() error {
+	for _, component := range options.Components {
+		if !containsString(Components, component) {
+			return fmt.Errorf("unknown component %q, supported components: %s", component, strings.Join(Components, ", "))
+		}
+	}
+
+	return nil
+}
+
+// componentSelected returns true if the component should be upgraded.
+func (options *UpgradeOptions) componentSelected(component string) bool {
+	return len(options.Components) == 0 || containsString(options.Components, component)
+}
+
+// saveState persists the upgrade state, if the state path is set.
+func (options *UpgradeOptions) saveState() error {
+	if options.statePath == "" || options.DryRun {
+		return nil
+	}
+
+	return options.state.Save(options.statePath)
+}
+
 // Log writes the line to logger or to stdout if no logger was provided.
 func (options *UpgradeOptions) Log(line string, args ...interface{}) {
 	if options.LogOutput != nil {
@@ -61,3 +125,13 @@ func (options *UpgradeOptions) Log(line string, args ...interface{}) {
 }
 
 type daemonsetUpdater func(ds string, daemonset *appsv1.DaemonSet) error
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
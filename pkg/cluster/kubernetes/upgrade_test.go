@@ -37,3 +37,17 @@ func TestPath(t *testing.T) {
 		assert.Equal(t, "", options.Path())
 	})
 }
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	options := &kubernetes.UpgradeOptions{
+		Components: []string{kubernetes.ComponentAPIServer, kubernetes.ComponentScheduler},
+	}
+
+	assert.NoError(t, options.Validate())
+
+	options.Components = append(options.Components, "etcd")
+
+	assert.EqualError(t, options.Validate(), `unknown component "etcd", supported components: apiserver, controller-manager, scheduler, kube-proxy, kubelet, manifests`)
+}
@@ -34,6 +34,8 @@ type MachineServiceClient interface {
 	// If recover_etcd argument is specified, etcd is recovered from a snapshot
 	// uploaded with EtcdRecover.
 	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	// ConfirmConfiguration confirms the configuration applied in 'try' mode, cancelling the rollback.
+	ConfirmConfiguration(ctx context.Context, in *ConfirmConfigurationRequest, opts ...grpc.CallOption) (*ConfirmConfigurationResponse, error)
 	Containers(ctx context.Context, in *ContainersRequest, opts ...grpc.CallOption) (*ContainersResponse, error)
 	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (MachineService_CopyClient, error)
 	CPUInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CPUInfoResponse, error)
@@ -54,6 +56,25 @@ type MachineServiceClient interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(ctx context.Context, in *EtcdSnapshotRequest, opts ...grpc.CallOption) (MachineService_EtcdSnapshotClient, error)
+	// EtcdStatus method returns the status of the local etcd member.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(ctx context.Context, in *EtcdStatusRequest, opts ...grpc.CallOption) (*EtcdStatusResponse, error)
+	// EtcdDefragment method defragments the database of the local etcd member.
+	//
+	// Defragmentation blocks reads and writes on the member while it runs,
+	// so it should be done one member at a time.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(ctx context.Context, in *EtcdDefragmentRequest, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error)
+	// EtcdAlarmList method lists the alarms raised in the etcd cluster.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(ctx context.Context, in *EtcdAlarmListRequest, opts ...grpc.CallOption) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm method disarms the alarms raised in the etcd cluster.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(ctx context.Context, in *EtcdAlarmDisarmRequest, opts ...grpc.CallOption) (*EtcdAlarmDisarmResponse, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
@@ -83,6 +104,16 @@ type MachineServiceClient interface {
 	GenerateClientConfiguration(ctx context.Context, in *GenerateClientConfigurationRequest, opts ...grpc.CallOption) (*GenerateClientConfigurationResponse, error)
 	// PacketCapture performs packet capture and streams back pcap file.
 	PacketCapture(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (MachineService_PacketCaptureClient, error)
+	// Netstat provides information about network connections.
+	Netstat(ctx context.Context, in *NetstatRequest, opts ...grpc.CallOption) (*NetstatResponse, error)
+	// ProbePing sends ICMP echo requests from the node and streams back the replies.
+	ProbePing(ctx context.Context, in *ProbePingRequest, opts ...grpc.CallOption) (MachineService_ProbePingClient, error)
+	// ProbeTCP connects to the TCP endpoint (optionally performing TLS handshake) from the node and streams back the results.
+	ProbeTCP(ctx context.Context, in *ProbeTCPRequest, opts ...grpc.CallOption) (MachineService_ProbeTCPClient, error)
+	// ProbeDNS resolves the name via each of the node's DNS servers and streams back the results.
+	ProbeDNS(ctx context.Context, in *ProbeDNSRequest, opts ...grpc.CallOption) (MachineService_ProbeDNSClient, error)
+	// ProbeTraceroute traces the route to the host from the node and streams back the hops.
+	ProbeTraceroute(ctx context.Context, in *ProbeTracerouteRequest, opts ...grpc.CallOption) (MachineService_ProbeTracerouteClient, error)
 }
 
 type machineServiceClient struct {
@@ -111,6 +142,15 @@ func (c *machineServiceClient) Bootstrap(ctx context.Context, in *BootstrapReque
 	return out, nil
 }
 
+func (c *machineServiceClient) ConfirmConfiguration(ctx context.Context, in *ConfirmConfigurationRequest, opts ...grpc.CallOption) (*ConfirmConfigurationResponse, error) {
+	out := new(ConfirmConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/ConfirmConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Containers(ctx context.Context, in *ContainersRequest, opts ...grpc.CallOption) (*ContainersResponse, error) {
 	out := new(ContainersResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/Containers", in, out, opts...)
@@ -336,6 +376,42 @@ func (x *machineServiceEtcdSnapshotClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) EtcdStatus(ctx context.Context, in *EtcdStatusRequest, opts ...grpc.CallOption) (*EtcdStatusResponse, error) {
+	out := new(EtcdStatusResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdDefragment(ctx context.Context, in *EtcdDefragmentRequest, opts ...grpc.CallOption) (*EtcdDefragmentResponse, error) {
+	out := new(EtcdDefragmentResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdDefragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdAlarmList(ctx context.Context, in *EtcdAlarmListRequest, opts ...grpc.CallOption) (*EtcdAlarmListResponse, error) {
+	out := new(EtcdAlarmListResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdAlarmList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) EtcdAlarmDisarm(ctx context.Context, in *EtcdAlarmDisarmRequest, opts ...grpc.CallOption) (*EtcdAlarmDisarmResponse, error) {
+	out := new(EtcdAlarmDisarmResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/EtcdAlarmDisarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error) {
 	out := new(GenerateConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/GenerateConfiguration", in, out, opts...)
@@ -717,6 +793,143 @@ func (x *machineServicePacketCaptureClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) Netstat(ctx context.Context, in *NetstatRequest, opts ...grpc.CallOption) (*NetstatResponse, error) {
+	out := new(NetstatResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/Netstat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ProbePing(ctx context.Context, in *ProbePingRequest, opts ...grpc.CallOption) (MachineService_ProbePingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[11], "/machine.MachineService/ProbePing", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProbePingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ProbePingClient interface {
+	Recv() (*ProbeResult, error)
+	grpc.ClientStream
+}
+
+type machineServiceProbePingClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceProbePingClient) Recv() (*ProbeResult, error) {
+	m := new(ProbeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ProbeTCP(ctx context.Context, in *ProbeTCPRequest, opts ...grpc.CallOption) (MachineService_ProbeTCPClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], "/machine.MachineService/ProbeTCP", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProbeTCPClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ProbeTCPClient interface {
+	Recv() (*ProbeResult, error)
+	grpc.ClientStream
+}
+
+type machineServiceProbeTCPClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceProbeTCPClient) Recv() (*ProbeResult, error) {
+	m := new(ProbeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ProbeDNS(ctx context.Context, in *ProbeDNSRequest, opts ...grpc.CallOption) (MachineService_ProbeDNSClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[13], "/machine.MachineService/ProbeDNS", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProbeDNSClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ProbeDNSClient interface {
+	Recv() (*ProbeResult, error)
+	grpc.ClientStream
+}
+
+type machineServiceProbeDNSClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceProbeDNSClient) Recv() (*ProbeResult, error) {
+	m := new(ProbeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ProbeTraceroute(ctx context.Context, in *ProbeTracerouteRequest, opts ...grpc.CallOption) (MachineService_ProbeTracerouteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[14], "/machine.MachineService/ProbeTraceroute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProbeTracerouteClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ProbeTracerouteClient interface {
+	Recv() (*ProbeResult, error)
+	grpc.ClientStream
+}
+
+type machineServiceProbeTracerouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceProbeTracerouteClient) Recv() (*ProbeResult, error) {
+	m := new(ProbeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility
@@ -729,6 +942,8 @@ type MachineServiceServer interface {
 	// If recover_etcd argument is specified, etcd is recovered from a snapshot
 	// uploaded with EtcdRecover.
 	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	// ConfirmConfiguration confirms the configuration applied in 'try' mode, cancelling the rollback.
+	ConfirmConfiguration(context.Context, *ConfirmConfigurationRequest) (*ConfirmConfigurationResponse, error)
 	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
 	Copy(*CopyRequest, MachineService_CopyServer) error
 	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
@@ -749,6 +964,25 @@ type MachineServiceServer interface {
 	//
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
+	// EtcdStatus method returns the status of the local etcd member.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(context.Context, *EtcdStatusRequest) (*EtcdStatusResponse, error)
+	// EtcdDefragment method defragments the database of the local etcd member.
+	//
+	// Defragmentation blocks reads and writes on the member while it runs,
+	// so it should be done one member at a time.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(context.Context, *EtcdDefragmentRequest) (*EtcdDefragmentResponse, error)
+	// EtcdAlarmList method lists the alarms raised in the etcd cluster.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(context.Context, *EtcdAlarmListRequest) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm method disarms the alarms raised in the etcd cluster.
+	//
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(context.Context, *EtcdAlarmDisarmRequest) (*EtcdAlarmDisarmResponse, error)
 	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
 	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
 	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
@@ -778,6 +1012,16 @@ type MachineServiceServer interface {
 	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
 	// PacketCapture performs packet capture and streams back pcap file.
 	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
+	// Netstat provides information about network connections.
+	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
+	// ProbePing sends ICMP echo requests from the node and streams back the replies.
+	ProbePing(*ProbePingRequest, MachineService_ProbePingServer) error
+	// ProbeTCP connects to the TCP endpoint (optionally performing TLS handshake) from the node and streams back the results.
+	ProbeTCP(*ProbeTCPRequest, MachineService_ProbeTCPServer) error
+	// ProbeDNS resolves the name via each of the node's DNS servers and streams back the results.
+	ProbeDNS(*ProbeDNSRequest, MachineService_ProbeDNSServer) error
+	// ProbeTraceroute traces the route to the host from the node and streams back the hops.
+	ProbeTraceroute(*ProbeTracerouteRequest, MachineService_ProbeTracerouteServer) error
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -791,6 +1035,9 @@ func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *Ap
 func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
 }
+func (UnimplementedMachineServiceServer) ConfirmConfiguration(context.Context, *ConfirmConfigurationRequest) (*ConfirmConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmConfiguration not implemented")
+}
 func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
 }
@@ -827,6 +1074,18 @@ func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverS
 func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
 	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
 }
+func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *EtcdStatusRequest) (*EtcdStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *EtcdDefragmentRequest) (*EtcdDefragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *EtcdAlarmListRequest) (*EtcdAlarmListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *EtcdAlarmDisarmRequest) (*EtcdAlarmDisarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+}
 func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
 }
@@ -908,6 +1167,21 @@ func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Con
 func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
 	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
 }
+func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+}
+func (UnimplementedMachineServiceServer) ProbePing(*ProbePingRequest, MachineService_ProbePingServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbePing not implemented")
+}
+func (UnimplementedMachineServiceServer) ProbeTCP(*ProbeTCPRequest, MachineService_ProbeTCPServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbeTCP not implemented")
+}
+func (UnimplementedMachineServiceServer) ProbeDNS(*ProbeDNSRequest, MachineService_ProbeDNSServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbeDNS not implemented")
+}
+func (UnimplementedMachineServiceServer) ProbeTraceroute(*ProbeTracerouteRequest, MachineService_ProbeTracerouteServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbeTraceroute not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
 // UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -957,6 +1231,24 @@ func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ConfirmConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ConfirmConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/ConfirmConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ConfirmConfiguration(ctx, req.(*ConfirmConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainersRequest)
 	if err := dec(in); err != nil {
@@ -1193,6 +1485,78 @@ func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*EtcdStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdDefragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdDefragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*EtcdDefragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdAlarmListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdAlarmList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*EtcdAlarmListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdAlarmDisarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/EtcdAlarmDisarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*EtcdAlarmDisarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -1697,6 +2061,108 @@ func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetstatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Netstat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/Netstat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ProbePing_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbePingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ProbePing(m, &machineServiceProbePingServer{stream})
+}
+
+type MachineService_ProbePingServer interface {
+	Send(*ProbeResult) error
+	grpc.ServerStream
+}
+
+type machineServiceProbePingServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceProbePingServer) Send(m *ProbeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ProbeTCP_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbeTCPRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ProbeTCP(m, &machineServiceProbeTCPServer{stream})
+}
+
+type MachineService_ProbeTCPServer interface {
+	Send(*ProbeResult) error
+	grpc.ServerStream
+}
+
+type machineServiceProbeTCPServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceProbeTCPServer) Send(m *ProbeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ProbeDNS_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbeDNSRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ProbeDNS(m, &machineServiceProbeDNSServer{stream})
+}
+
+type MachineService_ProbeDNSServer interface {
+	Send(*ProbeResult) error
+	grpc.ServerStream
+}
+
+type machineServiceProbeDNSServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceProbeDNSServer) Send(m *ProbeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ProbeTraceroute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbeTracerouteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ProbeTraceroute(m, &machineServiceProbeTracerouteServer{stream})
+}
+
+type MachineService_ProbeTracerouteServer interface {
+	Send(*ProbeResult) error
+	grpc.ServerStream
+}
+
+type machineServiceProbeTracerouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceProbeTracerouteServer) Send(m *ProbeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1712,6 +2178,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Bootstrap",
 			Handler:    _MachineService_Bootstrap_Handler,
 		},
+		{
+			MethodName: "ConfirmConfiguration",
+			Handler:    _MachineService_ConfirmConfiguration_Handler,
+		},
 		{
 			MethodName: "Containers",
 			Handler:    _MachineService_Containers_Handler,
@@ -1740,6 +2210,22 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EtcdForfeitLeadership",
 			Handler:    _MachineService_EtcdForfeitLeadership_Handler,
 		},
+		{
+			MethodName: "EtcdStatus",
+			Handler:    _MachineService_EtcdStatus_Handler,
+		},
+		{
+			MethodName: "EtcdDefragment",
+			Handler:    _MachineService_EtcdDefragment_Handler,
+		},
+		{
+			MethodName: "EtcdAlarmList",
+			Handler:    _MachineService_EtcdAlarmList_Handler,
+		},
+		{
+			MethodName: "EtcdAlarmDisarm",
+			Handler:    _MachineService_EtcdAlarmDisarm_Handler,
+		},
 		{
 			MethodName: "GenerateConfiguration",
 			Handler:    _MachineService_GenerateConfiguration_Handler,
@@ -1824,6 +2310,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateClientConfiguration",
 			Handler:    _MachineService_GenerateClientConfiguration_Handler,
 		},
+		{
+			MethodName: "Netstat",
+			Handler:    _MachineService_Netstat_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1881,6 +2371,26 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_PacketCapture_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProbePing",
+			Handler:       _MachineService_ProbePing_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProbeTCP",
+			Handler:       _MachineService_ProbeTCP_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProbeDNS",
+			Handler:       _MachineService_ProbeDNS_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProbeTraceroute",
+			Handler:       _MachineService_ProbeTraceroute_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
@@ -1490,6 +1490,168 @@ func (NethelpersVLANProtocol) EnumDescriptor() ([]byte, []int) {
 	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{21}
 }
 
+// NethelpersProtocol is a inet protocol.
+type NethelpersProtocol int32
+
+const (
+	NethelpersProtocol_PROTOCOL_UNSPECIFIED NethelpersProtocol = 0
+	NethelpersProtocol_PROTOCOL_ICMP        NethelpersProtocol = 1
+	NethelpersProtocol_PROTOCOL_TCP         NethelpersProtocol = 6
+	NethelpersProtocol_PROTOCOL_UDP         NethelpersProtocol = 17
+	NethelpersProtocol_PROTOCOL_ICMPV6      NethelpersProtocol = 58
+)
+
+// Enum value maps for NethelpersProtocol.
+var (
+	NethelpersProtocol_name = map[int32]string{
+		0:  "PROTOCOL_UNSPECIFIED",
+		1:  "PROTOCOL_ICMP",
+		6:  "PROTOCOL_TCP",
+		17: "PROTOCOL_UDP",
+		58: "PROTOCOL_ICMPV6",
+	}
+	NethelpersProtocol_value = map[string]int32{
+		"PROTOCOL_UNSPECIFIED": 0,
+		"PROTOCOL_ICMP":        1,
+		"PROTOCOL_TCP":         6,
+		"PROTOCOL_UDP":         17,
+		"PROTOCOL_ICMPV6":      58,
+	}
+)
+
+func (x NethelpersProtocol) Enum() *NethelpersProtocol {
+	p := new(NethelpersProtocol)
+	*p = x
+	return p
+}
+
+func (x NethelpersProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
+}
+
+func (NethelpersProtocol) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
+}
+
+func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersProtocol.Descriptor instead.
+func (NethelpersProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
+}
+
+// NethelpersDefaultAction is a default firewall action.
+type NethelpersDefaultAction int32
+
+const (
+	NethelpersDefaultAction_DEFAULT_ACTION_ACCEPT NethelpersDefaultAction = 0
+	NethelpersDefaultAction_DEFAULT_ACTION_BLOCK  NethelpersDefaultAction = 1
+)
+
+// Enum value maps for NethelpersDefaultAction.
+var (
+	NethelpersDefaultAction_name = map[int32]string{
+		0: "DEFAULT_ACTION_ACCEPT",
+		1: "DEFAULT_ACTION_BLOCK",
+	}
+	NethelpersDefaultAction_value = map[string]int32{
+		"DEFAULT_ACTION_ACCEPT": 0,
+		"DEFAULT_ACTION_BLOCK":  1,
+	}
+)
+
+func (x NethelpersDefaultAction) Enum() *NethelpersDefaultAction {
+	p := new(NethelpersDefaultAction)
+	*p = x
+	return p
+}
+
+func (x NethelpersDefaultAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersDefaultAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[23].Descriptor()
+}
+
+func (NethelpersDefaultAction) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[23]
+}
+
+func (x NethelpersDefaultAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersDefaultAction.Descriptor instead.
+func (NethelpersDefaultAction) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
+}
+
+// NethelpersMACVLANMode is a MACVLAN mode.
+type NethelpersMACVLANMode int32
+
+const (
+	NethelpersMACVLANMode_MACVLAN_MODE_UNSPECIFIED NethelpersMACVLANMode = 0
+	NethelpersMACVLANMode_MACVLAN_MODE_PRIVATE     NethelpersMACVLANMode = 1
+	NethelpersMACVLANMode_MACVLAN_MODE_VEPA        NethelpersMACVLANMode = 2
+	NethelpersMACVLANMode_MACVLAN_MODE_BRIDGE      NethelpersMACVLANMode = 4
+	NethelpersMACVLANMode_MACVLAN_MODE_PASSTHRU    NethelpersMACVLANMode = 8
+	NethelpersMACVLANMode_MACVLAN_MODE_SOURCE      NethelpersMACVLANMode = 16
+)
+
+// Enum value maps for NethelpersMACVLANMode.
+var (
+	NethelpersMACVLANMode_name = map[int32]string{
+		0:  "MACVLAN_MODE_UNSPECIFIED",
+		1:  "MACVLAN_MODE_PRIVATE",
+		2:  "MACVLAN_MODE_VEPA",
+		4:  "MACVLAN_MODE_BRIDGE",
+		8:  "MACVLAN_MODE_PASSTHRU",
+		16: "MACVLAN_MODE_SOURCE",
+	}
+	NethelpersMACVLANMode_value = map[string]int32{
+		"MACVLAN_MODE_UNSPECIFIED": 0,
+		"MACVLAN_MODE_PRIVATE":     1,
+		"MACVLAN_MODE_VEPA":        2,
+		"MACVLAN_MODE_BRIDGE":      4,
+		"MACVLAN_MODE_PASSTHRU":    8,
+		"MACVLAN_MODE_SOURCE":      16,
+	}
+)
+
+func (x NethelpersMACVLANMode) Enum() *NethelpersMACVLANMode {
+	p := new(NethelpersMACVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersMACVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersMACVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+}
+
+func (NethelpersMACVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+}
+
+func (x NethelpersMACVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersMACVLANMode.Descriptor instead.
+func (NethelpersMACVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+}
+
 // RuntimeMachineStage describes the stage of the machine boot/run process.
 type RuntimeMachineStage int32
 
@@ -1542,11 +1704,11 @@ func (x RuntimeMachineStage) String() string {
 }
 
 func (RuntimeMachineStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
 }
 
 func (RuntimeMachineStage) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
 }
 
 func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
@@ -1555,7 +1717,60 @@ func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuntimeMachineStage.Descriptor instead.
 func (RuntimeMachineStage) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
+}
+
+// RuntimeConfigTryState describes the state of the configuration applied in 'try' mode.
+type RuntimeConfigTryState int32
+
+const (
+	RuntimeConfigTryState_CONFIG_TRY_STATE_PENDING     RuntimeConfigTryState = 0
+	RuntimeConfigTryState_CONFIG_TRY_STATE_CONFIRMED   RuntimeConfigTryState = 1
+	RuntimeConfigTryState_CONFIG_TRY_STATE_ROLLED_BACK RuntimeConfigTryState = 2
+	RuntimeConfigTryState_CONFIG_TRY_STATE_CANCELLED   RuntimeConfigTryState = 3
+)
+
+// Enum value maps for RuntimeConfigTryState.
+var (
+	RuntimeConfigTryState_name = map[int32]string{
+		0: "CONFIG_TRY_STATE_PENDING",
+		1: "CONFIG_TRY_STATE_CONFIRMED",
+		2: "CONFIG_TRY_STATE_ROLLED_BACK",
+		3: "CONFIG_TRY_STATE_CANCELLED",
+	}
+	RuntimeConfigTryState_value = map[string]int32{
+		"CONFIG_TRY_STATE_PENDING":     0,
+		"CONFIG_TRY_STATE_CONFIRMED":   1,
+		"CONFIG_TRY_STATE_ROLLED_BACK": 2,
+		"CONFIG_TRY_STATE_CANCELLED":   3,
+	}
+)
+
+func (x RuntimeConfigTryState) Enum() *RuntimeConfigTryState {
+	p := new(RuntimeConfigTryState)
+	*p = x
+	return p
+}
+
+func (x RuntimeConfigTryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuntimeConfigTryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
+}
+
+func (RuntimeConfigTryState) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
+}
+
+func (x RuntimeConfigTryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuntimeConfigTryState.Descriptor instead.
+func (RuntimeConfigTryState) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
 }
 
 var File_resource_definitions_enums_enums_proto protoreflect.FileDescriptor
@@ -1826,30 +2041,64 @@ var file_resource_definitions_enums_enums_proto_rawDesc = []byte{
 	0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
 	0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x51, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56,
 	0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31,
-	0x5f, 0x41, 0x44, 0x10, 0x01, 0x2a, 0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
-	0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48,
-	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03,
-	0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47,
-	0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4d,
-	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x42,
-	0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x43, 0x48,
-	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43,
-	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x49, 0x4e,
-	0x47, 0x10, 0x08, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f,
-	0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x41, 0x44, 0x10, 0x01, 0x2a, 0x7a, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x56, 0x36, 0x10,
+	0x3a, 0x2a, 0x4e, 0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x01, 0x2a, 0xb3, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
+	0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d,
+	0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x43,
+	0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x50, 0x41, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41,
+	0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x52, 0x49, 0x44, 0x47,
+	0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x55, 0x10, 0x08, 0x12, 0x17,
+	0x0a, 0x13, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x10, 0x2a, 0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45,
+	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b, 0x0a,
+	0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x4d,
+	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53,
+	0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48,
+	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x08, 0x2a, 0x97, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a,
+	0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12,
+	0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42,
+	0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61,
+	0x6c, 0x6f, 0x73, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f,
+	0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1864,7 +2113,7 @@ func file_resource_definitions_enums_enums_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_enums_enums_proto_rawDescData
 }
 
-var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 23)
+var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 27)
 var file_resource_definitions_enums_enums_proto_goTypes = []interface{}{
 	(MachineType)(0),                  // 0: talos.resource.definitions.enums.MachineType
 	(KubespanPeerState)(0),            // 1: talos.resource.definitions.enums.KubespanPeerState
@@ -1888,7 +2137,11 @@ var file_resource_definitions_enums_enums_proto_goTypes = []interface{}{
 	(NethelpersRoutingTable)(0),       // 19: talos.resource.definitions.enums.NethelpersRoutingTable
 	(NethelpersRouteType)(0),          // 20: talos.resource.definitions.enums.NethelpersRouteType
 	(NethelpersVLANProtocol)(0),       // 21: talos.resource.definitions.enums.NethelpersVLANProtocol
-	(RuntimeMachineStage)(0),          // 22: talos.resource.definitions.enums.RuntimeMachineStage
+	(NethelpersProtocol)(0),           // 22: talos.resource.definitions.enums.NethelpersProtocol
+	(NethelpersDefaultAction)(0),      // 23: talos.resource.definitions.enums.NethelpersDefaultAction
+	(NethelpersMACVLANMode)(0),        // 24: talos.resource.definitions.enums.NethelpersMACVLANMode
+	(RuntimeMachineStage)(0),          // 25: talos.resource.definitions.enums.RuntimeMachineStage
+	(RuntimeConfigTryState)(0),        // 26: talos.resource.definitions.enums.RuntimeConfigTryState
 }
 var file_resource_definitions_enums_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -1908,7 +2161,7 @@ func file_resource_definitions_enums_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_definitions_enums_enums_proto_rawDesc,
-			NumEnums:      23,
+			NumEnums:      27,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -259,6 +259,17 @@ func (c *Client) ApplyConfiguration(ctx context.Context, req *machineapi.ApplyCo
 	return
 }
 
+// ConfirmConfiguration confirms the configuration applied in 'try' mode.
+func (c *Client) ConfirmConfiguration(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ConfirmConfigurationResponse, err error) {
+	resp, err = c.MachineClient.ConfirmConfiguration(ctx, &machineapi.ConfirmConfigurationRequest{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ConfirmConfigurationResponse) //nolint:errcheck
+
+	return
+}
+
 // GenerateConfiguration implements proto.MachineServiceClient interface.
 func (c *Client) GenerateConfiguration(ctx context.Context, req *machineapi.GenerateConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateConfigurationResponse, err error) {
 	resp, err = c.MachineClient.GenerateConfiguration(ctx, req, callOptions...)
@@ -779,6 +790,50 @@ func (c *Client) EtcdSnapshot(ctx context.Context, req *machineapi.EtcdSnapshotR
 	return ReadStream(stream)
 }
 
+// EtcdStatus returns the status of the etcd member.
+func (c *Client) EtcdStatus(ctx context.Context, req *machineapi.EtcdStatusRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdStatusResponse, error) {
+	resp, err := c.MachineClient.EtcdStatus(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdStatusResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdDefragment defragments the database of the etcd member.
+func (c *Client) EtcdDefragment(ctx context.Context, req *machineapi.EtcdDefragmentRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdDefragmentResponse, error) {
+	resp, err := c.MachineClient.EtcdDefragment(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdDefragmentResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdAlarmList lists the alarms raised in the etcd cluster.
+func (c *Client) EtcdAlarmList(ctx context.Context, req *machineapi.EtcdAlarmListRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdAlarmListResponse, error) {
+	resp, err := c.MachineClient.EtcdAlarmList(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmListResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// EtcdAlarmDisarm disarms the alarms raised in the etcd cluster.
+func (c *Client) EtcdAlarmDisarm(ctx context.Context, req *machineapi.EtcdAlarmDisarmRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdAlarmDisarmResponse, error) {
+	resp, err := c.MachineClient.EtcdAlarmDisarm(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.EtcdAlarmDisarmResponse) //nolint:errcheck
+
+	return resp, err
+}
+
 // EtcdRecover uploads etcd snapshot created with EtcdSnapshot to the node.
 func (c *Client) EtcdRecover(ctx context.Context, snapshot io.Reader, callOptions ...grpc.CallOption) (*machineapi.EtcdRecoverResponse, error) {
 	cli, err := c.MachineClient.EtcdRecover(ctx, callOptions...)
@@ -837,6 +892,37 @@ func (c *Client) GenerateClientConfiguration(ctx context.Context, req *machineap
 	return
 }
 
+// Netstat implements the proto.MachineServiceClient interface.
+func (c *Client) Netstat(ctx context.Context, req *machineapi.NetstatRequest, callOptions ...grpc.CallOption) (*machineapi.NetstatResponse, error) {
+	resp, err := c.MachineClient.Netstat(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.NetstatResponse) //nolint:errcheck
+
+	return resp, err
+}
+
+// ProbePing implements the proto.MachineServiceClient interface.
+func (c *Client) ProbePing(ctx context.Context, req *machineapi.ProbePingRequest) (machineapi.MachineService_ProbePingClient, error) {
+	return c.MachineClient.ProbePing(ctx, req)
+}
+
+// ProbeTCP implements the proto.MachineServiceClient interface.
+func (c *Client) ProbeTCP(ctx context.Context, req *machineapi.ProbeTCPRequest) (machineapi.MachineService_ProbeTCPClient, error) {
+	return c.MachineClient.ProbeTCP(ctx, req)
+}
+
+// ProbeDNS implements the proto.MachineServiceClient interface.
+func (c *Client) ProbeDNS(ctx context.Context, req *machineapi.ProbeDNSRequest) (machineapi.MachineService_ProbeDNSClient, error) {
+	return c.MachineClient.ProbeDNS(ctx, req)
+}
+
+// ProbeTraceroute implements the proto.MachineServiceClient interface.
+func (c *Client) ProbeTraceroute(ctx context.Context, req *machineapi.ProbeTracerouteRequest) (machineapi.MachineService_ProbeTracerouteClient, error) {
+	return c.MachineClient.ProbeTraceroute(ctx, req)
+}
+
 // PacketCapture implements the proto.MachineServiceClient interface.
 func (c *Client) PacketCapture(ctx context.Context, req *machineapi.PacketCaptureRequest) (io.ReadCloser, <-chan error, error) {
 	stream, err := c.MachineClient.PacketCapture(ctx, req)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/decoder"
+	_ "github.com/talos-systems/talos/pkg/machinery/config/types/network" // register network config documents
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
@@ -21,7 +22,7 @@ import (
 var ErrNoConfig = errors.New("config not found")
 
 // newConfig initializes and returns a Configurator.
-func newConfig(source []byte) (config config.Provider, err error) {
+func newConfig(source []byte) (config.Provider, error) {
 	dec := decoder.NewDecoder(source)
 
 	manifests, err := dec.Decode()
@@ -29,15 +30,29 @@ func newConfig(source []byte) (config config.Provider, err error) {
 		return nil, err
 	}
 
+	var (
+		talosconfig *v1alpha1.Config
+		documents   []config.Document
+	)
+
 	// Look for the older flat v1alpha1 file first, since we have to handle it in
 	// a special way.
 	for _, manifest := range manifests {
-		if talosconfig, ok := manifest.(*v1alpha1.Config); ok {
-			return v1alpha1.WrapReadonly(talosconfig, source), nil
+		switch m := manifest.(type) {
+		case *v1alpha1.Config:
+			if talosconfig == nil {
+				talosconfig = m
+			}
+		case config.Document:
+			documents = append(documents, m)
 		}
 	}
 
-	return nil, ErrNoConfig
+	if talosconfig == nil {
+		return nil, ErrNoConfig
+	}
+
+	return v1alpha1.WrapReadonly(talosconfig, source, documents...), nil
 }
 
 // NewFromFile will take a filepath and attempt to parse a config file from it.
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configpatcher_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/configpatcher"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+const configWithDocuments = `version: v1alpha1
+machine:
+  network:
+    hostname: hostname1
+---
+kind: NetworkDefaultActionConfig
+version: v1alpha1
+spec:
+  ingress: block
+---
+kind: NetworkRuleConfig
+version: v1alpha1
+spec:
+  name: kubelet
+  portSelector:
+    ports:
+      - 10250
+    protocol: tcp
+  ingress:
+    - subnet: 10.0.0.0/8
+`
+
+const strategicPatchWithDocuments = `machine:
+  network:
+    hostname: hostname2
+---
+kind: NetworkRuleConfig
+version: v1alpha1
+spec:
+  name: kubelet
+  portSelector:
+    ports:
+      - 10250
+    protocol: tcp
+  ingress:
+    - subnet: 192.168.0.0/16
+---
+kind: NetworkRuleConfig
+version: v1alpha1
+spec:
+  name: etcd
+  portSelector:
+    ports:
+      - 2379-2380
+    protocol: tcp
+  ingress:
+    - subnet: 10.0.0.0/8
+`
+
+func ruleSubnets(cfg talosconfig.Provider) map[string][]string {
+	result := map[string][]string{}
+
+	for _, rule := range cfg.NetworkRules().Rules() {
+		result[rule.Name()] = slices.Map(rule.Ingress(), func(ingress talosconfig.NetworkRuleIngress) string { return ingress.Subnet().String() })
+	}
+
+	return result
+}
+
+func assertDocumentsPreserved(t *testing.T, out configpatcher.Output) {
+	t.Helper()
+
+	cfg, err := out.Config()
+	require.NoError(t, err)
+
+	assert.True(t, cfg.NetworkRules().Enabled())
+	assert.Equal(t, nethelpers.DefaultActionBlock, cfg.NetworkRules().DefaultAction())
+	assert.Equal(t, map[string][]string{"kubelet": {"10.0.0.0/8"}}, ruleSubnets(cfg))
+
+	encoded, err := cfg.EncodeString(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	assert.Contains(t, encoded, "kind: NetworkRuleConfig")
+	assert.Contains(t, encoded, "kind: NetworkDefaultActionConfig")
+}
+
+func TestApplyPreservesDocuments(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name  string
+		patch string
+	}{
+		{
+			name: "StrategicMerge",
+			patch: `machine:
+  network:
+    hostname: hostname2
+`,
+		},
+		{
+			name:  "JSON6902",
+			patch: `[{"op": "replace", "path": "/machine/network/hostname", "value": "hostname2"}]`,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			patches, err := configpatcher.LoadPatches([]string{tt.patch})
+			require.NoError(t, err)
+
+			cfg, err := configloader.NewFromBytes([]byte(configWithDocuments))
+			require.NoError(t, err)
+
+			for _, input := range []configpatcher.Input{
+				configpatcher.WithConfig(cfg),
+				configpatcher.WithBytes([]byte(configWithDocuments)),
+			} {
+				out, err := configpatcher.Apply(input, patches)
+				require.NoError(t, err)
+
+				assertDocumentsPreserved(t, out)
+
+				patched, err := out.Config()
+				require.NoError(t, err)
+
+				assert.Equal(t, "hostname2", patched.Machine().Network().Hostname())
+
+				// round-trip through bytes
+				bytes, err := out.Bytes()
+				require.NoError(t, err)
+
+				assertDocumentsPreserved(t, configpatcher.WithBytes(bytes))
+			}
+		})
+	}
+}
+
+func TestStrategicMergeDocuments(t *testing.T) {
+	t.Parallel()
+
+	patches, err := configpatcher.LoadPatches([]string{strategicPatchWithDocuments})
+	require.NoError(t, err)
+
+	out, err := configpatcher.Apply(configpatcher.WithBytes([]byte(configWithDocuments)), patches)
+	require.NoError(t, err)
+
+	cfg, err := out.Config()
+	require.NoError(t, err)
+
+	assert.Equal(t, "hostname2", cfg.Machine().Network().Hostname())
+	assert.Equal(t, nethelpers.DefaultActionBlock, cfg.NetworkRules().DefaultAction())
+	assert.Equal(t, map[string][]string{
+		"kubelet": {"192.168.0.0/16"},
+		"etcd":    {"10.0.0.0/8"},
+	}, ruleSubnets(cfg))
+}
@@ -5,14 +5,54 @@
 package configpatcher
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	ghodssyaml "github.com/ghodss/yaml"
+	"gopkg.in/yaml.v3"
 )
 
 // JSON6902 is responsible for applying a JSON 6902 patch to the bootstrap data.
+//
+// If the machine config is followed by additional documents, the patch is applied to the v1alpha1 config document only,
+// and the additional documents are preserved.
 func JSON6902(talosMachineConfig []byte, patch jsonpatch.Patch) ([]byte, error) {
+	documents, err := splitDocuments(talosMachineConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failure splitting talos machine config into documents: %s", err)
+	}
+
+	if len(documents) < 2 {
+		return json6902(talosMachineConfig, patch)
+	}
+
+	var out []byte
+
+	patched := false
+
+	for i, doc := range documents {
+		if !patched && isMachineConfigDocument(doc) {
+			if doc, err = json6902(doc, patch); err != nil {
+				return nil, err
+			}
+
+			patched = true
+		}
+
+		if i > 0 {
+			out = append(out, "---\n"...)
+		}
+
+		out = append(out, doc...)
+	}
+
+	return out, nil
+}
+
+func json6902(talosMachineConfig []byte, patch jsonpatch.Patch) ([]byte, error) {
 	jsonDecodedData, err := ghodssyaml.YAMLToJSON(talosMachineConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failure converting talos machine config to json: %s", err)
@@ -30,3 +70,42 @@ func JSON6902(talosMachineConfig []byte, patch jsonpatch.Patch) ([]byte, error)
 
 	return talosMachineConfig, nil
 }
+
+// splitDocuments splits multi-document YAML into the documents.
+func splitDocuments(in []byte) ([][]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(in))
+
+	var documents [][]byte
+
+	for {
+		var node yaml.Node
+
+		if err := dec.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents, nil
+			}
+
+			return nil, err
+		}
+
+		doc, err := yaml.Marshal(&node)
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, doc)
+	}
+}
+
+// isMachineConfigDocument returns true for the v1alpha1 machine config document, which (unlike other documents) has no kind.
+func isMachineConfigDocument(doc []byte) bool {
+	var manifest struct {
+		Kind string `yaml:"kind"`
+	}
+
+	if err := yaml.Unmarshal(doc, &manifest); err != nil {
+		return false
+	}
+
+	return manifest.Kind == ""
+}
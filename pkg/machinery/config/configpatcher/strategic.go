@@ -9,6 +9,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/merge"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
 )
 
 // StrategicMergePatch is a strategic merge config patch.
@@ -17,6 +18,9 @@ type StrategicMergePatch struct {
 }
 
 // StrategicMerge performs strategic merge config patching.
+//
+// Additional documents are carried over from the config, documents from the patch
+// replace the config documents with the same identity (kind and name), or get appended.
 func StrategicMerge(cfg config.Provider, patch StrategicMergePatch) (config.Provider, error) {
 	left := cfg.Raw()
 	right := patch.Raw()
@@ -25,10 +29,57 @@ func StrategicMerge(cfg config.Provider, patch StrategicMergePatch) (config.Prov
 		return nil, err
 	}
 
-	result, ok := left.(config.Provider)
+	documents := mergeDocuments(cfg.Documents(), patch.Documents())
+
+	if len(documents) == 0 {
+		result, ok := left.(config.Provider)
+		if !ok {
+			return nil, fmt.Errorf("strategic left is not config.Provider %T", left)
+		}
+
+		return result, nil
+	}
+
+	result, ok := left.(*v1alpha1.Config)
 	if !ok {
-		return nil, fmt.Errorf("strategic left is not config.Provider %T", left)
+		return nil, fmt.Errorf("strategic left is not v1alpha1 config %T", left)
+	}
+
+	return v1alpha1.WithDocuments(result, documents...)
+}
+
+// documentID returns the identity of the document: the kind and the name, if the document is named.
+func documentID(doc config.Document) string {
+	if named, ok := doc.(interface{ Name() string }); ok {
+		return doc.Kind() + "/" + named.Name()
+	}
+
+	return doc.Kind()
+}
+
+func mergeDocuments(documents, patchDocuments []config.Document) []config.Document {
+	if len(patchDocuments) == 0 {
+		return documents
+	}
+
+	result := append([]config.Document(nil), documents...)
+
+	for _, patchDoc := range patchDocuments {
+		replaced := false
+
+		for i, doc := range result {
+			if documentID(doc) == documentID(patchDoc) {
+				result[i] = patchDoc
+				replaced = true
+
+				break
+			}
+		}
+
+		if !replaced {
+			result = append(result, patchDoc)
+		}
 	}
 
-	return result, nil
+	return result
 }
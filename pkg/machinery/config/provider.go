@@ -7,6 +7,7 @@ package config
 import (
 	"crypto/tls"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"time"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 )
 
 // Provider defines the configuration consumption interface.
@@ -26,6 +28,9 @@ type Provider interface {
 	Persist() bool
 	Machine() MachineConfig
 	Cluster() ClusterConfig
+	NetworkRules() NetworkRuleConfig
+	// Documents returns the additional configuration documents supplied next to the v1alpha1 config.
+	Documents() []Document
 
 	// Validate checks configuration and returns warnings and fatal errors (as multierror).
 	Validate(RuntimeMode, ...ValidationOption) ([]string, error)
@@ -64,6 +69,7 @@ type MachineConfig interface {
 	Logging() Logging
 	Kernel() Kernel
 	SeccompProfiles() []SeccompProfile
+	Swap() Swap
 }
 
 // SeccompProfile defines the requirements for a config that pertains to seccomp
@@ -84,6 +90,7 @@ type Disk interface {
 type Partition interface {
 	Size() uint64
 	MountPoint() string
+	Swap() bool
 }
 
 // Env represents a set of environment variables.
@@ -150,6 +157,18 @@ type MachineNetwork interface {
 	ExtraHosts() []ExtraHost
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	RoutingRules() []RoutingRule
+}
+
+// RoutingRule represents a policy routing rule.
+type RoutingRule interface {
+	Priority() uint32
+	Source() string
+	Destination() string
+	IIF() string
+	FwMark() uint32
+	FwMask() uint32
+	Table() uint32
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -165,6 +184,9 @@ type Device interface {
 	Routes() []Route
 	Bond() Bond
 	Bridge() Bridge
+	VXLAN() VXLAN
+	VRF() VRF
+	MACVLAN() MACVLAN
 	Vlans() []Vlan
 	MTU() int
 	DHCP() bool
@@ -174,6 +196,8 @@ type Device interface {
 	VIPConfig() VIPConfig
 	WireguardConfig() WireguardConfig
 	Selector() NetworkDeviceSelector
+	Ethtool() Ethtool
+	RouterAdvertisement() RouterAdvertisement
 }
 
 // DHCPOptions represents a set of DHCP options.
@@ -182,6 +206,30 @@ type DHCPOptions interface {
 	IPv4() bool
 	IPv6() bool
 	DUIDv6() string
+	Hostname() string
+	VendorClass() string
+	ClientIdentifier() string
+	UserClasses() []string
+	RequestOptions() []int
+	PrefixDelegation() PrefixDelegation
+}
+
+// PrefixDelegation represents DHCPv6 prefix delegation settings.
+type PrefixDelegation interface {
+	PrefixLength() int
+	Subnets() []PrefixDelegationSubnet
+}
+
+// PrefixDelegationSubnet represents a subnet assigned from the delegated prefix.
+type PrefixDelegationSubnet interface {
+	Interface() string
+	ID() uint32
+}
+
+// RouterAdvertisement represents IPv6 router advertisement settings.
+type RouterAdvertisement interface {
+	Accept() bool
+	SLAAC() bool
 }
 
 // VIPConfig contains settings for the Virtual (shared) IP setup.
@@ -189,6 +237,8 @@ type VIPConfig interface {
 	IP() string
 	EquinixMetal() VIPEquinixMetal
 	HCloud() VIPHCloud
+	BGP() VIPBGP
+	KubernetesLease() VIPKubernetesLease
 }
 
 // VIPEquinixMetal contains Equinix Metal API VIP settings.
@@ -201,6 +251,27 @@ type VIPHCloud interface {
 	APIToken() string
 }
 
+// VIPBGP contains settings to announce VIP via BGP.
+type VIPBGP interface {
+	LocalASN() uint32
+	RouterID() string
+	HoldTime() time.Duration
+	Peers() []VIPBGPPeer
+}
+
+// VIPBGPPeer describes the BGP peer.
+type VIPBGPPeer interface {
+	Address() string
+	ASN() uint32
+	Port() uint16
+}
+
+// VIPKubernetesLease contains settings for the VIP leader election via Kubernetes Lease.
+type VIPKubernetesLease interface {
+	Namespace() string
+	ServiceAccountToken() string
+}
+
 // WireguardConfig contains settings for configuring Wireguard network interface.
 type WireguardConfig interface {
 	PrivateKey() string
@@ -260,6 +331,40 @@ type Bridge interface {
 	STP() STP
 }
 
+// VXLAN contains the options for configuring a VXLAN interface.
+type VXLAN interface {
+	VNI() uint32
+	Link() string
+	Local() string
+	Remote() string
+	Port() uint16
+	TTL() uint8
+}
+
+// VRF contains the options for configuring a VRF interface.
+type VRF interface {
+	Table() uint32
+	Interfaces() []string
+}
+
+// MACVLAN contains the options for configuring a MACVLAN interface.
+type MACVLAN interface {
+	Link() string
+	Mode() string
+}
+
+// Ethtool contains the ethtool settings of the link.
+type Ethtool interface {
+	RXRingSize() uint32
+	TXRingSize() uint32
+	RXChannels() uint32
+	TXChannels() uint32
+	CombinedChannels() uint32
+	Features() map[string]bool
+	Speed() uint32
+	Duplex() string
+}
+
 // Vlan represents vlan settings for a device.
 type Vlan interface {
 	Addresses() []string
@@ -278,6 +383,7 @@ type Route interface {
 	Source() string
 	Metric() uint32
 	MTU() uint32
+	Table() uint32
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -311,6 +417,7 @@ type Kubelet interface {
 	ExtraArgs() map[string]string
 	ExtraMounts() []specs.Mount
 	ExtraConfig() map[string]interface{}
+	CredentialProviderConfig() map[string]interface{}
 	DefaultRuntimeSeccompProfileEnabled() bool
 	RegisterWithFQDN() bool
 	NodeIP() KubeletNodeIP
@@ -357,6 +464,11 @@ type RegistryTLSConfig interface {
 	GetTLSConfig() (*tls.Config, error)
 }
 
+// LoggingSpool describes logging destination on-disk spool configuration.
+type LoggingSpool interface {
+	MaxSize() uint64
+}
+
 // ClusterConfig defines the requirements for a config that pertains to cluster
 // related options.
 type ClusterConfig interface {
@@ -467,6 +579,41 @@ type Etcd interface {
 	ExtraArgs() map[string]string
 	AdvertisedSubnets() []string
 	ListenSubnets() []string
+	Backup() EtcdBackup
+	Defragmentation() EtcdDefragmentation
+	Role() string
+	ExternalEndpoints() []string
+}
+
+// EtcdBackup defines the requirements for a config that pertains to etcd scheduled snapshots.
+type EtcdBackup interface {
+	Enabled() bool
+	Interval() time.Duration
+	RetentionCount() int
+	RetentionMaxAge() time.Duration
+	Uploader() string
+	EncryptionKey() []byte
+	S3() EtcdBackupS3
+}
+
+// EtcdBackupS3 defines the S3-compatible storage for etcd snapshots.
+type EtcdBackupS3 interface {
+	Enabled() bool
+	Endpoint() string
+	Region() string
+	Bucket() string
+	Prefix() string
+	AccessKeyID() string
+	SecretAccessKey() string
+	ForcePathStyle() bool
+}
+
+// EtcdDefragmentation defines the requirements for a config that pertains to etcd automatic defragmentation.
+type EtcdDefragmentation interface {
+	Enabled() bool
+	Interval() time.Duration
+	Threshold() int
+	MinDBSize() uint64
 }
 
 // Token defines the requirements for a config that pertains to Kubernetes
@@ -501,6 +648,8 @@ type AdminKubeconfig interface {
 type EncryptionKey interface {
 	Static() EncryptionKeyStatic
 	NodeID() EncryptionKeyNodeID
+	TPM() EncryptionKeyTPM
+	KMS() EncryptionKeyKMS
 	Slot() int
 }
 
@@ -512,6 +661,19 @@ type EncryptionKeyStatic interface {
 // EncryptionKeyNodeID deterministically generated encryption key.
 type EncryptionKeyNodeID interface{}
 
+// EncryptionKeyTPM random encryption key sealed to the TPM.
+type EncryptionKeyTPM interface {
+	PCRs() []int
+}
+
+// EncryptionKeyKMS random encryption key sealed by the key management server.
+type EncryptionKeyKMS interface {
+	Endpoint() string
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
+	GetTLSConfig() (*tls.Config, error)
+}
+
 // Encryption defines settings for the partition encryption.
 type Encryption interface {
 	Kind() string
@@ -533,6 +695,12 @@ type Features interface {
 	StableHostnameEnabled() bool
 	KubernetesTalosAPIAccess() KubernetesTalosAPIAccess
 	ApidCheckExtKeyUsageEnabled() bool
+	HostDNS() HostDNS
+}
+
+// HostDNS describes the host DNS caching resolver configuration.
+type HostDNS interface {
+	Enabled() bool
 }
 
 // KubernetesTalosAPIAccess describes the Kubernetes Talos API access features.
@@ -593,6 +761,16 @@ type Logging interface {
 type LoggingDestination interface {
 	Endpoint() *url.URL
 	Format() string
+	TLS() LoggingTLS
+	Spool() LoggingSpool
+}
+
+// LoggingTLS describes logging destination TLS configuration.
+type LoggingTLS interface {
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
+	InsecureSkipVerify() bool
+	GetTLSConfig() (*tls.Config, error)
 }
 
 // Kernel describes Talos Linux kernel configuration.
@@ -600,8 +778,66 @@ type Kernel interface {
 	Modules() []KernelModule
 }
 
+// Swap describes swap configuration.
+type Swap interface {
+	// Enabled is true if any swap is configured.
+	Enabled() bool
+	Zram() Zram
+	Devices() []SwapDevice
+	KubeletSwapBehavior() string
+}
+
+// Zram describes zram swap device.
+type Zram interface {
+	Enabled() bool
+	Size() uint64
+	Algorithm() string
+	Priority() int
+}
+
+// SwapDevice describes swap partition.
+type SwapDevice interface {
+	Device() string
+	// Priority returns swap priority, -1 for kernel default.
+	Priority() int
+}
+
 // KernelModule describes Linux module to load.
 type KernelModule interface {
 	Name() string
 	Parameters() []string
 }
+
+// Document defines an additional configuration document which is supplied next to the v1alpha1 machine config.
+type Document interface {
+	Kind() string
+	Version() string
+	Validate(RuntimeMode, ...ValidationOption) ([]string, error)
+}
+
+// NetworkRuleConfig defines the host firewall configuration.
+type NetworkRuleConfig interface {
+	// Enabled is true if the host firewall is configured.
+	Enabled() bool
+	DefaultAction() nethelpers.DefaultAction
+	Rules() []NetworkRule
+}
+
+// NetworkDefaultAction defines the document which sets the default host firewall action.
+type NetworkDefaultAction interface {
+	DefaultAction() nethelpers.DefaultAction
+}
+
+// NetworkRule defines a single host firewall ingress rule.
+type NetworkRule interface {
+	Name() string
+	Protocol() nethelpers.Protocol
+	PortRanges() [][2]uint16
+	Ingress() []NetworkRuleIngress
+}
+
+// NetworkRuleIngress defines the source subnet of the host firewall ingress rule.
+type NetworkRuleIngress interface {
+	Subnet() netip.Prefix
+	Except() netip.Prefix
+}
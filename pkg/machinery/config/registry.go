@@ -54,7 +54,9 @@ func (r *Registry) new(kind, version string) (interface{}, error) {
 
 	f, ok := r.registered[kind]
 	if ok {
-		return f(version), nil
+		if target := f(version); target != nil {
+			return target, nil
+		}
 	}
 
 	return nil, fmt.Errorf("%q %q: %w", kind, version, ErrNotRegistered)
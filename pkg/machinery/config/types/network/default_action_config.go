@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// DefaultActionConfigKind is a config document kind.
+const DefaultActionConfigKind = "NetworkDefaultActionConfig"
+
+// Check interfaces.
+var (
+	_ config.Document             = &DefaultActionConfigV1Alpha1{}
+	_ config.NetworkDefaultAction = &DefaultActionConfigV1Alpha1{}
+)
+
+// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+//
+// Example:
+//
+//	kind: NetworkDefaultActionConfig
+//	version: v1alpha1
+//	spec:
+//	  ingress: block
+type DefaultActionConfigV1Alpha1 struct {
+	// Default action for all not explicitly configured ingress traffic: accept or block.
+	Ingress nethelpers.DefaultAction `yaml:"ingress"`
+}
+
+// NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
+func NewDefaultActionConfigV1Alpha1() *DefaultActionConfigV1Alpha1 {
+	return &DefaultActionConfigV1Alpha1{}
+}
+
+// Kind implements config.Document interface.
+func (s *DefaultActionConfigV1Alpha1) Kind() string {
+	return DefaultActionConfigKind
+}
+
+// Version implements config.Document interface.
+func (s *DefaultActionConfigV1Alpha1) Version() string {
+	return Version
+}
+
+// Validate implements config.Document interface.
+func (s *DefaultActionConfigV1Alpha1) Validate(config.RuntimeMode, ...config.ValidationOption) ([]string, error) {
+	return nil, nil
+}
+
+// DefaultAction implements config.NetworkDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) DefaultAction() nethelpers.DefaultAction {
+	return s.Ingress
+}
@@ -0,0 +1,31 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package network provides network machine configuration documents.
+package network
+
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+// Version is the version string for network documents.
+const Version = "v1alpha1"
+
+func init() {
+	config.Register(RuleConfigKind, func(version string) interface{} {
+		if version != Version {
+			return nil
+		}
+
+		return &RuleConfigV1Alpha1{}
+	})
+
+	config.Register(DefaultActionConfigKind, func(version string) interface{} {
+		if version != Version {
+			return nil
+		}
+
+		return &DefaultActionConfigV1Alpha1{}
+	})
+}
@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/slices"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// RuleConfigKind is a config document kind.
+const RuleConfigKind = "NetworkRuleConfig"
+
+// Check interfaces.
+var (
+	_ config.Document    = &RuleConfigV1Alpha1{}
+	_ config.NetworkRule = &RuleConfigV1Alpha1{}
+)
+
+// RuleConfigV1Alpha1 is a network firewall rule config document.
+//
+// The rule allows ingress traffic to the selected ports only from the listed subnets,
+// traffic to the selected ports from other sources is dropped.
+//
+// Example:
+//
+//	kind: NetworkRuleConfig
+//	version: v1alpha1
+//	spec:
+//	  name: etcd
+//	  portSelector:
+//	    ports:
+//	      - 2379-2380
+//	    protocol: tcp
+//	  ingress:
+//	    - subnet: 10.0.0.0/24
+//	      except: 10.0.0.13/32
+type RuleConfigV1Alpha1 struct {
+	// Name of the rule, should be unique across all rules.
+	RuleName string `yaml:"name"`
+	// Ports and protocol the rule applies to.
+	RulePortSelector RulePortSelector `yaml:"portSelector"`
+	// List of source subnets allowed to access the selected ports.
+	RuleIngress []IngressRule `yaml:"ingress"`
+}
+
+// RulePortSelector is a port selector for the network rule.
+type RulePortSelector struct {
+	// List of ports or port ranges (e.g. `2379-2380`).
+	Ports []PortRange `yaml:"ports"`
+	// Protocol: tcp or udp.
+	Protocol nethelpers.Protocol `yaml:"protocol"`
+}
+
+// IngressRule is a ingress rule.
+type IngressRule struct {
+	// Source subnet.
+	IngressSubnet Prefix `yaml:"subnet"`
+	// Subnet to exclude from the source subnet.
+	IngressExcept Prefix `yaml:"except,omitempty"`
+}
+
+// Prefix is a wrapper for netip.Prefix which implements YAML marshaling.
+type Prefix struct {
+	netip.Prefix
+}
+
+// IsZero implements yaml.IsZeroer.
+func (p Prefix) IsZero() bool {
+	return p.Prefix == netip.Prefix{}
+}
+
+// PortRange is a port range: `[lo, hi]`.
+type PortRange [2]uint16
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (r *PortRange) UnmarshalYAML(value *yaml.Node) error {
+	var raw string
+
+	if err := value.Decode(&raw); err != nil {
+		return err
+	}
+
+	lo, hi, isRange := strings.Cut(raw, "-")
+	if !isRange {
+		hi = lo
+	}
+
+	for i, s := range []string{lo, hi} {
+		port, err := strconv.ParseUint(strings.TrimSpace(s), 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port range %q: %w", raw, err)
+		}
+
+		r[i] = uint16(port)
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r PortRange) MarshalYAML() (interface{}, error) {
+	if r[0] == r[1] {
+		return r[0], nil
+	}
+
+	return fmt.Sprintf("%d-%d", r[0], r[1]), nil
+}
+
+// NewRuleConfigV1Alpha1 creates a new RuleConfig config document.
+func NewRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
+	return &RuleConfigV1Alpha1{}
+}
+
+// Kind implements config.Document interface.
+func (s *RuleConfigV1Alpha1) Kind() string {
+	return RuleConfigKind
+}
+
+// Version implements config.Document interface.
+func (s *RuleConfigV1Alpha1) Version() string {
+	return Version
+}
+
+// Validate implements config.Document interface.
+//
+//nolint:gocyclo
+func (s *RuleConfigV1Alpha1) Validate(config.RuntimeMode, ...config.ValidationOption) ([]string, error) {
+	var (
+		warnings []string
+		result   *multierror.Error
+	)
+
+	if s.RuleName == "" {
+		result = multierror.Append(result, errors.New("network rule name is required"))
+	}
+
+	switch s.RulePortSelector.Protocol {
+	case nethelpers.ProtocolTCP, nethelpers.ProtocolUDP:
+	default:
+		result = multierror.Append(result, fmt.Errorf("network rule %q: unsupported protocol %q", s.RuleName, s.RulePortSelector.Protocol))
+	}
+
+	if len(s.RulePortSelector.Ports) == 0 {
+		result = multierror.Append(result, fmt.Errorf("network rule %q: at least one port should be specified", s.RuleName))
+	}
+
+	for _, r := range s.RulePortSelector.Ports {
+		if r[0] == 0 || r[0] > r[1] {
+			result = multierror.Append(result, fmt.Errorf("network rule %q: invalid port range %d-%d", s.RuleName, r[0], r[1]))
+
+			continue
+		}
+
+		if s.RulePortSelector.Protocol == nethelpers.ProtocolTCP && r[0] <= constants.ApidPort && constants.ApidPort <= r[1] {
+			warnings = append(warnings, fmt.Sprintf("network rule %q: access to the Talos API port %d is always allowed", s.RuleName, constants.ApidPort))
+		}
+	}
+
+	for _, ingress := range s.RuleIngress {
+		if !ingress.IngressSubnet.IsValid() {
+			result = multierror.Append(result, fmt.Errorf("network rule %q: invalid ingress subnet", s.RuleName))
+
+			continue
+		}
+
+		if ingress.IngressExcept.IsZero() {
+			continue
+		}
+
+		if !ingress.IngressExcept.IsValid() || ingress.IngressExcept.Addr().Is4() != ingress.IngressSubnet.Addr().Is4() {
+			result = multierror.Append(result,
+				fmt.Errorf("network rule %q: except %q doesn't match subnet %q", s.RuleName, ingress.IngressExcept, ingress.IngressSubnet))
+		}
+	}
+
+	return warnings, result.ErrorOrNil()
+}
+
+// Name implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Name() string {
+	return s.RuleName
+}
+
+// Protocol implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Protocol() nethelpers.Protocol {
+	return s.RulePortSelector.Protocol
+}
+
+// PortRanges implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) PortRanges() [][2]uint16 {
+	return slices.Map(s.RulePortSelector.Ports, func(r PortRange) [2]uint16 { return r })
+}
+
+// Ingress implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Ingress() []config.NetworkRuleIngress {
+	return slices.Map(s.RuleIngress, func(r IngressRule) config.NetworkRuleIngress { return r })
+}
+
+// Subnet implements config.NetworkRuleIngress interface.
+func (r IngressRule) Subnet() netip.Prefix {
+	return r.IngressSubnet.Prefix
+}
+
+// Except implements config.NetworkRuleIngress interface.
+func (r IngressRule) Except() netip.Prefix {
+	return r.IngressExcept.Prefix
+}
@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/network"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+const machineConfig = `version: v1alpha1
+machine:
+  type: worker
+cluster:
+  controlPlane:
+    endpoint: https://localhost:6443
+`
+
+const ruleConfigs = `---
+kind: NetworkDefaultActionConfig
+version: v1alpha1
+spec:
+  ingress: block
+---
+kind: NetworkRuleConfig
+version: v1alpha1
+spec:
+  name: etcd
+  portSelector:
+    ports:
+      - 2379-2380
+      - 4001
+    protocol: tcp
+  ingress:
+    - subnet: 10.0.0.0/24
+      except: 10.0.0.13/32
+    - subnet: fd00::/64
+`
+
+func TestRuleConfigDecode(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := configloader.NewFromBytes([]byte(machineConfig + ruleConfigs))
+	require.NoError(t, err)
+
+	assertRules := func(cfg config.Provider) {
+		rules := cfg.NetworkRules()
+
+		assert.True(t, rules.Enabled())
+		assert.Equal(t, nethelpers.DefaultActionBlock, rules.DefaultAction())
+		require.Len(t, rules.Rules(), 1)
+
+		rule := rules.Rules()[0]
+
+		assert.Equal(t, "etcd", rule.Name())
+		assert.Equal(t, nethelpers.ProtocolTCP, rule.Protocol())
+		assert.Equal(t, [][2]uint16{{2379, 2380}, {4001, 4001}}, rule.PortRanges())
+		require.Len(t, rule.Ingress(), 2)
+		assert.Equal(t, netip.MustParsePrefix("10.0.0.0/24"), rule.Ingress()[0].Subnet())
+		assert.Equal(t, netip.MustParsePrefix("10.0.0.13/32"), rule.Ingress()[0].Except())
+		assert.Equal(t, netip.MustParsePrefix("fd00::/64"), rule.Ingress()[1].Subnet())
+		assert.Equal(t, netip.Prefix{}, rule.Ingress()[1].Except())
+	}
+
+	assertRules(cfg)
+
+	encoded, err := cfg.EncodeBytes()
+	require.NoError(t, err)
+
+	cfg, err = configloader.NewFromBytes(encoded)
+	require.NoError(t, err)
+
+	assertRules(cfg)
+
+	cfg, err = configloader.NewFromBytes([]byte(machineConfig))
+	require.NoError(t, err)
+
+	assert.False(t, cfg.NetworkRules().Enabled())
+	assert.Equal(t, nethelpers.DefaultActionAccept, cfg.NetworkRules().DefaultAction())
+	assert.Empty(t, cfg.NetworkRules().Rules())
+}
+
+func TestRuleConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RuleConfigV1Alpha1
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRuleConfigV1Alpha1,
+
+			expectedError: "3 errors occurred:\n\t* network rule name is required\n\t* network rule \"\": unsupported protocol \"Protocol(0)\"\n\t* network rule \"\": at least one port should be specified\n\n",
+		},
+		{
+			name: "invalid ports",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.RuleName = "test"
+				cfg.RulePortSelector.Protocol = nethelpers.ProtocolUDP
+				cfg.RulePortSelector.Ports = []network.PortRange{{0, 0}, {20, 10}}
+
+				return cfg
+			},
+
+			expectedError: "2 errors occurred:\n\t* network rule \"test\": invalid port range 0-0\n\t* network rule \"test\": invalid port range 20-10\n\n",
+		},
+		{
+			name: "mismatched except",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.RuleName = "test"
+				cfg.RulePortSelector.Protocol = nethelpers.ProtocolTCP
+				cfg.RulePortSelector.Ports = []network.PortRange{{80, 80}}
+				cfg.RuleIngress = []network.IngressRule{
+					{
+						IngressSubnet: network.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+						IngressExcept: network.Prefix{Prefix: netip.MustParsePrefix("fd00::/64")},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "1 error occurred:\n\t* network rule \"test\": except \"fd00::/64\" doesn't match subnet \"10.0.0.0/8\"\n\n",
+		},
+		{
+			name: "apid",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.RuleName = "talos"
+				cfg.RulePortSelector.Protocol = nethelpers.ProtocolTCP
+				cfg.RulePortSelector.Ports = []network.PortRange{{50000, 50001}}
+				cfg.RuleIngress = []network.IngressRule{
+					{
+						IngressSubnet: network.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedWarnings: []string{"network rule \"talos\": access to the Talos API port 50000 is always allowed"},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(nil)
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -5,11 +5,15 @@
 package v1alpha1
 
 import (
+	"encoding/base64"
 	"fmt"
 	goruntime "runtime"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-pointer"
 
+	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -71,3 +75,199 @@ func (e *EtcdConfig) ListenSubnets() []string {
 
 	return nil
 }
+
+// Backup implements the config.Etcd interface.
+func (e *EtcdConfig) Backup() config.EtcdBackup {
+	return e.EtcdBackupConfig
+}
+
+// Defragmentation implements the config.Etcd interface.
+func (e *EtcdConfig) Defragmentation() config.EtcdDefragmentation {
+	return e.EtcdDefragmentationConfig
+}
+
+// Role implements the config.Etcd interface.
+func (e *EtcdConfig) Role() string {
+	if e.EtcdRole == "" {
+		return constants.EtcdRoleVoter
+	}
+
+	return e.EtcdRole
+}
+
+// ExternalEndpoints implements the config.Etcd interface.
+func (e *EtcdConfig) ExternalEndpoints() []string {
+	return e.EtcdExternalEndpoints
+}
+
+// Enabled implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Enabled() bool {
+	if b == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(b.BackupEnabled)
+}
+
+// Interval implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Interval() time.Duration {
+	if b == nil || b.BackupInterval == 0 {
+		return constants.EtcdBackupDefaultInterval
+	}
+
+	return b.BackupInterval
+}
+
+// RetentionCount implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) RetentionCount() int {
+	if b == nil || b.BackupRetentionCount == 0 {
+		return constants.EtcdBackupDefaultRetentionCount
+	}
+
+	return b.BackupRetentionCount
+}
+
+// RetentionMaxAge implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) RetentionMaxAge() time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	return b.BackupRetentionMaxAge
+}
+
+// Uploader implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) Uploader() string {
+	if b == nil {
+		return ""
+	}
+
+	return b.BackupUploader
+}
+
+// EncryptionKey implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) EncryptionKey() []byte {
+	if b == nil || b.BackupEncryptionKey == "" {
+		return nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(b.BackupEncryptionKey)
+	if err != nil {
+		return nil
+	}
+
+	return key
+}
+
+// S3 implements the config.EtcdBackup interface.
+func (b *EtcdBackupConfig) S3() config.EtcdBackupS3 {
+	if b == nil {
+		return (*EtcdBackupS3Config)(nil)
+	}
+
+	return b.BackupS3
+}
+
+// Enabled implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Enabled() bool {
+	return s != nil
+}
+
+// Endpoint implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Endpoint() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3Endpoint
+}
+
+// Region implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Region() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3Region
+}
+
+// Bucket implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Bucket() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3Bucket
+}
+
+// Prefix implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) Prefix() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3Prefix
+}
+
+// AccessKeyID implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) AccessKeyID() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3AccessKeyID
+}
+
+// SecretAccessKey implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) SecretAccessKey() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.S3SecretAccessKey
+}
+
+// ForcePathStyle implements the config.EtcdBackupS3 interface.
+func (s *EtcdBackupS3Config) ForcePathStyle() bool {
+	if s == nil {
+		return false
+	}
+
+	return s.S3ForcePathStyle
+}
+
+// Enabled implements the config.EtcdDefragmentation interface.
+func (d *EtcdDefragmentationConfig) Enabled() bool {
+	if d == nil {
+		return false
+	}
+
+	return pointer.SafeDeref(d.DefragEnabled)
+}
+
+// Interval implements the config.EtcdDefragmentation interface.
+func (d *EtcdDefragmentationConfig) Interval() time.Duration {
+	if d == nil || d.DefragInterval == 0 {
+		return constants.EtcdDefragDefaultInterval
+	}
+
+	return d.DefragInterval
+}
+
+// Threshold implements the config.EtcdDefragmentation interface.
+func (d *EtcdDefragmentationConfig) Threshold() int {
+	if d == nil || d.DefragThreshold == 0 {
+		return constants.EtcdDefragDefaultThreshold
+	}
+
+	return d.DefragThreshold
+}
+
+// MinDBSize implements the config.EtcdDefragmentation interface.
+func (d *EtcdDefragmentationConfig) MinDBSize() uint64 {
+	if d == nil || d.DefragMinDBSize == 0 {
+		return constants.EtcdDefragDefaultMinDBSize
+	}
+
+	return uint64(d.DefragMinDBSize)
+}
@@ -5,17 +5,23 @@
 package v1alpha1
 
 import (
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"fmt"
 	"net/url"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
 // Validate checks logging configuration for errors.
+//
+//nolint:gocyclo
 func (lc *LoggingConfig) Validate() error {
 	var errs *multierror.Error
 
@@ -39,10 +45,28 @@ func (lc *LoggingConfig) Validate() error {
 
 		switch f := dest.LoggingFormat; f {
 		case constants.LoggingFormatJSONLines:
+			if dest.LoggingTLS != nil {
+				errs = multierror.Append(errs, fmt.Errorf("logging format %q doesn't support TLS", f))
+			}
+		case constants.LoggingFormatSyslog:
 			// nothing
+		case constants.LoggingFormatOTLP:
+			if endpoint != nil && endpoint.Scheme != "tcp" {
+				errs = multierror.Append(errs, fmt.Errorf("logging format %q requires \"tcp\" endpoint scheme", f))
+			}
 		default:
 			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
 		}
+
+		if dest.LoggingTLS != nil {
+			if endpoint != nil && endpoint.Scheme != "tcp" {
+				errs = multierror.Append(errs, fmt.Errorf("logging TLS requires \"tcp\" endpoint scheme"))
+			}
+
+			if _, err := dest.LoggingTLS.GetTLSConfig(); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("invalid logging TLS configuration: %w", err))
+			}
+		}
 	}
 
 	return errs.ErrorOrNil()
@@ -62,3 +86,73 @@ func (ld LoggingDestination) Endpoint() *url.URL {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// TLS implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLS() config.LoggingTLS {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS
+}
+
+// Spool implements config.LoggingDestination interface.
+func (ld LoggingDestination) Spool() config.LoggingSpool {
+	if ld.LoggingSpool == nil || !pointer.SafeDeref(ld.LoggingSpool.SpoolEnabled) {
+		return nil
+	}
+
+	return ld.LoggingSpool
+}
+
+// MaxSize implements config.LoggingSpool interface.
+func (ls *LoggingSpoolConfig) MaxSize() uint64 {
+	if ls.SpoolMaxSize == 0 {
+		return constants.LoggingSpoolDefaultMaxSize
+	}
+
+	return uint64(ls.SpoolMaxSize)
+}
+
+// ClientIdentity implements config.LoggingTLS interface.
+func (lt *LoggingTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return lt.TLSClientIdentity
+}
+
+// CA implements config.LoggingTLS interface.
+func (lt *LoggingTLSConfig) CA() []byte {
+	return lt.TLSCA
+}
+
+// InsecureSkipVerify implements config.LoggingTLS interface.
+func (lt *LoggingTLSConfig) InsecureSkipVerify() bool {
+	return pointer.SafeDeref(lt.TLSInsecureSkipVerify)
+}
+
+// GetTLSConfig implements config.LoggingTLS interface.
+func (lt *LoggingTLSConfig) GetTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if lt.TLSClientIdentity != nil {
+		cert, err := tls.X509KeyPair(lt.TLSClientIdentity.Crt, lt.TLSClientIdentity.Key)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client identity: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if lt.CA() != nil {
+		tlsConfig.RootCAs = stdx509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(lt.TLSCA) {
+			return nil, fmt.Errorf("no valid certificates found in CA")
+		}
+	}
+
+	if lt.InsecureSkipVerify() {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// networkRuleConfig implements config.NetworkRuleConfig over the additional configuration documents.
+//
+// +k8s:deepcopy-gen=false
+type networkRuleConfig []config.Document
+
+// NetworkRules implements the config.Provider interface.
+func (c *Config) NetworkRules() config.NetworkRuleConfig {
+	return networkRuleConfig(nil)
+}
+
+// Documents implements the config.Provider interface.
+//
+// Bare v1alpha1 config never carries additional documents, see WithDocuments.
+func (c *Config) Documents() []config.Document {
+	return nil
+}
+
+// Enabled implements the config.NetworkRuleConfig interface.
+func (docs networkRuleConfig) Enabled() bool {
+	for _, doc := range docs {
+		switch doc.(type) {
+		case config.NetworkDefaultAction, config.NetworkRule:
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultAction implements the config.NetworkRuleConfig interface.
+func (docs networkRuleConfig) DefaultAction() nethelpers.DefaultAction {
+	for _, doc := range docs {
+		if action, ok := doc.(config.NetworkDefaultAction); ok {
+			return action.DefaultAction()
+		}
+	}
+
+	return nethelpers.DefaultActionAccept
+}
+
+// Rules implements the config.NetworkRuleConfig interface.
+func (docs networkRuleConfig) Rules() []config.NetworkRule {
+	var rules []config.NetworkRule
+
+	for _, doc := range docs {
+		if rule, ok := doc.(config.NetworkRule); ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
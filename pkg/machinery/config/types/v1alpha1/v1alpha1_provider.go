@@ -295,6 +295,15 @@ func (m *MachineConfig) Kernel() config.Kernel {
 	return m.MachineKernel
 }
 
+// Swap implements the config.Provider interface.
+func (m *MachineConfig) Swap() config.Swap {
+	if m.MachineSwap == nil {
+		return &SwapConfig{}
+	}
+
+	return m.MachineSwap
+}
+
 // Image implements the config.Provider interface.
 func (k *KubeletConfig) Image() string {
 	image := k.KubeletImage
@@ -334,6 +343,11 @@ func (k *KubeletConfig) ExtraConfig() map[string]interface{} {
 	return k.KubeletExtraConfig.Object
 }
 
+// CredentialProviderConfig implements the config.Provider interface.
+func (k *KubeletConfig) CredentialProviderConfig() map[string]interface{} {
+	return k.KubeletCredentialProviderConfig.Object
+}
+
 // DefaultRuntimeSeccompProfileEnabled implements the config.Provider interface.
 func (k *KubeletConfig) DefaultRuntimeSeccompProfileEnabled() bool {
 	return pointer.SafeDeref(k.KubeletDefaultRuntimeSeccompProfileEnabled)
@@ -507,6 +521,11 @@ func (n *NetworkConfig) ExtraHosts() []config.ExtraHost {
 	return slices.Map(n.ExtraHostEntries, func(e *ExtraHost) config.ExtraHost { return e })
 }
 
+// RoutingRules implements the config.Provider interface.
+func (n *NetworkConfig) RoutingRules() []config.RoutingRule {
+	return slices.Map(n.NetworkRoutingRules, func(r *RoutingRule) config.RoutingRule { return r })
+}
+
 // KubeSpan implements the config.Provider interface.
 func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	if n.NetworkKubeSpan == nil {
@@ -566,6 +585,33 @@ func (d *Device) Bridge() config.Bridge {
 	return d.DeviceBridge
 }
 
+// VXLAN implements the MachineNetwork interface.
+func (d *Device) VXLAN() config.VXLAN {
+	if d.DeviceVXLAN == nil {
+		return nil
+	}
+
+	return d.DeviceVXLAN
+}
+
+// VRF implements the MachineNetwork interface.
+func (d *Device) VRF() config.VRF {
+	if d.DeviceVRF == nil {
+		return nil
+	}
+
+	return d.DeviceVRF
+}
+
+// MACVLAN implements the MachineNetwork interface.
+func (d *Device) MACVLAN() config.MACVLAN {
+	if d.DeviceMACVLAN == nil {
+		return nil
+	}
+
+	return d.DeviceMACVLAN
+}
+
 // Vlans implements the MachineNetwork interface.
 func (d *Device) Vlans() []config.Vlan {
 	return slices.Map(d.DeviceVlans, func(v *Vlan) config.Vlan { return v })
@@ -621,6 +667,24 @@ func (d *Device) Selector() config.NetworkDeviceSelector {
 	return d.DeviceSelector
 }
 
+// Ethtool implements the MachineNetwork interface.
+func (d *Device) Ethtool() config.Ethtool {
+	if d.DeviceEthtool == nil {
+		return nil
+	}
+
+	return d.DeviceEthtool
+}
+
+// RouterAdvertisement implements the MachineNetwork interface.
+func (d *Device) RouterAdvertisement() config.RouterAdvertisement {
+	if d.DeviceRouterAdvertisement == nil {
+		return nil
+	}
+
+	return d.DeviceRouterAdvertisement
+}
+
 // IP implements the config.VIPConfig interface.
 func (d *DeviceVIPConfig) IP() string {
 	return d.SharedIP
@@ -654,6 +718,69 @@ func (v *VIPHCloudConfig) APIToken() string {
 	return v.HCloudAPIToken
 }
 
+// BGP implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) BGP() config.VIPBGP {
+	if d.BGPConfig == nil {
+		return nil
+	}
+
+	return d.BGPConfig
+}
+
+// LocalASN implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) LocalASN() uint32 {
+	return v.BGPLocalASN
+}
+
+// RouterID implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) RouterID() string {
+	return v.BGPRouterID
+}
+
+// HoldTime implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) HoldTime() time.Duration {
+	return v.BGPHoldTime
+}
+
+// Peers implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) Peers() []config.VIPBGPPeer {
+	return slices.Map(v.BGPPeers, func(p *VIPBGPPeer) config.VIPBGPPeer { return p })
+}
+
+// Address implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) Address() string {
+	return p.BGPPeerAddress
+}
+
+// ASN implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) ASN() uint32 {
+	return p.BGPPeerASN
+}
+
+// Port implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) Port() uint16 {
+	return p.BGPPeerPort
+}
+
+// KubernetesLease implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) KubernetesLease() config.VIPKubernetesLease {
+	if d.KubernetesLeaseConfig == nil {
+		return nil
+	}
+
+	return d.KubernetesLeaseConfig
+}
+
+// Namespace implements the config.VIPKubernetesLease interface.
+func (v *VIPKubernetesLeaseConfig) Namespace() string {
+	return v.LeaseNamespace
+}
+
+// ServiceAccountToken implements the config.VIPKubernetesLease interface.
+func (v *VIPKubernetesLeaseConfig) ServiceAccountToken() string {
+	return v.LeaseServiceAccountToken
+}
+
 // WireguardConfig implements the MachineNetwork interface.
 func (d *Device) WireguardConfig() config.WireguardConfig {
 	if d.DeviceWireguardConfig == nil {
@@ -691,6 +818,78 @@ func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
 }
 
+// Hostname implements the DHCPOptions interface.
+func (d *DHCPOptions) Hostname() string {
+	return d.DHCPHostname
+}
+
+// VendorClass implements the DHCPOptions interface.
+func (d *DHCPOptions) VendorClass() string {
+	return d.DHCPVendorClass
+}
+
+// ClientIdentifier implements the DHCPOptions interface.
+func (d *DHCPOptions) ClientIdentifier() string {
+	return d.DHCPClientIdentifier
+}
+
+// UserClasses implements the DHCPOptions interface.
+func (d *DHCPOptions) UserClasses() []string {
+	return d.DHCPUserClasses
+}
+
+// RequestOptions implements the DHCPOptions interface.
+func (d *DHCPOptions) RequestOptions() []int {
+	return d.DHCPRequestOptions
+}
+
+// PrefixDelegation implements the DHCPOptions interface.
+func (d *DHCPOptions) PrefixDelegation() config.PrefixDelegation {
+	if d.DHCPPrefixDelegation == nil {
+		return nil
+	}
+
+	return d.DHCPPrefixDelegation
+}
+
+// PrefixLength implements the config.PrefixDelegation interface.
+func (p *DHCPPrefixDelegation) PrefixLength() int {
+	return p.PrefixDelegationPrefixLength
+}
+
+// Subnets implements the config.PrefixDelegation interface.
+func (p *DHCPPrefixDelegation) Subnets() []config.PrefixDelegationSubnet {
+	return slices.Map(p.PrefixDelegationSubnets, func(s *DHCPPrefixDelegationSubnet) config.PrefixDelegationSubnet { return s })
+}
+
+// Interface implements the config.PrefixDelegationSubnet interface.
+func (s *DHCPPrefixDelegationSubnet) Interface() string {
+	return s.SubnetInterface
+}
+
+// ID implements the config.PrefixDelegationSubnet interface.
+func (s *DHCPPrefixDelegationSubnet) ID() uint32 {
+	return s.SubnetID
+}
+
+// Accept implements the config.RouterAdvertisement interface.
+func (r *RouterAdvertisement) Accept() bool {
+	if r.RAAccept == nil {
+		return true
+	}
+
+	return *r.RAAccept
+}
+
+// SLAAC implements the config.RouterAdvertisement interface.
+func (r *RouterAdvertisement) SLAAC() bool {
+	if r.RASLAAC == nil {
+		return true
+	}
+
+	return *r.RASLAAC
+}
+
 // PrivateKey implements the MachineNetwork interface.
 func (wc *DeviceWireguardConfig) PrivateKey() string {
 	return wc.WireguardPrivateKey
@@ -776,6 +975,46 @@ func (r *Route) MTU() uint32 {
 	return r.RouteMTU
 }
 
+// Table implements the MachineNetwork interface.
+func (r *Route) Table() uint32 {
+	return r.RouteTable
+}
+
+// Priority implements the config.RoutingRule interface.
+func (r *RoutingRule) Priority() uint32 {
+	return r.RoutingRulePriority
+}
+
+// Source implements the config.RoutingRule interface.
+func (r *RoutingRule) Source() string {
+	return r.RoutingRuleSource
+}
+
+// Destination implements the config.RoutingRule interface.
+func (r *RoutingRule) Destination() string {
+	return r.RoutingRuleDestination
+}
+
+// IIF implements the config.RoutingRule interface.
+func (r *RoutingRule) IIF() string {
+	return r.RoutingRuleIIF
+}
+
+// FwMark implements the config.RoutingRule interface.
+func (r *RoutingRule) FwMark() uint32 {
+	return r.RoutingRuleFwMark
+}
+
+// FwMask implements the config.RoutingRule interface.
+func (r *RoutingRule) FwMask() uint32 {
+	return r.RoutingRuleFwMask
+}
+
+// Table implements the config.RoutingRule interface.
+func (r *RoutingRule) Table() uint32 {
+	return r.RoutingRuleTable
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -946,6 +1185,124 @@ func (b *Bridge) STP() config.STP {
 	return b.BridgeSTP
 }
 
+// VNI implements the config.VXLAN interface.
+func (v *VXLAN) VNI() uint32 {
+	return v.VXLANID
+}
+
+// Link implements the config.VXLAN interface.
+func (v *VXLAN) Link() string {
+	return v.VXLANLink
+}
+
+// Local implements the config.VXLAN interface.
+func (v *VXLAN) Local() string {
+	return v.VXLANLocal
+}
+
+// Remote implements the config.VXLAN interface.
+func (v *VXLAN) Remote() string {
+	return v.VXLANRemote
+}
+
+// Port implements the config.VXLAN interface.
+func (v *VXLAN) Port() uint16 {
+	if v.VXLANPort == 0 {
+		return constants.DefaultVXLANPort
+	}
+
+	return v.VXLANPort
+}
+
+// TTL implements the config.VXLAN interface.
+func (v *VXLAN) TTL() uint8 {
+	return v.VXLANTTL
+}
+
+// Table implements the config.VRF interface.
+func (v *VRF) Table() uint32 {
+	return v.VRFTable
+}
+
+// Interfaces implements the config.VRF interface.
+func (v *VRF) Interfaces() []string {
+	return v.VRFInterfaces
+}
+
+// Link implements the config.MACVLAN interface.
+func (m *MACVLAN) Link() string {
+	return m.MACVLANLink
+}
+
+// Mode implements the config.MACVLAN interface.
+func (m *MACVLAN) Mode() string {
+	return m.MACVLANMode
+}
+
+// RXRingSize implements the config.Ethtool interface.
+func (e *Ethtool) RXRingSize() uint32 {
+	if e.EthtoolRings == nil {
+		return 0
+	}
+
+	return e.EthtoolRings.RingsRX
+}
+
+// TXRingSize implements the config.Ethtool interface.
+func (e *Ethtool) TXRingSize() uint32 {
+	if e.EthtoolRings == nil {
+		return 0
+	}
+
+	return e.EthtoolRings.RingsTX
+}
+
+// RXChannels implements the config.Ethtool interface.
+func (e *Ethtool) RXChannels() uint32 {
+	if e.EthtoolChannels == nil {
+		return 0
+	}
+
+	return e.EthtoolChannels.ChannelsRX
+}
+
+// TXChannels implements the config.Ethtool interface.
+func (e *Ethtool) TXChannels() uint32 {
+	if e.EthtoolChannels == nil {
+		return 0
+	}
+
+	return e.EthtoolChannels.ChannelsTX
+}
+
+// CombinedChannels implements the config.Ethtool interface.
+func (e *Ethtool) CombinedChannels() uint32 {
+	if e.EthtoolChannels == nil {
+		return 0
+	}
+
+	return e.EthtoolChannels.ChannelsCombined
+}
+
+// Features implements the config.Ethtool interface.
+func (e *Ethtool) Features() map[string]bool {
+	return e.EthtoolFeatures
+}
+
+// Speed implements the config.Ethtool interface.
+func (e *Ethtool) Speed() uint32 {
+	return e.EthtoolSpeed
+}
+
+// Duplex implements the config.Ethtool interface.
+func (e *Ethtool) Duplex() string {
+	if e.EthtoolDuplex == "" {
+		return "full"
+	}
+
+	return e.EthtoolDuplex
+}
+
 // Addresses implements the MachineNetwork interface.
 func (v *Vlan) Addresses() []string {
 	switch {
@@ -1208,6 +1565,11 @@ func (p *DiskPartition) MountPoint() string {
 	return p.DiskMountPoint
 }
 
+// Swap implements the config.Provider interface.
+func (p *DiskPartition) Swap() bool {
+	return p.DiskSwap
+}
+
 // Kind implements the config.Provider interface.
 func (e *EncryptionConfig) Kind() string {
 	return e.EncryptionProvider
@@ -1256,6 +1618,24 @@ func (e *EncryptionKey) NodeID() config.EncryptionKeyNodeID {
 	return e.KeyNodeID
 }
 
+// TPM implements the config.Provider interface.
+func (e *EncryptionKey) TPM() config.EncryptionKeyTPM {
+	if e.KeyTPM == nil {
+		return nil
+	}
+
+	return e.KeyTPM
+}
+
+// KMS implements the config.Provider interface.
+func (e *EncryptionKey) KMS() config.EncryptionKeyKMS {
+	if e.KeyKMS == nil {
+		return nil
+	}
+
+	return e.KeyKMS
+}
+
 // Slot implements the config.Provider interface.
 func (e *EncryptionKey) Slot() int {
 	return e.KeySlot
@@ -1266,6 +1646,57 @@ func (e *EncryptionKeyStatic) Key() []byte {
 	return []byte(e.KeyData)
 }
 
+// PCRs implements the config.Provider interface.
+func (e *EncryptionKeyTPM) PCRs() []int {
+	if len(e.TPMPCRs) == 0 {
+		return []int{constants.DefaultEncryptionTPMPCR}
+	}
+
+	return e.TPMPCRs
+}
+
+// Endpoint implements the config.Provider interface.
+func (e *EncryptionKeyKMS) Endpoint() string {
+	return e.KMSEndpoint
+}
+
+// ClientIdentity implements the config.Provider interface.
+func (e *EncryptionKeyKMS) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return e.KMSClientIdentity
+}
+
+// CA implements the config.Provider interface.
+func (e *EncryptionKeyKMS) CA() []byte {
+	return e.KMSCA
+}
+
+// GetTLSConfig implements the config.Provider interface.
+func (e *EncryptionKeyKMS) GetTLSConfig() (*tls.Config, error) {
+	if e.KMSClientIdentity == nil {
+		return nil, fmt.Errorf("client identity is required")
+	}
+
+	cert, err := tls.X509KeyPair(e.KMSClientIdentity.Crt, e.KMSClientIdentity.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing client identity: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if e.KMSCA != nil {
+		tlsConfig.RootCAs = stdx509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(e.KMSCA) {
+			return nil, fmt.Errorf("no valid certificates found in CA")
+		}
+	}
+
+	return tlsConfig, nil
+}
+
 // Get implements the config.Provider interface.
 func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
 	switch label {
@@ -1312,3 +1743,69 @@ func (v VolumeMountConfig) ReadOnly() bool {
 func (u *UdevConfig) Rules() []string {
 	return u.UdevRules
 }
+
+// Enabled implements the config.Provider interface.
+func (s *SwapConfig) Enabled() bool {
+	return s.Zram().Enabled() || len(s.SwapDevices) > 0
+}
+
+// Zram implements the config.Provider interface.
+func (s *SwapConfig) Zram() config.Zram {
+	if s.SwapZram == nil {
+		return &ZramConfig{}
+	}
+
+	return s.SwapZram
+}
+
+// Devices implements the config.Provider interface.
+func (s *SwapConfig) Devices() []config.SwapDevice {
+	return slices.Map(s.SwapDevices, func(d *SwapDeviceConfig) config.SwapDevice { return d })
+}
+
+// KubeletSwapBehavior implements the config.Provider interface.
+func (s *SwapConfig) KubeletSwapBehavior() string {
+	if s.SwapKubeletBehavior == "" {
+		return constants.KubeletDefaultSwapBehavior
+	}
+
+	return s.SwapKubeletBehavior
+}
+
+// Enabled implements the config.Provider interface.
+func (z *ZramConfig) Enabled() bool {
+	return z.ZramSize > 0
+}
+
+// Size implements the config.Provider interface.
+func (z *ZramConfig) Size() uint64 {
+	return uint64(z.ZramSize)
+}
+
+// Algorithm implements the config.Provider interface.
+func (z *ZramConfig) Algorithm() string {
+	return z.ZramAlgorithm
+}
+
+// Priority implements the config.Provider interface.
+func (z *ZramConfig) Priority() int {
+	if z.ZramPriority == nil {
+		return constants.ZramDefaultSwapPriority
+	}
+
+	return *z.ZramPriority
+}
+
+// Device implements the config.Provider interface.
+func (d *SwapDeviceConfig) Device() string {
+	return d.SwapDevice
+}
+
+// Priority implements the config.Provider interface.
+func (d *SwapDeviceConfig) Priority() int {
+	if d.SwapPriority == nil {
+		return -1
+	}
+
+	return *d.SwapPriority
+}
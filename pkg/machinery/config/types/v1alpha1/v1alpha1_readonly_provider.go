@@ -6,6 +6,10 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	yaml "gopkg.in/yaml.v3"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
@@ -13,17 +17,22 @@ import (
 
 // ReadonlyProvider wraps the *v1alpha1.Config to make config read-only.
 //
+// ReadonlyProvider also carries additional configuration documents which were
+// supplied next to the v1alpha1 config.
+//
 // +k8s:deepcopy-gen=false
 type ReadonlyProvider struct {
-	cfg   *Config
-	bytes []byte
+	cfg       *Config
+	bytes     []byte
+	documents []config.Document
 }
 
 // WrapReadonly the v1alpha.Config providing read-only interface to it.
-func WrapReadonly(cfg *Config, bytes []byte) *ReadonlyProvider {
+func WrapReadonly(cfg *Config, bytes []byte, documents ...config.Document) *ReadonlyProvider {
 	return &ReadonlyProvider{
-		cfg:   cfg,
-		bytes: bytes,
+		cfg:       cfg,
+		bytes:     bytes,
+		documents: documents,
 	}
 }
 
@@ -52,9 +61,52 @@ func (r *ReadonlyProvider) Cluster() config.ClusterConfig {
 	return r.cfg.Cluster()
 }
 
+// NetworkRules implements the config.Provider interface.
+func (r *ReadonlyProvider) NetworkRules() config.NetworkRuleConfig {
+	return networkRuleConfig(r.documents)
+}
+
+// Documents implements the config.Provider interface.
+func (r *ReadonlyProvider) Documents() []config.Document {
+	return r.documents
+}
+
 // Validate checks configuration and returns warnings and fatal errors (as multierror).
 func (r *ReadonlyProvider) Validate(mode config.RuntimeMode, opts ...config.ValidationOption) ([]string, error) {
-	return r.cfg.Validate(mode, opts...)
+	warnings, err := r.cfg.Validate(mode, opts...)
+	if len(r.documents) == 0 {
+		return warnings, err
+	}
+
+	result := multierror.Append(nil, err)
+
+	var defaultActions int
+
+	ruleNames := map[string]struct{}{}
+
+	for _, doc := range r.documents {
+		docWarnings, docErr := doc.Validate(mode, opts...)
+
+		warnings = append(warnings, docWarnings...)
+		result = multierror.Append(result, docErr)
+
+		switch d := doc.(type) {
+		case config.NetworkDefaultAction:
+			defaultActions++
+		case config.NetworkRule:
+			if _, exists := ruleNames[d.Name()]; exists {
+				result = multierror.Append(result, fmt.Errorf("duplicate network rule %q", d.Name()))
+			}
+
+			ruleNames[d.Name()] = struct{}{}
+		}
+	}
+
+	if defaultActions > 1 {
+		result = multierror.Append(result, errors.New("only one network default action document is allowed"))
+	}
+
+	return warnings, result.ErrorOrNil()
 }
 
 // Bytes returns source YAML representation (if available) or does default encoding.
@@ -68,12 +120,77 @@ func (r *ReadonlyProvider) Bytes() ([]byte, error) {
 
 // EncodeString implements the config.Provider interface.
 func (r *ReadonlyProvider) EncodeString(encoderOptions ...encoder.Option) (string, error) {
-	return r.cfg.EncodeString(encoderOptions...)
+	out, err := r.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
 }
 
 // EncodeBytes implements the config.Provider interface.
 func (r *ReadonlyProvider) EncodeBytes(encoderOptions ...encoder.Option) ([]byte, error) {
-	return r.cfg.EncodeBytes(encoderOptions...)
+	out, err := r.cfg.EncodeBytes(encoderOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	docBytes, err := EncodeDocuments(r.documents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, docBytes...), nil
+}
+
+// WithDocuments returns the config provider which carries the additional documents next to the config.
+//
+// If there are no documents, the config itself is returned.
+func WithDocuments(cfg *Config, documents ...config.Document) (config.Provider, error) {
+	if len(documents) == 0 {
+		return cfg, nil
+	}
+
+	provider := WrapReadonly(cfg, nil, documents...)
+
+	bytes, err := provider.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return nil, err
+	}
+
+	provider.bytes = bytes
+
+	return provider, nil
+}
+
+// EncodeDocuments encodes the additional configuration documents, each one prefixed with the YAML document separator.
+func EncodeDocuments(documents ...config.Document) ([]byte, error) {
+	var out []byte
+
+	for _, doc := range documents {
+		docBytes, err := yaml.Marshal(&documentManifest{
+			Kind:    doc.Kind(),
+			Version: doc.Version(),
+			Spec:    doc,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %q document: %w", doc.Kind(), err)
+		}
+
+		out = append(out, "---\n"...)
+		out = append(out, docBytes...)
+	}
+
+	return out, nil
+}
+
+// documentManifest is the envelope of the additional configuration document.
+//
+// +k8s:deepcopy-gen=false
+type documentManifest struct {
+	Kind    string      `yaml:"kind"`
+	Version string      `yaml:"version"`
+	Spec    interface{} `yaml:"spec"`
 }
 
 // Raw implements the config.Provider interface.
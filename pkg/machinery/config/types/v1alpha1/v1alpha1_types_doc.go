@@ -40,6 +40,9 @@ var (
 	ProxyConfigDoc                    encoder.Doc
 	SchedulerConfigDoc                encoder.Doc
 	EtcdConfigDoc                     encoder.Doc
+	EtcdBackupConfigDoc               encoder.Doc
+	EtcdDefragmentationConfigDoc      encoder.Doc
+	EtcdBackupS3ConfigDoc             encoder.Doc
 	ClusterNetworkConfigDoc           encoder.Doc
 	CNIConfigDoc                      encoder.Doc
 	ExternalCloudProviderConfigDoc    encoder.Doc
@@ -50,27 +53,44 @@ var (
 	EncryptionKeyDoc                  encoder.Doc
 	EncryptionKeyStaticDoc            encoder.Doc
 	EncryptionKeyNodeIDDoc            encoder.Doc
+	EncryptionKeyTPMDoc               encoder.Doc
+	EncryptionKeyKMSDoc               encoder.Doc
 	MachineFileDoc                    encoder.Doc
 	ExtraHostDoc                      encoder.Doc
+	RoutingRuleDoc                    encoder.Doc
 	DeviceDoc                         encoder.Doc
 	DHCPOptionsDoc                    encoder.Doc
+	DHCPPrefixDelegationDoc           encoder.Doc
+	DHCPPrefixDelegationSubnetDoc     encoder.Doc
+	RouterAdvertisementDoc            encoder.Doc
 	DeviceWireguardConfigDoc          encoder.Doc
 	DeviceWireguardPeerDoc            encoder.Doc
 	DeviceVIPConfigDoc                encoder.Doc
 	VIPEquinixMetalConfigDoc          encoder.Doc
 	VIPHCloudConfigDoc                encoder.Doc
+	VIPBGPConfigDoc                   encoder.Doc
+	VIPBGPPeerDoc                     encoder.Doc
+	VIPKubernetesLeaseConfigDoc       encoder.Doc
 	BondDoc                           encoder.Doc
 	STPDoc                            encoder.Doc
 	BridgeDoc                         encoder.Doc
+	VXLANDoc                          encoder.Doc
+	VRFDoc                            encoder.Doc
+	MACVLANDoc                        encoder.Doc
+	EthtoolDoc                        encoder.Doc
+	EthtoolRingsDoc                   encoder.Doc
+	EthtoolChannelsDoc                encoder.Doc
 	VlanDoc                           encoder.Doc
 	RouteDoc                          encoder.Doc
 	RegistryMirrorConfigDoc           encoder.Doc
 	RegistryConfigDoc                 encoder.Doc
 	RegistryAuthConfigDoc             encoder.Doc
 	RegistryTLSConfigDoc              encoder.Doc
+	LoggingSpoolConfigDoc             encoder.Doc
 	SystemDiskEncryptionConfigDoc     encoder.Doc
 	FeaturesConfigDoc                 encoder.Doc
 	KubernetesTalosAPIAccessConfigDoc encoder.Doc
+	HostDNSConfigDoc                  encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
@@ -82,7 +102,11 @@ var (
 	UdevConfigDoc                     encoder.Doc
 	LoggingConfigDoc                  encoder.Doc
 	LoggingDestinationDoc             encoder.Doc
+	LoggingTLSConfigDoc               encoder.Doc
 	KernelConfigDoc                   encoder.Doc
+	SwapConfigDoc                     encoder.Doc
+	ZramConfigDoc                     encoder.Doc
+	SwapDeviceConfigDoc               encoder.Doc
 	KernelModuleConfigDoc             encoder.Doc
 )
 
@@ -145,7 +169,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 22)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 23)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -313,6 +337,13 @@ func init() {
 	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Configures the seccomp profiles for the machine."
 
 	MachineConfigDoc.Fields[21].AddExample("", machineSeccompExample)
+	MachineConfigDoc.Fields[22].Name = "swap"
+	MachineConfigDoc.Fields[22].Type = "SwapConfig"
+	MachineConfigDoc.Fields[22].Note = ""
+	MachineConfigDoc.Fields[22].Description = "Configures swap: zram-backed swap and swap partitions.\n\nIf any swap is configured, kubelet is configured with `NodeSwap` feature enabled."
+	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "Configures swap: zram-backed swap and swap partitions."
+
+	MachineConfigDoc.Fields[22].AddExample("", machineSwapExample)
 
 	MachineSeccompProfileDoc.Type = "MachineSeccompProfile"
 	MachineSeccompProfileDoc.Comments[encoder.LineComment] = "MachineSeccompProfile defines seccomp profiles for the machine."
@@ -594,7 +625,7 @@ func init() {
 			FieldName: "kubelet",
 		},
 	}
-	KubeletConfigDoc.Fields = make([]encoder.Doc, 9)
+	KubeletConfigDoc.Fields = make([]encoder.Doc, 10)
 	KubeletConfigDoc.Fields[0].Name = "image"
 	KubeletConfigDoc.Fields[0].Type = "string"
 	KubeletConfigDoc.Fields[0].Note = ""
@@ -632,41 +663,48 @@ func init() {
 	KubeletConfigDoc.Fields[4].Comments[encoder.LineComment] = "The `extraConfig` field is used to provide kubelet configuration overrides."
 
 	KubeletConfigDoc.Fields[4].AddExample("", kubeletExtraConfigExample)
-	KubeletConfigDoc.Fields[5].Name = "defaultRuntimeSeccompProfileEnabled"
-	KubeletConfigDoc.Fields[5].Type = "bool"
+	KubeletConfigDoc.Fields[5].Name = "credentialProviderConfig"
+	KubeletConfigDoc.Fields[5].Type = "Unstructured"
 	KubeletConfigDoc.Fields[5].Note = ""
-	KubeletConfigDoc.Fields[5].Description = "Enable container runtime default Seccomp profile."
-	KubeletConfigDoc.Fields[5].Comments[encoder.LineComment] = "Enable container runtime default Seccomp profile."
-	KubeletConfigDoc.Fields[5].Values = []string{
+	KubeletConfigDoc.Fields[5].Description = "The `credentialProviderConfig` field is used to provide kubelet credential provider configuration.\n\nThe configuration is passed to the kubelet as `--image-credential-provider-config`,\ncredential provider binaries are expected to be installed to `/usr/local/lib/kubelet/credentialproviders`\nby a system extension."
+	KubeletConfigDoc.Fields[5].Comments[encoder.LineComment] = "The `credentialProviderConfig` field is used to provide kubelet credential provider configuration."
+
+	KubeletConfigDoc.Fields[5].AddExample("", kubeletCredentialProviderConfigExample)
+	KubeletConfigDoc.Fields[6].Name = "defaultRuntimeSeccompProfileEnabled"
+	KubeletConfigDoc.Fields[6].Type = "bool"
+	KubeletConfigDoc.Fields[6].Note = ""
+	KubeletConfigDoc.Fields[6].Description = "Enable container runtime default Seccomp profile."
+	KubeletConfigDoc.Fields[6].Comments[encoder.LineComment] = "Enable container runtime default Seccomp profile."
+	KubeletConfigDoc.Fields[6].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
-	KubeletConfigDoc.Fields[6].Name = "registerWithFQDN"
-	KubeletConfigDoc.Fields[6].Type = "bool"
-	KubeletConfigDoc.Fields[6].Note = ""
-	KubeletConfigDoc.Fields[6].Description = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration.\nThis is required in clouds like AWS."
-	KubeletConfigDoc.Fields[6].Comments[encoder.LineComment] = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration."
-	KubeletConfigDoc.Fields[6].Values = []string{
+	KubeletConfigDoc.Fields[7].Name = "registerWithFQDN"
+	KubeletConfigDoc.Fields[7].Type = "bool"
+	KubeletConfigDoc.Fields[7].Note = ""
+	KubeletConfigDoc.Fields[7].Description = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration.\nThis is required in clouds like AWS."
+	KubeletConfigDoc.Fields[7].Comments[encoder.LineComment] = "The `registerWithFQDN` field is used to force kubelet to use the node FQDN for registration."
+	KubeletConfigDoc.Fields[7].Values = []string{
 		"true",
 		"yes",
 		"false",
 		"no",
 	}
-	KubeletConfigDoc.Fields[7].Name = "nodeIP"
-	KubeletConfigDoc.Fields[7].Type = "KubeletNodeIPConfig"
-	KubeletConfigDoc.Fields[7].Note = ""
-	KubeletConfigDoc.Fields[7].Description = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.\nThis is used when a node has multiple addresses to choose from."
-	KubeletConfigDoc.Fields[7].Comments[encoder.LineComment] = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet."
-
-	KubeletConfigDoc.Fields[7].AddExample("", kubeletNodeIPExample)
-	KubeletConfigDoc.Fields[8].Name = "skipNodeRegistration"
-	KubeletConfigDoc.Fields[8].Type = "bool"
+	KubeletConfigDoc.Fields[8].Name = "nodeIP"
+	KubeletConfigDoc.Fields[8].Type = "KubeletNodeIPConfig"
 	KubeletConfigDoc.Fields[8].Note = ""
-	KubeletConfigDoc.Fields[8].Description = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver.\nThis runs kubelet as standalone and only runs static pods."
-	KubeletConfigDoc.Fields[8].Comments[encoder.LineComment] = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver."
-	KubeletConfigDoc.Fields[8].Values = []string{
+	KubeletConfigDoc.Fields[8].Description = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet.\nThis is used when a node has multiple addresses to choose from."
+	KubeletConfigDoc.Fields[8].Comments[encoder.LineComment] = "The `nodeIP` field is used to configure `--node-ip` flag for the kubelet."
+
+	KubeletConfigDoc.Fields[8].AddExample("", kubeletNodeIPExample)
+	KubeletConfigDoc.Fields[9].Name = "skipNodeRegistration"
+	KubeletConfigDoc.Fields[9].Type = "bool"
+	KubeletConfigDoc.Fields[9].Note = ""
+	KubeletConfigDoc.Fields[9].Description = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver.\nThis runs kubelet as standalone and only runs static pods."
+	KubeletConfigDoc.Fields[9].Comments[encoder.LineComment] = "The `skipNodeRegistration` is used to run the kubelet without registering with the apiserver."
+	KubeletConfigDoc.Fields[9].Values = []string{
 		"true",
 		"yes",
 		"false",
@@ -702,7 +740,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 7)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -747,6 +785,13 @@ func init() {
 		"false",
 		"no",
 	}
+	NetworkConfigDoc.Fields[6].Name = "routingRules"
+	NetworkConfigDoc.Fields[6].Type = "[]RoutingRule"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Configures policy routing rules (`ip rule`).\nRules are evaluated in the order of priority, and can steer traffic into custom routing tables."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures policy routing rules (`ip rule`)."
+
+	NetworkConfigDoc.Fields[6].AddExample("", networkConfigRoutingRulesExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -937,7 +982,7 @@ func init() {
 	TimeConfigDoc.Fields[1].Name = "servers"
 	TimeConfigDoc.Fields[1].Type = "[]string"
 	TimeConfigDoc.Fields[1].Note = ""
-	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`"
+	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nServers prefixed with `nts://` (e.g. `nts://time.cloudflare.com`) are queried using Network Time Security (RFC 8915).\nDefaults to `pool.ntp.org`"
 	TimeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies time (NTP) servers to use for setting the system time."
 	TimeConfigDoc.Fields[2].Name = "bootTimeout"
 	TimeConfigDoc.Fields[2].Type = "Duration"
@@ -1016,6 +1061,8 @@ func init() {
 	EndpointDoc.AddExample("", loggingEndpointExample1)
 
 	EndpointDoc.AddExample("", loggingEndpointExample2)
+
+	EndpointDoc.AddExample("", loggingEndpointExample3)
 	EndpointDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "ControlPlaneConfig",
@@ -1255,7 +1302,7 @@ func init() {
 			FieldName: "etcd",
 		},
 	}
-	EtcdConfigDoc.Fields = make([]encoder.Doc, 6)
+	EtcdConfigDoc.Fields = make([]encoder.Doc, 10)
 	EtcdConfigDoc.Fields[0].Name = "image"
 	EtcdConfigDoc.Fields[0].Type = "string"
 	EtcdConfigDoc.Fields[0].Note = ""
@@ -1288,6 +1335,168 @@ func init() {
 	EtcdConfigDoc.Fields[5].Note = ""
 	EtcdConfigDoc.Fields[5].Description = "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections.\n\nIf `listenSubnets` is not set, but `advertisedSubnets` is set, `listenSubnets` defaults to\n`advertisedSubnets`.\n\nIf neither `advertisedSubnets` nor `listenSubnets` is set, `listenSubnets` defaults to listen on all addresses.\n\nIPs can be excluded from the list by using negative match with `!`, e.g `!10.0.0.0/8`.\nNegative subnet matches should be specified last to filter out IPs picked by positive matches.\nIf not specified, advertised IP is selected as the first routable address of the node."
 	EtcdConfigDoc.Fields[5].Comments[encoder.LineComment] = "The `listenSubnets` field configures the networks for the etcd to listen for peer and client connections."
+	EtcdConfigDoc.Fields[6].Name = "backup"
+	EtcdConfigDoc.Fields[6].Type = "EtcdBackupConfig"
+	EtcdConfigDoc.Fields[6].Note = ""
+	EtcdConfigDoc.Fields[6].Description = "The `backup` field configures scheduled etcd snapshots.\n\nSnapshots are taken on each control plane node and stored under `/var/lib/etcd-backup`,\nand optionally uploaded to the S3-compatible storage by a single etcd member."
+	EtcdConfigDoc.Fields[6].Comments[encoder.LineComment] = "The `backup` field configures scheduled etcd snapshots."
+
+	EtcdConfigDoc.Fields[6].AddExample("", clusterEtcdBackupExample)
+	EtcdConfigDoc.Fields[7].Name = "defragmentation"
+	EtcdConfigDoc.Fields[7].Type = "EtcdDefragmentationConfig"
+	EtcdConfigDoc.Fields[7].Note = ""
+	EtcdConfigDoc.Fields[7].Description = "The `defragmentation` field configures automatic defragmentation of the etcd database.\n\nEach control plane node periodically checks the fragmentation of its etcd member database,\nand defragments it when the threshold is crossed.\nMembers are defragmented one at a time, and the leader transfers the leadership before defragmentation."
+	EtcdConfigDoc.Fields[7].Comments[encoder.LineComment] = "The `defragmentation` field configures automatic defragmentation of the etcd database."
+
+	EtcdConfigDoc.Fields[7].AddExample("", clusterEtcdDefragmentationExample)
+	EtcdConfigDoc.Fields[8].Name = "role"
+	EtcdConfigDoc.Fields[8].Type = "string"
+	EtcdConfigDoc.Fields[8].Note = ""
+	EtcdConfigDoc.Fields[8].Description = "The `role` field configures the role of the node in the etcd cluster.\n\nNodes with the `voter` role run voting etcd members.\nNodes with the `learner` role join the etcd cluster as non-voting members, and they are never promoted to voters.\nLearners serve only serializable reads, so Kubernetes API server and Talos on the node talk to the voting members listed in `externalEndpoints`.\nNodes with the `none` role don't run etcd, and Kubernetes API server on the node uses `externalEndpoints` instead.\n\nThe role is applied when the node joins the etcd cluster."
+	EtcdConfigDoc.Fields[8].Comments[encoder.LineComment] = "The `role` field configures the role of the node in the etcd cluster."
+
+	EtcdConfigDoc.Fields[8].AddExample("", "learner")
+	EtcdConfigDoc.Fields[8].Values = []string{
+		"voter",
+		"learner",
+		"none",
+	}
+	EtcdConfigDoc.Fields[9].Name = "externalEndpoints"
+	EtcdConfigDoc.Fields[9].Type = "[]string"
+	EtcdConfigDoc.Fields[9].Note = ""
+	EtcdConfigDoc.Fields[9].Description = "The `externalEndpoints` field configures the etcd endpoints for the nodes with the `learner` and `none` roles.\n\nFor the `learner` role, the endpoints should point to the voting members of the etcd cluster.\n\nExternal etcd should trust the client certificates issued by the etcd CA of the cluster."
+	EtcdConfigDoc.Fields[9].Comments[encoder.LineComment] = "The `externalEndpoints` field configures the etcd endpoints for the nodes with the `learner` and `none` roles."
+
+	EtcdConfigDoc.Fields[9].AddExample("", clusterEtcdExternalEndpointsExample)
+
+	EtcdBackupConfigDoc.Type = "EtcdBackupConfig"
+	EtcdBackupConfigDoc.Comments[encoder.LineComment] = "EtcdBackupConfig represents the etcd scheduled snapshots configuration."
+	EtcdBackupConfigDoc.Description = "EtcdBackupConfig represents the etcd scheduled snapshots configuration."
+
+	EtcdBackupConfigDoc.AddExample("", clusterEtcdBackupExample)
+	EtcdBackupConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EtcdConfig",
+			FieldName: "backup",
+		},
+	}
+	EtcdBackupConfigDoc.Fields = make([]encoder.Doc, 7)
+	EtcdBackupConfigDoc.Fields[0].Name = "enabled"
+	EtcdBackupConfigDoc.Fields[0].Type = "bool"
+	EtcdBackupConfigDoc.Fields[0].Note = ""
+	EtcdBackupConfigDoc.Fields[0].Description = "Enable scheduled etcd snapshots."
+	EtcdBackupConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable scheduled etcd snapshots."
+	EtcdBackupConfigDoc.Fields[1].Name = "interval"
+	EtcdBackupConfigDoc.Fields[1].Type = "Duration"
+	EtcdBackupConfigDoc.Fields[1].Note = ""
+	EtcdBackupConfigDoc.Fields[1].Description = "Interval between the snapshots.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 1h."
+	EtcdBackupConfigDoc.Fields[1].Comments[encoder.LineComment] = "Interval between the snapshots."
+	EtcdBackupConfigDoc.Fields[2].Name = "retentionCount"
+	EtcdBackupConfigDoc.Fields[2].Type = "int"
+	EtcdBackupConfigDoc.Fields[2].Note = ""
+	EtcdBackupConfigDoc.Fields[2].Description = "Number of the most recent snapshots to keep (both locally and in the S3 bucket).\nDefaults to 24."
+	EtcdBackupConfigDoc.Fields[2].Comments[encoder.LineComment] = "Number of the most recent snapshots to keep (both locally and in the S3 bucket)."
+	EtcdBackupConfigDoc.Fields[3].Name = "retentionMaxAge"
+	EtcdBackupConfigDoc.Fields[3].Type = "Duration"
+	EtcdBackupConfigDoc.Fields[3].Note = ""
+	EtcdBackupConfigDoc.Fields[3].Description = "Snapshots older than the specified age are removed (both locally and from the S3 bucket).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nSnapshots are not removed by age by default."
+	EtcdBackupConfigDoc.Fields[3].Comments[encoder.LineComment] = "Snapshots older than the specified age are removed (both locally and from the S3 bucket)."
+	EtcdBackupConfigDoc.Fields[4].Name = "uploader"
+	EtcdBackupConfigDoc.Fields[4].Type = "string"
+	EtcdBackupConfigDoc.Fields[4].Note = ""
+	EtcdBackupConfigDoc.Fields[4].Description = "Name of the etcd member (hostname of the node) which uploads the snapshots to the S3 bucket.\nDefaults to the current etcd leader."
+	EtcdBackupConfigDoc.Fields[4].Comments[encoder.LineComment] = "Name of the etcd member (hostname of the node) which uploads the snapshots to the S3 bucket."
+	EtcdBackupConfigDoc.Fields[5].Name = "encryptionKey"
+	EtcdBackupConfigDoc.Fields[5].Type = "string"
+	EtcdBackupConfigDoc.Fields[5].Note = ""
+	EtcdBackupConfigDoc.Fields[5].Description = "Base64 encoded 32-byte key to encrypt the snapshots with.\nSnapshots are stored unencrypted if not set."
+	EtcdBackupConfigDoc.Fields[5].Comments[encoder.LineComment] = "Base64 encoded 32-byte key to encrypt the snapshots with."
+	EtcdBackupConfigDoc.Fields[6].Name = "s3"
+	EtcdBackupConfigDoc.Fields[6].Type = "EtcdBackupS3Config"
+	EtcdBackupConfigDoc.Fields[6].Note = ""
+	EtcdBackupConfigDoc.Fields[6].Description = "S3-compatible storage to upload the snapshots to."
+	EtcdBackupConfigDoc.Fields[6].Comments[encoder.LineComment] = "S3-compatible storage to upload the snapshots to."
+
+	EtcdDefragmentationConfigDoc.Type = "EtcdDefragmentationConfig"
+	EtcdDefragmentationConfigDoc.Comments[encoder.LineComment] = "EtcdDefragmentationConfig represents the etcd automatic defragmentation configuration."
+	EtcdDefragmentationConfigDoc.Description = "EtcdDefragmentationConfig represents the etcd automatic defragmentation configuration."
+
+	EtcdDefragmentationConfigDoc.AddExample("", clusterEtcdDefragmentationExample)
+	EtcdDefragmentationConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EtcdConfig",
+			FieldName: "defragmentation",
+		},
+	}
+	EtcdDefragmentationConfigDoc.Fields = make([]encoder.Doc, 4)
+	EtcdDefragmentationConfigDoc.Fields[0].Name = "enabled"
+	EtcdDefragmentationConfigDoc.Fields[0].Type = "bool"
+	EtcdDefragmentationConfigDoc.Fields[0].Note = ""
+	EtcdDefragmentationConfigDoc.Fields[0].Description = "Enable automatic defragmentation."
+	EtcdDefragmentationConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable automatic defragmentation."
+	EtcdDefragmentationConfigDoc.Fields[1].Name = "interval"
+	EtcdDefragmentationConfigDoc.Fields[1].Type = "Duration"
+	EtcdDefragmentationConfigDoc.Fields[1].Note = ""
+	EtcdDefragmentationConfigDoc.Fields[1].Description = "Interval between the fragmentation checks.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 1h."
+	EtcdDefragmentationConfigDoc.Fields[1].Comments[encoder.LineComment] = "Interval between the fragmentation checks."
+	EtcdDefragmentationConfigDoc.Fields[2].Name = "threshold"
+	EtcdDefragmentationConfigDoc.Fields[2].Type = "int"
+	EtcdDefragmentationConfigDoc.Fields[2].Note = ""
+	EtcdDefragmentationConfigDoc.Fields[2].Description = "Percentage of the database file size not in use which triggers defragmentation.\nDefaults to 50."
+	EtcdDefragmentationConfigDoc.Fields[2].Comments[encoder.LineComment] = "Percentage of the database file size not in use which triggers defragmentation."
+	EtcdDefragmentationConfigDoc.Fields[3].Name = "minDBSize"
+	EtcdDefragmentationConfigDoc.Fields[3].Type = "DiskSize"
+	EtcdDefragmentationConfigDoc.Fields[3].Note = ""
+	EtcdDefragmentationConfigDoc.Fields[3].Description = "Databases smaller than the specified size are not defragmented.\nDefaults to 100MB."
+	EtcdDefragmentationConfigDoc.Fields[3].Comments[encoder.LineComment] = "Databases smaller than the specified size are not defragmented."
+
+	EtcdDefragmentationConfigDoc.Fields[3].AddExample("", DiskSize(100*1000*1000))
+
+	EtcdBackupS3ConfigDoc.Type = "EtcdBackupS3Config"
+	EtcdBackupS3ConfigDoc.Comments[encoder.LineComment] = "EtcdBackupS3Config represents the S3-compatible storage for etcd snapshots."
+	EtcdBackupS3ConfigDoc.Description = "EtcdBackupS3Config represents the S3-compatible storage for etcd snapshots."
+	EtcdBackupS3ConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EtcdBackupConfig",
+			FieldName: "s3",
+		},
+	}
+	EtcdBackupS3ConfigDoc.Fields = make([]encoder.Doc, 7)
+	EtcdBackupS3ConfigDoc.Fields[0].Name = "endpoint"
+	EtcdBackupS3ConfigDoc.Fields[0].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[0].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[0].Description = "The S3 endpoint URL.\nDefaults to AWS S3 endpoint for the region."
+	EtcdBackupS3ConfigDoc.Fields[0].Comments[encoder.LineComment] = "The S3 endpoint URL."
+	EtcdBackupS3ConfigDoc.Fields[1].Name = "region"
+	EtcdBackupS3ConfigDoc.Fields[1].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[1].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[1].Description = "The S3 region."
+	EtcdBackupS3ConfigDoc.Fields[1].Comments[encoder.LineComment] = "The S3 region."
+	EtcdBackupS3ConfigDoc.Fields[2].Name = "bucket"
+	EtcdBackupS3ConfigDoc.Fields[2].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[2].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[2].Description = "The bucket to upload the snapshots to."
+	EtcdBackupS3ConfigDoc.Fields[2].Comments[encoder.LineComment] = "The bucket to upload the snapshots to."
+	EtcdBackupS3ConfigDoc.Fields[3].Name = "prefix"
+	EtcdBackupS3ConfigDoc.Fields[3].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[3].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[3].Description = "The prefix for the snapshot object names."
+	EtcdBackupS3ConfigDoc.Fields[3].Comments[encoder.LineComment] = "The prefix for the snapshot object names."
+	EtcdBackupS3ConfigDoc.Fields[4].Name = "accessKeyID"
+	EtcdBackupS3ConfigDoc.Fields[4].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[4].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[4].Description = "The access key ID."
+	EtcdBackupS3ConfigDoc.Fields[4].Comments[encoder.LineComment] = "The access key ID."
+	EtcdBackupS3ConfigDoc.Fields[5].Name = "secretAccessKey"
+	EtcdBackupS3ConfigDoc.Fields[5].Type = "string"
+	EtcdBackupS3ConfigDoc.Fields[5].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[5].Description = "The secret access key."
+	EtcdBackupS3ConfigDoc.Fields[5].Comments[encoder.LineComment] = "The secret access key."
+	EtcdBackupS3ConfigDoc.Fields[6].Name = "forcePathStyle"
+	EtcdBackupS3ConfigDoc.Fields[6].Type = "bool"
+	EtcdBackupS3ConfigDoc.Fields[6].Note = ""
+	EtcdBackupS3ConfigDoc.Fields[6].Description = "Use path-style addressing for the bucket (required by most S3-compatible storage implementations)."
+	EtcdBackupS3ConfigDoc.Fields[6].Comments[encoder.LineComment] = "Use path-style addressing for the bucket (required by most S3-compatible storage implementations)."
 
 	ClusterNetworkConfigDoc.Type = "ClusterNetworkConfig"
 	ClusterNetworkConfigDoc.Comments[encoder.LineComment] = "ClusterNetworkConfig represents kube networking configuration options."
@@ -1442,7 +1651,7 @@ func init() {
 			FieldName: "partitions",
 		},
 	}
-	DiskPartitionDoc.Fields = make([]encoder.Doc, 2)
+	DiskPartitionDoc.Fields = make([]encoder.Doc, 3)
 	DiskPartitionDoc.Fields[0].Name = "size"
 	DiskPartitionDoc.Fields[0].Type = "DiskSize"
 	DiskPartitionDoc.Fields[0].Note = ""
@@ -1457,6 +1666,11 @@ func init() {
 	DiskPartitionDoc.Fields[1].Note = ""
 	DiskPartitionDoc.Fields[1].Description = "Where to mount the partition."
 	DiskPartitionDoc.Fields[1].Comments[encoder.LineComment] = "Where to mount the partition."
+	DiskPartitionDoc.Fields[2].Name = "swap"
+	DiskPartitionDoc.Fields[2].Type = "bool"
+	DiskPartitionDoc.Fields[2].Note = ""
+	DiskPartitionDoc.Fields[2].Description = "Format the partition as swap instead of XFS (see `.machine.swap.devices`).\nSwap partitions are not mounted, so the mountpoint should not be set."
+	DiskPartitionDoc.Fields[2].Comments[encoder.LineComment] = "Format the partition as swap instead of XFS (see `.machine.swap.devices`)."
 
 	EncryptionConfigDoc.Type = "EncryptionConfig"
 	EncryptionConfigDoc.Comments[encoder.LineComment] = "EncryptionConfig represents partition encryption settings."
@@ -1530,7 +1744,7 @@ func init() {
 			FieldName: "keys",
 		},
 	}
-	EncryptionKeyDoc.Fields = make([]encoder.Doc, 3)
+	EncryptionKeyDoc.Fields = make([]encoder.Doc, 5)
 	EncryptionKeyDoc.Fields[0].Name = "static"
 	EncryptionKeyDoc.Fields[0].Type = "EncryptionKeyStatic"
 	EncryptionKeyDoc.Fields[0].Note = ""
@@ -1541,11 +1755,25 @@ func init() {
 	EncryptionKeyDoc.Fields[1].Note = ""
 	EncryptionKeyDoc.Fields[1].Description = "Deterministically generated key from the node UUID and PartitionLabel."
 	EncryptionKeyDoc.Fields[1].Comments[encoder.LineComment] = "Deterministically generated key from the node UUID and PartitionLabel."
-	EncryptionKeyDoc.Fields[2].Name = "slot"
-	EncryptionKeyDoc.Fields[2].Type = "int"
+	EncryptionKeyDoc.Fields[2].Name = "tpm"
+	EncryptionKeyDoc.Fields[2].Type = "EncryptionKeyTPM"
 	EncryptionKeyDoc.Fields[2].Note = ""
-	EncryptionKeyDoc.Fields[2].Description = "Key slot number for LUKS2 encryption."
-	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[2].Description = "Random key sealed to the TPM 2.0 device and bound to the PCR values. Sealed key is stored in the LUKS2 token of the encrypted partition."
+	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Random key sealed to the TPM 2.0 device and bound to the PCR values. Sealed key is stored in the LUKS2 token of the encrypted partition."
+
+	EncryptionKeyDoc.Fields[2].AddExample("", encryptionKeyTPMExample)
+	EncryptionKeyDoc.Fields[3].Name = "kms"
+	EncryptionKeyDoc.Fields[3].Type = "EncryptionKeyKMS"
+	EncryptionKeyDoc.Fields[3].Note = ""
+	EncryptionKeyDoc.Fields[3].Description = "Random key sealed by the remote key management server. Sealed key is stored in the LUKS2 token of the encrypted partition, and it is unsealed by the key management server on every boot."
+	EncryptionKeyDoc.Fields[3].Comments[encoder.LineComment] = "Random key sealed by the remote key management server. Sealed key is stored in the LUKS2 token of the encrypted partition, and it is unsealed by the key management server on every boot."
+
+	EncryptionKeyDoc.Fields[3].AddExample("", encryptionKeyKMSExample)
+	EncryptionKeyDoc.Fields[4].Name = "slot"
+	EncryptionKeyDoc.Fields[4].Type = "int"
+	EncryptionKeyDoc.Fields[4].Note = ""
+	EncryptionKeyDoc.Fields[4].Description = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[4].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
 
 	EncryptionKeyStaticDoc.Type = "EncryptionKeyStatic"
 	EncryptionKeyStaticDoc.Comments[encoder.LineComment] = "EncryptionKeyStatic represents throw away key type."
@@ -1574,6 +1802,54 @@ func init() {
 	}
 	EncryptionKeyNodeIDDoc.Fields = make([]encoder.Doc, 0)
 
+	EncryptionKeyTPMDoc.Type = "EncryptionKeyTPM"
+	EncryptionKeyTPMDoc.Comments[encoder.LineComment] = "EncryptionKeyTPM represents random key sealed to the TPM."
+	EncryptionKeyTPMDoc.Description = "EncryptionKeyTPM represents random key sealed to the TPM."
+
+	EncryptionKeyTPMDoc.AddExample("", encryptionKeyTPMExample)
+	EncryptionKeyTPMDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "tpm",
+		},
+	}
+	EncryptionKeyTPMDoc.Fields = make([]encoder.Doc, 1)
+	EncryptionKeyTPMDoc.Fields[0].Name = "pcrs"
+	EncryptionKeyTPMDoc.Fields[0].Type = "[]int"
+	EncryptionKeyTPMDoc.Fields[0].Note = ""
+	EncryptionKeyTPMDoc.Fields[0].Description = "List of PCRs (SHA256 bank) the key is bound to. The key can only be unsealed if the PCR values match the values at the time of sealing. Defaults to PCR 7 (Secure Boot state)."
+	EncryptionKeyTPMDoc.Fields[0].Comments[encoder.LineComment] = "List of PCRs (SHA256 bank) the key is bound to. The key can only be unsealed if the PCR values match the values at the time of sealing. Defaults to PCR 7 (Secure Boot state)."
+
+	EncryptionKeyKMSDoc.Type = "EncryptionKeyKMS"
+	EncryptionKeyKMSDoc.Comments[encoder.LineComment] = "EncryptionKeyKMS represents random key sealed by the key management server."
+	EncryptionKeyKMSDoc.Description = "EncryptionKeyKMS represents random key sealed by the key management server."
+
+	EncryptionKeyKMSDoc.AddExample("", encryptionKeyKMSExample)
+	EncryptionKeyKMSDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "kms",
+		},
+	}
+	EncryptionKeyKMSDoc.Fields = make([]encoder.Doc, 3)
+	EncryptionKeyKMSDoc.Fields[0].Name = "endpoint"
+	EncryptionKeyKMSDoc.Fields[0].Type = "string"
+	EncryptionKeyKMSDoc.Fields[0].Note = ""
+	EncryptionKeyKMSDoc.Fields[0].Description = "Key management server endpoint (host:port)."
+	EncryptionKeyKMSDoc.Fields[0].Comments[encoder.LineComment] = "Key management server endpoint (host:port)."
+	EncryptionKeyKMSDoc.Fields[1].Name = "clientIdentity"
+	EncryptionKeyKMSDoc.Fields[1].Type = "PEMEncodedCertificateAndKey"
+	EncryptionKeyKMSDoc.Fields[1].Note = ""
+	EncryptionKeyKMSDoc.Fields[1].Description = "Client certificate and key used to authenticate to the key management server (mutual TLS).\nCertificate and key should be base64-encoded."
+	EncryptionKeyKMSDoc.Fields[1].Comments[encoder.LineComment] = "Client certificate and key used to authenticate to the key management server (mutual TLS)."
+
+	EncryptionKeyKMSDoc.Fields[1].AddExample("", pemEncodedCertificateExample)
+	EncryptionKeyKMSDoc.Fields[2].Name = "ca"
+	EncryptionKeyKMSDoc.Fields[2].Type = "Base64Bytes"
+	EncryptionKeyKMSDoc.Fields[2].Note = ""
+	EncryptionKeyKMSDoc.Fields[2].Description = "CA certificate to verify the key management server certificate.\nCertificate should be base64-encoded.\nIf not set, system CA certificates are used."
+	EncryptionKeyKMSDoc.Fields[2].Comments[encoder.LineComment] = "CA certificate to verify the key management server certificate."
+
 	MachineFileDoc.Type = "MachineFile"
 	MachineFileDoc.Comments[encoder.LineComment] = "MachineFile represents a file to write to disk."
 	MachineFileDoc.Description = "MachineFile represents a file to write to disk."
@@ -1635,6 +1911,54 @@ func init() {
 	ExtraHostDoc.Fields[1].Description = "The host alias."
 	ExtraHostDoc.Fields[1].Comments[encoder.LineComment] = "The host alias."
 
+	RoutingRuleDoc.Type = "RoutingRule"
+	RoutingRuleDoc.Comments[encoder.LineComment] = "RoutingRule represents a policy routing rule."
+	RoutingRuleDoc.Description = "RoutingRule represents a policy routing rule."
+
+	RoutingRuleDoc.AddExample("", networkConfigRoutingRulesExample)
+	RoutingRuleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "routingRules",
+		},
+	}
+	RoutingRuleDoc.Fields = make([]encoder.Doc, 7)
+	RoutingRuleDoc.Fields[0].Name = "priority"
+	RoutingRuleDoc.Fields[0].Type = "uint32"
+	RoutingRuleDoc.Fields[0].Note = ""
+	RoutingRuleDoc.Fields[0].Description = "The priority of the rule, rules are evaluated in the increasing order of priority.\nShould be in range 1-32765."
+	RoutingRuleDoc.Fields[0].Comments[encoder.LineComment] = "The priority of the rule, rules are evaluated in the increasing order of priority."
+	RoutingRuleDoc.Fields[1].Name = "source"
+	RoutingRuleDoc.Fields[1].Type = "string"
+	RoutingRuleDoc.Fields[1].Note = ""
+	RoutingRuleDoc.Fields[1].Description = "The source address prefix to match (optional)."
+	RoutingRuleDoc.Fields[1].Comments[encoder.LineComment] = "The source address prefix to match (optional)."
+	RoutingRuleDoc.Fields[2].Name = "destination"
+	RoutingRuleDoc.Fields[2].Type = "string"
+	RoutingRuleDoc.Fields[2].Note = ""
+	RoutingRuleDoc.Fields[2].Description = "The destination address prefix to match (optional)."
+	RoutingRuleDoc.Fields[2].Comments[encoder.LineComment] = "The destination address prefix to match (optional)."
+	RoutingRuleDoc.Fields[3].Name = "iif"
+	RoutingRuleDoc.Fields[3].Type = "string"
+	RoutingRuleDoc.Fields[3].Note = ""
+	RoutingRuleDoc.Fields[3].Description = "The incoming interface name to match (optional)."
+	RoutingRuleDoc.Fields[3].Comments[encoder.LineComment] = "The incoming interface name to match (optional)."
+	RoutingRuleDoc.Fields[4].Name = "fwMark"
+	RoutingRuleDoc.Fields[4].Type = "uint32"
+	RoutingRuleDoc.Fields[4].Note = ""
+	RoutingRuleDoc.Fields[4].Description = "The firewall mark to match (optional)."
+	RoutingRuleDoc.Fields[4].Comments[encoder.LineComment] = "The firewall mark to match (optional)."
+	RoutingRuleDoc.Fields[5].Name = "fwMask"
+	RoutingRuleDoc.Fields[5].Type = "uint32"
+	RoutingRuleDoc.Fields[5].Note = ""
+	RoutingRuleDoc.Fields[5].Description = "The mask for the firewall mark (optional)."
+	RoutingRuleDoc.Fields[5].Comments[encoder.LineComment] = "The mask for the firewall mark (optional)."
+	RoutingRuleDoc.Fields[6].Name = "table"
+	RoutingRuleDoc.Fields[6].Type = "uint32"
+	RoutingRuleDoc.Fields[6].Note = ""
+	RoutingRuleDoc.Fields[6].Description = "The routing table to look up if the rule matches."
+	RoutingRuleDoc.Fields[6].Comments[encoder.LineComment] = "The routing table to look up if the rule matches."
+
 	DeviceDoc.Type = "Device"
 	DeviceDoc.Comments[encoder.LineComment] = "Device represents a network interface."
 	DeviceDoc.Description = "Device represents a network interface."
@@ -1646,7 +1970,7 @@ func init() {
 			FieldName: "interfaces",
 		},
 	}
-	DeviceDoc.Fields = make([]encoder.Doc, 15)
+	DeviceDoc.Fields = make([]encoder.Doc, 20)
 	DeviceDoc.Fields[0].Name = "interface"
 	DeviceDoc.Fields[0].Type = "string"
 	DeviceDoc.Fields[0].Note = ""
@@ -1691,62 +2015,101 @@ func init() {
 	DeviceDoc.Fields[6].Comments[encoder.LineComment] = "Bridge specific options."
 
 	DeviceDoc.Fields[6].AddExample("", networkConfigBridgeExample)
-	DeviceDoc.Fields[7].Name = "vlans"
-	DeviceDoc.Fields[7].Type = "[]Vlan"
+	DeviceDoc.Fields[7].Name = "vxlan"
+	DeviceDoc.Fields[7].Type = "VXLAN"
 	DeviceDoc.Fields[7].Note = ""
-	DeviceDoc.Fields[7].Description = "VLAN specific options."
-	DeviceDoc.Fields[7].Comments[encoder.LineComment] = "VLAN specific options."
-	DeviceDoc.Fields[8].Name = "mtu"
-	DeviceDoc.Fields[8].Type = "int"
+	DeviceDoc.Fields[7].Description = "VXLAN specific options.\nThe interface is created as a VXLAN tunnel endpoint."
+	DeviceDoc.Fields[7].Comments[encoder.LineComment] = "VXLAN specific options."
+
+	DeviceDoc.Fields[7].AddExample("", networkConfigVXLANExample)
+	DeviceDoc.Fields[8].Name = "vrf"
+	DeviceDoc.Fields[8].Type = "VRF"
 	DeviceDoc.Fields[8].Note = ""
-	DeviceDoc.Fields[8].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
-	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "The interface's MTU."
-	DeviceDoc.Fields[9].Name = "dhcp"
-	DeviceDoc.Fields[9].Type = "bool"
+	DeviceDoc.Fields[8].Description = "VRF specific options.\nThe interface is created as a VRF device, listed interfaces are enslaved to it."
+	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "VRF specific options."
+
+	DeviceDoc.Fields[8].AddExample("", networkConfigVRFExample)
+	DeviceDoc.Fields[9].Name = "macvlan"
+	DeviceDoc.Fields[9].Type = "MACVLAN"
 	DeviceDoc.Fields[9].Note = ""
-	DeviceDoc.Fields[9].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
-	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
+	DeviceDoc.Fields[9].Description = "MACVLAN specific options.\nThe interface is created as a MACVLAN device on top of the link."
+	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "MACVLAN specific options."
 
-	DeviceDoc.Fields[9].AddExample("", true)
-	DeviceDoc.Fields[10].Name = "ignore"
-	DeviceDoc.Fields[10].Type = "bool"
+	DeviceDoc.Fields[9].AddExample("", networkConfigMACVLANExample)
+	DeviceDoc.Fields[10].Name = "vlans"
+	DeviceDoc.Fields[10].Type = "[]Vlan"
 	DeviceDoc.Fields[10].Note = ""
-	DeviceDoc.Fields[10].Description = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[11].Name = "dummy"
-	DeviceDoc.Fields[11].Type = "bool"
+	DeviceDoc.Fields[10].Description = "VLAN specific options."
+	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "VLAN specific options."
+	DeviceDoc.Fields[11].Name = "mtu"
+	DeviceDoc.Fields[11].Type = "int"
 	DeviceDoc.Fields[11].Note = ""
-	DeviceDoc.Fields[11].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
-	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
-	DeviceDoc.Fields[12].Name = "dhcpOptions"
-	DeviceDoc.Fields[12].Type = "DHCPOptions"
+	DeviceDoc.Fields[11].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
+	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "The interface's MTU."
+	DeviceDoc.Fields[12].Name = "dhcp"
+	DeviceDoc.Fields[12].Type = "bool"
 	DeviceDoc.Fields[12].Note = ""
-	DeviceDoc.Fields[12].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
-	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "DHCP specific options."
+	DeviceDoc.Fields[12].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
+	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
 
-	DeviceDoc.Fields[12].AddExample("", networkConfigDHCPOptionsExample)
-	DeviceDoc.Fields[13].Name = "wireguard"
-	DeviceDoc.Fields[13].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[12].AddExample("", true)
+	DeviceDoc.Fields[13].Name = "ignore"
+	DeviceDoc.Fields[13].Type = "bool"
 	DeviceDoc.Fields[13].Note = ""
-	DeviceDoc.Fields[13].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
-	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Wireguard specific configuration."
-
-	DeviceDoc.Fields[13].AddExample("wireguard server example", networkConfigWireguardHostExample)
-
-	DeviceDoc.Fields[13].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
-	DeviceDoc.Fields[14].Name = "vip"
-	DeviceDoc.Fields[14].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[13].Description = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[14].Name = "dummy"
+	DeviceDoc.Fields[14].Type = "bool"
 	DeviceDoc.Fields[14].Note = ""
-	DeviceDoc.Fields[14].Description = "Virtual (shared) IP address configuration."
-	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
-
-	DeviceDoc.Fields[14].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+	DeviceDoc.Fields[14].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
+	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
+	DeviceDoc.Fields[15].Name = "dhcpOptions"
+	DeviceDoc.Fields[15].Type = "DHCPOptions"
+	DeviceDoc.Fields[15].Note = ""
+	DeviceDoc.Fields[15].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
+	DeviceDoc.Fields[15].Comments[encoder.LineComment] = "DHCP specific options."
+
+	DeviceDoc.Fields[15].AddExample("", networkConfigDHCPOptionsExample)
+
+	DeviceDoc.Fields[15].AddExample("", networkConfigDHCPOptionsIPAMExample)
+	DeviceDoc.Fields[16].Name = "wireguard"
+	DeviceDoc.Fields[16].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[16].Note = ""
+	DeviceDoc.Fields[16].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
+	DeviceDoc.Fields[16].Comments[encoder.LineComment] = "Wireguard specific configuration."
+
+	DeviceDoc.Fields[16].AddExample("wireguard server example", networkConfigWireguardHostExample)
+
+	DeviceDoc.Fields[16].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
+	DeviceDoc.Fields[17].Name = "vip"
+	DeviceDoc.Fields[17].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[17].Note = ""
+	DeviceDoc.Fields[17].Description = "Virtual (shared) IP address configuration."
+	DeviceDoc.Fields[17].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
+
+	DeviceDoc.Fields[17].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+	DeviceDoc.Fields[18].Name = "ethtool"
+	DeviceDoc.Fields[18].Type = "Ethtool"
+	DeviceDoc.Fields[18].Note = ""
+	DeviceDoc.Fields[18].Description = "Ethtool settings of the physical interface.\nSettings which are not specified are left unchanged."
+	DeviceDoc.Fields[18].Comments[encoder.LineComment] = "Ethtool settings of the physical interface."
+
+	DeviceDoc.Fields[18].AddExample("", networkConfigEthtoolExample)
+	DeviceDoc.Fields[19].Name = "routerAdvertisement"
+	DeviceDoc.Fields[19].Type = "RouterAdvertisement"
+	DeviceDoc.Fields[19].Note = ""
+	DeviceDoc.Fields[19].Description = "IPv6 router advertisement (RA) and stateless address autoconfiguration (SLAAC) settings.\nIf not specified, kernel defaults are used."
+	DeviceDoc.Fields[19].Comments[encoder.LineComment] = "IPv6 router advertisement (RA) and stateless address autoconfiguration (SLAAC) settings."
+
+	DeviceDoc.Fields[19].AddExample("", networkConfigRouterAdvertisementExample)
 
 	DHCPOptionsDoc.Type = "DHCPOptions"
 	DHCPOptionsDoc.Comments[encoder.LineComment] = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
 	DHCPOptionsDoc.Description = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
 
 	DHCPOptionsDoc.AddExample("", networkConfigDHCPOptionsExample)
+
+	DHCPOptionsDoc.AddExample("", networkConfigDHCPOptionsIPAMExample)
 	DHCPOptionsDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "Device",
@@ -1757,7 +2120,7 @@ func init() {
 			FieldName: "dhcpOptions",
 		},
 	}
-	DHCPOptionsDoc.Fields = make([]encoder.Doc, 4)
+	DHCPOptionsDoc.Fields = make([]encoder.Doc, 10)
 	DHCPOptionsDoc.Fields[0].Name = "routeMetric"
 	DHCPOptionsDoc.Fields[0].Type = "uint32"
 	DHCPOptionsDoc.Fields[0].Note = ""
@@ -1778,6 +2141,105 @@ func init() {
 	DHCPOptionsDoc.Fields[3].Note = ""
 	DHCPOptionsDoc.Fields[3].Description = "Set client DUID (hex string)."
 	DHCPOptionsDoc.Fields[3].Comments[encoder.LineComment] = "Set client DUID (hex string)."
+	DHCPOptionsDoc.Fields[4].Name = "hostname"
+	DHCPOptionsDoc.Fields[4].Type = "string"
+	DHCPOptionsDoc.Fields[4].Note = ""
+	DHCPOptionsDoc.Fields[4].Description = "Hostname to send to the DHCPv4 server (option 12)."
+	DHCPOptionsDoc.Fields[4].Comments[encoder.LineComment] = "Hostname to send to the DHCPv4 server (option 12)."
+	DHCPOptionsDoc.Fields[5].Name = "vendorClass"
+	DHCPOptionsDoc.Fields[5].Type = "string"
+	DHCPOptionsDoc.Fields[5].Note = ""
+	DHCPOptionsDoc.Fields[5].Description = "Vendor class identifier to send to the DHCPv4 server (option 60)."
+	DHCPOptionsDoc.Fields[5].Comments[encoder.LineComment] = "Vendor class identifier to send to the DHCPv4 server (option 60)."
+	DHCPOptionsDoc.Fields[6].Name = "clientIdentifier"
+	DHCPOptionsDoc.Fields[6].Type = "string"
+	DHCPOptionsDoc.Fields[6].Note = ""
+	DHCPOptionsDoc.Fields[6].Description = "Client identifier to send to the DHCPv4 server (option 61, hex string)."
+	DHCPOptionsDoc.Fields[6].Comments[encoder.LineComment] = "Client identifier to send to the DHCPv4 server (option 61, hex string)."
+	DHCPOptionsDoc.Fields[7].Name = "userClasses"
+	DHCPOptionsDoc.Fields[7].Type = "[]string"
+	DHCPOptionsDoc.Fields[7].Note = ""
+	DHCPOptionsDoc.Fields[7].Description = "User classes to send to the DHCPv4 server (option 77)."
+	DHCPOptionsDoc.Fields[7].Comments[encoder.LineComment] = "User classes to send to the DHCPv4 server (option 77)."
+	DHCPOptionsDoc.Fields[8].Name = "requestOptions"
+	DHCPOptionsDoc.Fields[8].Type = "[]int"
+	DHCPOptionsDoc.Fields[8].Note = ""
+	DHCPOptionsDoc.Fields[8].Description = "Additional DHCPv4 options to request from the server (option codes).\nOptions which Talos handles (e.g. 119, domain search list) are always requested."
+	DHCPOptionsDoc.Fields[8].Comments[encoder.LineComment] = "Additional DHCPv4 options to request from the server (option codes)."
+	DHCPOptionsDoc.Fields[9].Name = "prefixDelegation"
+	DHCPOptionsDoc.Fields[9].Type = "DHCPPrefixDelegation"
+	DHCPOptionsDoc.Fields[9].Note = ""
+	DHCPOptionsDoc.Fields[9].Description = "DHCPv6 prefix delegation settings.\nIf set, a prefix is requested from the DHCPv6 server (IA_PD)."
+	DHCPOptionsDoc.Fields[9].Comments[encoder.LineComment] = "DHCPv6 prefix delegation settings."
+
+	DHCPOptionsDoc.Fields[9].AddExample("", networkConfigDHCPPrefixDelegationExample)
+
+	DHCPPrefixDelegationDoc.Type = "DHCPPrefixDelegation"
+	DHCPPrefixDelegationDoc.Comments[encoder.LineComment] = "DHCPPrefixDelegation contains settings for DHCPv6 prefix delegation."
+	DHCPPrefixDelegationDoc.Description = "DHCPPrefixDelegation contains settings for DHCPv6 prefix delegation."
+
+	DHCPPrefixDelegationDoc.AddExample("", networkConfigDHCPPrefixDelegationExample)
+	DHCPPrefixDelegationDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DHCPOptions",
+			FieldName: "prefixDelegation",
+		},
+	}
+	DHCPPrefixDelegationDoc.Fields = make([]encoder.Doc, 2)
+	DHCPPrefixDelegationDoc.Fields[0].Name = "prefixLength"
+	DHCPPrefixDelegationDoc.Fields[0].Type = "int"
+	DHCPPrefixDelegationDoc.Fields[0].Note = ""
+	DHCPPrefixDelegationDoc.Fields[0].Description = "Prefix length to request from the server (a hint, e.g. `56`).\nIf not set, the server picks the prefix length."
+	DHCPPrefixDelegationDoc.Fields[0].Comments[encoder.LineComment] = "Prefix length to request from the server (a hint, e.g. `56`)."
+	DHCPPrefixDelegationDoc.Fields[1].Name = "subnets"
+	DHCPPrefixDelegationDoc.Fields[1].Type = "[]DHCPPrefixDelegationSubnet"
+	DHCPPrefixDelegationDoc.Fields[1].Note = ""
+	DHCPPrefixDelegationDoc.Fields[1].Description = "Subnets (/64) carved from the delegated prefix and assigned to the downstream interfaces.\nDelegated prefix itself is reported in the `DelegatedPrefix` resource."
+	DHCPPrefixDelegationDoc.Fields[1].Comments[encoder.LineComment] = "Subnets (/64) carved from the delegated prefix and assigned to the downstream interfaces."
+
+	DHCPPrefixDelegationSubnetDoc.Type = "DHCPPrefixDelegationSubnet"
+	DHCPPrefixDelegationSubnetDoc.Comments[encoder.LineComment] = "DHCPPrefixDelegationSubnet describes a subnet assigned to the downstream interface."
+	DHCPPrefixDelegationSubnetDoc.Description = "DHCPPrefixDelegationSubnet describes a subnet assigned to the downstream interface."
+	DHCPPrefixDelegationSubnetDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DHCPPrefixDelegation",
+			FieldName: "subnets",
+		},
+	}
+	DHCPPrefixDelegationSubnetDoc.Fields = make([]encoder.Doc, 2)
+	DHCPPrefixDelegationSubnetDoc.Fields[0].Name = "interface"
+	DHCPPrefixDelegationSubnetDoc.Fields[0].Type = "string"
+	DHCPPrefixDelegationSubnetDoc.Fields[0].Note = ""
+	DHCPPrefixDelegationSubnetDoc.Fields[0].Description = "The downstream interface name."
+	DHCPPrefixDelegationSubnetDoc.Fields[0].Comments[encoder.LineComment] = "The downstream interface name."
+	DHCPPrefixDelegationSubnetDoc.Fields[1].Name = "subnetID"
+	DHCPPrefixDelegationSubnetDoc.Fields[1].Type = "uint32"
+	DHCPPrefixDelegationSubnetDoc.Fields[1].Note = ""
+	DHCPPrefixDelegationSubnetDoc.Fields[1].Description = "Subnet ID within the delegated prefix.\nThe interface gets address `::1` of the resulting /64 subnet."
+	DHCPPrefixDelegationSubnetDoc.Fields[1].Comments[encoder.LineComment] = "Subnet ID within the delegated prefix."
+
+	RouterAdvertisementDoc.Type = "RouterAdvertisement"
+	RouterAdvertisementDoc.Comments[encoder.LineComment] = "RouterAdvertisement contains IPv6 router advertisement settings of the link."
+	RouterAdvertisementDoc.Description = "RouterAdvertisement contains IPv6 router advertisement settings of the link."
+
+	RouterAdvertisementDoc.AddExample("", networkConfigRouterAdvertisementExample)
+	RouterAdvertisementDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "routerAdvertisement",
+		},
+	}
+	RouterAdvertisementDoc.Fields = make([]encoder.Doc, 2)
+	RouterAdvertisementDoc.Fields[0].Name = "accept"
+	RouterAdvertisementDoc.Fields[0].Type = "bool"
+	RouterAdvertisementDoc.Fields[0].Note = ""
+	RouterAdvertisementDoc.Fields[0].Description = "Accept router advertisements on the link (default is enabled)."
+	RouterAdvertisementDoc.Fields[0].Comments[encoder.LineComment] = "Accept router advertisements on the link (default is enabled)."
+	RouterAdvertisementDoc.Fields[1].Name = "slaac"
+	RouterAdvertisementDoc.Fields[1].Type = "bool"
+	RouterAdvertisementDoc.Fields[1].Note = ""
+	RouterAdvertisementDoc.Fields[1].Description = "Enables stateless address autoconfiguration (SLAAC) from router advertisements (default is enabled).\nAutoconfigured addresses are reported in the `AddressStatus` resources."
+	RouterAdvertisementDoc.Fields[1].Comments[encoder.LineComment] = "Enables stateless address autoconfiguration (SLAAC) from router advertisements (default is enabled)."
 
 	DeviceWireguardConfigDoc.Type = "DeviceWireguardConfig"
 	DeviceWireguardConfigDoc.Comments[encoder.LineComment] = "DeviceWireguardConfig contains settings for configuring Wireguard network interface."
@@ -1860,7 +2322,7 @@ func init() {
 			FieldName: "vip",
 		},
 	}
-	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 3)
+	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 5)
 	DeviceVIPConfigDoc.Fields[0].Name = "ip"
 	DeviceVIPConfigDoc.Fields[0].Type = "string"
 	DeviceVIPConfigDoc.Fields[0].Note = ""
@@ -1876,6 +2338,20 @@ func init() {
 	DeviceVIPConfigDoc.Fields[2].Note = ""
 	DeviceVIPConfigDoc.Fields[2].Description = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
 	DeviceVIPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
+	DeviceVIPConfigDoc.Fields[3].Name = "bgp"
+	DeviceVIPConfigDoc.Fields[3].Type = "VIPBGPConfig"
+	DeviceVIPConfigDoc.Fields[3].Note = ""
+	DeviceVIPConfigDoc.Fields[3].Description = "Specifies the BGP settings to announce VIP to the routers.\n\nThe node which holds the VIP announces it as /32 (or /128) prefix to the configured BGP peers,\nand withdraws it once the node loses the VIP."
+	DeviceVIPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies the BGP settings to announce VIP to the routers."
+
+	DeviceVIPConfigDoc.Fields[3].AddExample("", networkConfigVIPBGPExample)
+	DeviceVIPConfigDoc.Fields[4].Name = "kubernetesLease"
+	DeviceVIPConfigDoc.Fields[4].Type = "VIPKubernetesLeaseConfig"
+	DeviceVIPConfigDoc.Fields[4].Note = ""
+	DeviceVIPConfigDoc.Fields[4].Description = "Specifies the settings for the VIP leader election via Kubernetes Lease.\n\nControl plane nodes elect the VIP leader via etcd by default, while worker nodes\nalways use a Kubernetes Lease.\nSetting this on control plane nodes switches them to the Kubernetes Lease election."
+	DeviceVIPConfigDoc.Fields[4].Comments[encoder.LineComment] = "Specifies the settings for the VIP leader election via Kubernetes Lease."
+
+	DeviceVIPConfigDoc.Fields[4].AddExample("", networkConfigVIPKubernetesLeaseExample)
 
 	VIPEquinixMetalConfigDoc.Type = "VIPEquinixMetalConfig"
 	VIPEquinixMetalConfigDoc.Comments[encoder.LineComment] = "VIPEquinixMetalConfig contains settings for Equinix Metal VIP management."
@@ -1909,6 +2385,88 @@ func init() {
 	VIPHCloudConfigDoc.Fields[0].Description = "Specifies the Hetzner Cloud API Token."
 	VIPHCloudConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API Token."
 
+	VIPBGPConfigDoc.Type = "VIPBGPConfig"
+	VIPBGPConfigDoc.Comments[encoder.LineComment] = "VIPBGPConfig contains settings for announcing VIP via BGP."
+	VIPBGPConfigDoc.Description = "VIPBGPConfig contains settings for announcing VIP via BGP."
+
+	VIPBGPConfigDoc.AddExample("", networkConfigVIPBGPExample)
+	VIPBGPConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceVIPConfig",
+			FieldName: "bgp",
+		},
+	}
+	VIPBGPConfigDoc.Fields = make([]encoder.Doc, 4)
+	VIPBGPConfigDoc.Fields[0].Name = "localASN"
+	VIPBGPConfigDoc.Fields[0].Type = "uint32"
+	VIPBGPConfigDoc.Fields[0].Note = ""
+	VIPBGPConfigDoc.Fields[0].Description = "Specifies the local autonomous system number."
+	VIPBGPConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the local autonomous system number."
+	VIPBGPConfigDoc.Fields[1].Name = "routerID"
+	VIPBGPConfigDoc.Fields[1].Type = "string"
+	VIPBGPConfigDoc.Fields[1].Note = ""
+	VIPBGPConfigDoc.Fields[1].Description = "Specifies the BGP router ID.\nDefaults to the local IPv4 address of the BGP session, required for IPv6 peers."
+	VIPBGPConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the BGP router ID."
+	VIPBGPConfigDoc.Fields[2].Name = "holdTime"
+	VIPBGPConfigDoc.Fields[2].Type = "Duration"
+	VIPBGPConfigDoc.Fields[2].Note = ""
+	VIPBGPConfigDoc.Fields[2].Description = "Specifies the hold time proposed to the peers.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).\nDefaults to 90s."
+	VIPBGPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the hold time proposed to the peers."
+	VIPBGPConfigDoc.Fields[3].Name = "peers"
+	VIPBGPConfigDoc.Fields[3].Type = "[]VIPBGPPeer"
+	VIPBGPConfigDoc.Fields[3].Note = ""
+	VIPBGPConfigDoc.Fields[3].Description = "Specifies the list of BGP peers to announce VIP to."
+	VIPBGPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies the list of BGP peers to announce VIP to."
+
+	VIPBGPPeerDoc.Type = "VIPBGPPeer"
+	VIPBGPPeerDoc.Comments[encoder.LineComment] = "VIPBGPPeer describes the BGP peer."
+	VIPBGPPeerDoc.Description = "VIPBGPPeer describes the BGP peer."
+	VIPBGPPeerDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VIPBGPConfig",
+			FieldName: "peers",
+		},
+	}
+	VIPBGPPeerDoc.Fields = make([]encoder.Doc, 3)
+	VIPBGPPeerDoc.Fields[0].Name = "address"
+	VIPBGPPeerDoc.Fields[0].Type = "string"
+	VIPBGPPeerDoc.Fields[0].Note = ""
+	VIPBGPPeerDoc.Fields[0].Description = "Specifies the peer IP address.\nThe peer address family should match the VIP address family."
+	VIPBGPPeerDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the peer IP address."
+	VIPBGPPeerDoc.Fields[1].Name = "asn"
+	VIPBGPPeerDoc.Fields[1].Type = "uint32"
+	VIPBGPPeerDoc.Fields[1].Note = ""
+	VIPBGPPeerDoc.Fields[1].Description = "Specifies the peer autonomous system number."
+	VIPBGPPeerDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the peer autonomous system number."
+	VIPBGPPeerDoc.Fields[2].Name = "port"
+	VIPBGPPeerDoc.Fields[2].Type = "uint16"
+	VIPBGPPeerDoc.Fields[2].Note = ""
+	VIPBGPPeerDoc.Fields[2].Description = "Specifies the peer TCP port, defaults to 179."
+	VIPBGPPeerDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the peer TCP port, defaults to 179."
+
+	VIPKubernetesLeaseConfigDoc.Type = "VIPKubernetesLeaseConfig"
+	VIPKubernetesLeaseConfigDoc.Comments[encoder.LineComment] = "VIPKubernetesLeaseConfig contains settings for the VIP leader election via Kubernetes Lease."
+	VIPKubernetesLeaseConfigDoc.Description = "VIPKubernetesLeaseConfig contains settings for the VIP leader election via Kubernetes Lease."
+
+	VIPKubernetesLeaseConfigDoc.AddExample("", networkConfigVIPKubernetesLeaseExample)
+	VIPKubernetesLeaseConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceVIPConfig",
+			FieldName: "kubernetesLease",
+		},
+	}
+	VIPKubernetesLeaseConfigDoc.Fields = make([]encoder.Doc, 2)
+	VIPKubernetesLeaseConfigDoc.Fields[0].Name = "namespace"
+	VIPKubernetesLeaseConfigDoc.Fields[0].Type = "string"
+	VIPKubernetesLeaseConfigDoc.Fields[0].Note = ""
+	VIPKubernetesLeaseConfigDoc.Fields[0].Description = "Specifies the namespace of the Lease object.\nDefaults to `kube-system`."
+	VIPKubernetesLeaseConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the namespace of the Lease object."
+	VIPKubernetesLeaseConfigDoc.Fields[1].Name = "serviceAccountToken"
+	VIPKubernetesLeaseConfigDoc.Fields[1].Type = "string"
+	VIPKubernetesLeaseConfigDoc.Fields[1].Note = ""
+	VIPKubernetesLeaseConfigDoc.Fields[1].Description = "Specifies the service account token to access the Kubernetes API.\nThe service account should be allowed to get, create and update leases in the namespace.\n\nThe token is required, as the `NodeRestriction` admission plugin enabled by default\ndoesn't allow the kubelet credentials of the node to manage the leases."
+	VIPKubernetesLeaseConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies the service account token to access the Kubernetes API."
+
 	BondDoc.Type = "Bond"
 	BondDoc.Comments[encoder.LineComment] = "Bond contains the various options for configuring a bonded interface."
 	BondDoc.Description = "Bond contains the various options for configuring a bonded interface."
@@ -2096,6 +2654,191 @@ func init() {
 	BridgeDoc.Fields[1].Description = "A bridge option.\nPlease see the official kernel documentation."
 	BridgeDoc.Fields[1].Comments[encoder.LineComment] = "A bridge option."
 
+	VXLANDoc.Type = "VXLAN"
+	VXLANDoc.Comments[encoder.LineComment] = "VXLAN contains the various options for configuring a VXLAN interface."
+	VXLANDoc.Description = "VXLAN contains the various options for configuring a VXLAN interface."
+
+	VXLANDoc.AddExample("", networkConfigVXLANExample)
+	VXLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "vxlan",
+		},
+	}
+	VXLANDoc.Fields = make([]encoder.Doc, 6)
+	VXLANDoc.Fields[0].Name = "vni"
+	VXLANDoc.Fields[0].Type = "uint32"
+	VXLANDoc.Fields[0].Note = ""
+	VXLANDoc.Fields[0].Description = "The VXLAN Network Identifier (VNI)."
+	VXLANDoc.Fields[0].Comments[encoder.LineComment] = "The VXLAN Network Identifier (VNI)."
+	VXLANDoc.Fields[1].Name = "link"
+	VXLANDoc.Fields[1].Type = "string"
+	VXLANDoc.Fields[1].Note = ""
+	VXLANDoc.Fields[1].Description = "The underlying link to send the VXLAN traffic through.\nRequired if `remote` is a multicast group address."
+	VXLANDoc.Fields[1].Comments[encoder.LineComment] = "The underlying link to send the VXLAN traffic through."
+	VXLANDoc.Fields[2].Name = "local"
+	VXLANDoc.Fields[2].Type = "string"
+	VXLANDoc.Fields[2].Note = ""
+	VXLANDoc.Fields[2].Description = "The source IP address of the outgoing VXLAN packets."
+	VXLANDoc.Fields[2].Comments[encoder.LineComment] = "The source IP address of the outgoing VXLAN packets."
+	VXLANDoc.Fields[3].Name = "remote"
+	VXLANDoc.Fields[3].Type = "string"
+	VXLANDoc.Fields[3].Note = ""
+	VXLANDoc.Fields[3].Description = "The remote VXLAN tunnel endpoint address or the multicast group address."
+	VXLANDoc.Fields[3].Comments[encoder.LineComment] = "The remote VXLAN tunnel endpoint address or the multicast group address."
+	VXLANDoc.Fields[4].Name = "port"
+	VXLANDoc.Fields[4].Type = "uint16"
+	VXLANDoc.Fields[4].Note = ""
+	VXLANDoc.Fields[4].Description = "The UDP destination port.\nDefaults to 4789 (IANA-assigned VXLAN port)."
+	VXLANDoc.Fields[4].Comments[encoder.LineComment] = "The UDP destination port."
+	VXLANDoc.Fields[5].Name = "ttl"
+	VXLANDoc.Fields[5].Type = "uint8"
+	VXLANDoc.Fields[5].Note = ""
+	VXLANDoc.Fields[5].Description = "The TTL of the outgoing VXLAN packets.\nDefaults to inheriting the TTL of the inner packet."
+	VXLANDoc.Fields[5].Comments[encoder.LineComment] = "The TTL of the outgoing VXLAN packets."
+
+	VRFDoc.Type = "VRF"
+	VRFDoc.Comments[encoder.LineComment] = "VRF contains the various options for configuring a VRF interface."
+	VRFDoc.Description = "VRF contains the various options for configuring a VRF interface."
+
+	VRFDoc.AddExample("", networkConfigVRFExample)
+	VRFDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "vrf",
+		},
+	}
+	VRFDoc.Fields = make([]encoder.Doc, 2)
+	VRFDoc.Fields[0].Name = "table"
+	VRFDoc.Fields[0].Type = "uint32"
+	VRFDoc.Fields[0].Note = ""
+	VRFDoc.Fields[0].Description = "The routing table ID associated with the VRF."
+	VRFDoc.Fields[0].Comments[encoder.LineComment] = "The routing table ID associated with the VRF."
+	VRFDoc.Fields[1].Name = "interfaces"
+	VRFDoc.Fields[1].Type = "[]string"
+	VRFDoc.Fields[1].Note = ""
+	VRFDoc.Fields[1].Description = "The interfaces enslaved to the VRF."
+	VRFDoc.Fields[1].Comments[encoder.LineComment] = "The interfaces enslaved to the VRF."
+
+	MACVLANDoc.Type = "MACVLAN"
+	MACVLANDoc.Comments[encoder.LineComment] = "MACVLAN contains the various options for configuring a MACVLAN interface."
+	MACVLANDoc.Description = "MACVLAN contains the various options for configuring a MACVLAN interface."
+
+	MACVLANDoc.AddExample("", networkConfigMACVLANExample)
+	MACVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "macvlan",
+		},
+	}
+	MACVLANDoc.Fields = make([]encoder.Doc, 2)
+	MACVLANDoc.Fields[0].Name = "link"
+	MACVLANDoc.Fields[0].Type = "string"
+	MACVLANDoc.Fields[0].Note = ""
+	MACVLANDoc.Fields[0].Description = "The parent link of the MACVLAN interface."
+	MACVLANDoc.Fields[0].Comments[encoder.LineComment] = "The parent link of the MACVLAN interface."
+	MACVLANDoc.Fields[1].Name = "mode"
+	MACVLANDoc.Fields[1].Type = "string"
+	MACVLANDoc.Fields[1].Note = ""
+	MACVLANDoc.Fields[1].Description = "The MACVLAN mode.\nDefaults to `bridge`."
+	MACVLANDoc.Fields[1].Comments[encoder.LineComment] = "The MACVLAN mode."
+	MACVLANDoc.Fields[1].Values = []string{
+		"private",
+		"vepa",
+		"bridge",
+		"passthru",
+		"source",
+	}
+
+	EthtoolDoc.Type = "Ethtool"
+	EthtoolDoc.Comments[encoder.LineComment] = "Ethtool represents ethtool settings of the link."
+	EthtoolDoc.Description = "Ethtool represents ethtool settings of the link."
+
+	EthtoolDoc.AddExample("", networkConfigEthtoolExample)
+	EthtoolDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "ethtool",
+		},
+	}
+	EthtoolDoc.Fields = make([]encoder.Doc, 5)
+	EthtoolDoc.Fields[0].Name = "rings"
+	EthtoolDoc.Fields[0].Type = "EthtoolRings"
+	EthtoolDoc.Fields[0].Note = ""
+	EthtoolDoc.Fields[0].Description = "RX/TX ring buffer sizes."
+	EthtoolDoc.Fields[0].Comments[encoder.LineComment] = "RX/TX ring buffer sizes."
+	EthtoolDoc.Fields[1].Name = "channels"
+	EthtoolDoc.Fields[1].Type = "EthtoolChannels"
+	EthtoolDoc.Fields[1].Note = ""
+	EthtoolDoc.Fields[1].Description = "The number of RX/TX/combined channels (queues)."
+	EthtoolDoc.Fields[1].Comments[encoder.LineComment] = "The number of RX/TX/combined channels (queues)."
+	EthtoolDoc.Fields[2].Name = "features"
+	EthtoolDoc.Fields[2].Type = "map[string]bool"
+	EthtoolDoc.Fields[2].Note = ""
+	EthtoolDoc.Fields[2].Description = "Features (offloads) to enable or disable.\nFeature names are kernel feature names, e.g. `rx-gro`, `rx-lro`, `tx-tcp-segmentation`.\nCurrent features of the link are reported in the `LinkStatus` resource."
+	EthtoolDoc.Fields[2].Comments[encoder.LineComment] = "Features (offloads) to enable or disable."
+	EthtoolDoc.Fields[3].Name = "speed"
+	EthtoolDoc.Fields[3].Type = "uint32"
+	EthtoolDoc.Fields[3].Note = ""
+	EthtoolDoc.Fields[3].Description = "Forced link speed in Mbit/s.\nSetting the speed disables autonegotiation."
+	EthtoolDoc.Fields[3].Comments[encoder.LineComment] = "Forced link speed in Mbit/s."
+	EthtoolDoc.Fields[4].Name = "duplex"
+	EthtoolDoc.Fields[4].Type = "string"
+	EthtoolDoc.Fields[4].Note = ""
+	EthtoolDoc.Fields[4].Description = "Forced link duplex mode, used only if the speed is set.\nDefaults to `full`."
+	EthtoolDoc.Fields[4].Comments[encoder.LineComment] = "Forced link duplex mode, used only if the speed is set."
+	EthtoolDoc.Fields[4].Values = []string{
+		"full",
+		"half",
+	}
+
+	EthtoolRingsDoc.Type = "EthtoolRings"
+	EthtoolRingsDoc.Comments[encoder.LineComment] = "EthtoolRings represents ring buffer sizes of the link."
+	EthtoolRingsDoc.Description = "EthtoolRings represents ring buffer sizes of the link."
+	EthtoolRingsDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Ethtool",
+			FieldName: "rings",
+		},
+	}
+	EthtoolRingsDoc.Fields = make([]encoder.Doc, 2)
+	EthtoolRingsDoc.Fields[0].Name = "rx"
+	EthtoolRingsDoc.Fields[0].Type = "uint32"
+	EthtoolRingsDoc.Fields[0].Note = ""
+	EthtoolRingsDoc.Fields[0].Description = "RX ring buffer size."
+	EthtoolRingsDoc.Fields[0].Comments[encoder.LineComment] = "RX ring buffer size."
+	EthtoolRingsDoc.Fields[1].Name = "tx"
+	EthtoolRingsDoc.Fields[1].Type = "uint32"
+	EthtoolRingsDoc.Fields[1].Note = ""
+	EthtoolRingsDoc.Fields[1].Description = "TX ring buffer size."
+	EthtoolRingsDoc.Fields[1].Comments[encoder.LineComment] = "TX ring buffer size."
+
+	EthtoolChannelsDoc.Type = "EthtoolChannels"
+	EthtoolChannelsDoc.Comments[encoder.LineComment] = "EthtoolChannels represents the number of channels of the link."
+	EthtoolChannelsDoc.Description = "EthtoolChannels represents the number of channels of the link."
+	EthtoolChannelsDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Ethtool",
+			FieldName: "channels",
+		},
+	}
+	EthtoolChannelsDoc.Fields = make([]encoder.Doc, 3)
+	EthtoolChannelsDoc.Fields[0].Name = "rx"
+	EthtoolChannelsDoc.Fields[0].Type = "uint32"
+	EthtoolChannelsDoc.Fields[0].Note = ""
+	EthtoolChannelsDoc.Fields[0].Description = "The number of RX channels."
+	EthtoolChannelsDoc.Fields[0].Comments[encoder.LineComment] = "The number of RX channels."
+	EthtoolChannelsDoc.Fields[1].Name = "tx"
+	EthtoolChannelsDoc.Fields[1].Type = "uint32"
+	EthtoolChannelsDoc.Fields[1].Note = ""
+	EthtoolChannelsDoc.Fields[1].Description = "The number of TX channels."
+	EthtoolChannelsDoc.Fields[1].Comments[encoder.LineComment] = "The number of TX channels."
+	EthtoolChannelsDoc.Fields[2].Name = "combined"
+	EthtoolChannelsDoc.Fields[2].Type = "uint32"
+	EthtoolChannelsDoc.Fields[2].Note = ""
+	EthtoolChannelsDoc.Fields[2].Description = "The number of combined RX/TX channels."
+	EthtoolChannelsDoc.Fields[2].Comments[encoder.LineComment] = "The number of combined RX/TX channels."
+
 	VlanDoc.Type = "Vlan"
 	VlanDoc.Comments[encoder.LineComment] = "Vlan represents vlan settings for a device."
 	VlanDoc.Description = "Vlan represents vlan settings for a device."
@@ -2157,7 +2900,7 @@ func init() {
 			FieldName: "routes",
 		},
 	}
-	RouteDoc.Fields = make([]encoder.Doc, 5)
+	RouteDoc.Fields = make([]encoder.Doc, 6)
 	RouteDoc.Fields[0].Name = "network"
 	RouteDoc.Fields[0].Type = "string"
 	RouteDoc.Fields[0].Note = ""
@@ -2183,6 +2926,11 @@ func init() {
 	RouteDoc.Fields[4].Note = ""
 	RouteDoc.Fields[4].Description = "The optional MTU for the route."
 	RouteDoc.Fields[4].Comments[encoder.LineComment] = "The optional MTU for the route."
+	RouteDoc.Fields[5].Name = "table"
+	RouteDoc.Fields[5].Type = "uint32"
+	RouteDoc.Fields[5].Note = ""
+	RouteDoc.Fields[5].Description = "The optional routing table for the route.\nDefaults to the main routing table."
+	RouteDoc.Fields[5].Comments[encoder.LineComment] = "The optional routing table for the route."
 
 	RegistryMirrorConfigDoc.Type = "RegistryMirrorConfig"
 	RegistryMirrorConfigDoc.Comments[encoder.LineComment] = "RegistryMirrorConfig represents mirror configuration for a registry."
@@ -2296,6 +3044,31 @@ func init() {
 	RegistryTLSConfigDoc.Fields[2].Description = "Skip TLS server certificate verification (not recommended)."
 	RegistryTLSConfigDoc.Fields[2].Comments[encoder.LineComment] = "Skip TLS server certificate verification (not recommended)."
 
+	LoggingSpoolConfigDoc.Type = "LoggingSpoolConfig"
+	LoggingSpoolConfigDoc.Comments[encoder.LineComment] = "LoggingSpoolConfig struct configures on-disk spool for the logging destination."
+	LoggingSpoolConfigDoc.Description = "LoggingSpoolConfig struct configures on-disk spool for the logging destination."
+
+	LoggingSpoolConfigDoc.AddExample("", loggingSpoolExample)
+	LoggingSpoolConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "spool",
+		},
+	}
+	LoggingSpoolConfigDoc.Fields = make([]encoder.Doc, 2)
+	LoggingSpoolConfigDoc.Fields[0].Name = "enabled"
+	LoggingSpoolConfigDoc.Fields[0].Type = "bool"
+	LoggingSpoolConfigDoc.Fields[0].Note = ""
+	LoggingSpoolConfigDoc.Fields[0].Description = "Enable on-disk spool."
+	LoggingSpoolConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable on-disk spool."
+	LoggingSpoolConfigDoc.Fields[1].Name = "maxSize"
+	LoggingSpoolConfigDoc.Fields[1].Type = "DiskSize"
+	LoggingSpoolConfigDoc.Fields[1].Note = ""
+	LoggingSpoolConfigDoc.Fields[1].Description = "Maximum size of the spool: either bytes or human readable representation.\nWhen the spool is full, oldest log messages are dropped.\nDefaults to 64 MiB."
+	LoggingSpoolConfigDoc.Fields[1].Comments[encoder.LineComment] = "Maximum size of the spool: either bytes or human readable representation."
+
+	LoggingSpoolConfigDoc.Fields[1].AddExample("", DiskSize(100000000))
+
 	SystemDiskEncryptionConfigDoc.Type = "SystemDiskEncryptionConfig"
 	SystemDiskEncryptionConfigDoc.Comments[encoder.LineComment] = "SystemDiskEncryptionConfig specifies system disk partitions encryption settings."
 	SystemDiskEncryptionConfigDoc.Description = "SystemDiskEncryptionConfig specifies system disk partitions encryption settings."
@@ -2330,7 +3103,7 @@ func init() {
 			FieldName: "features",
 		},
 	}
-	FeaturesConfigDoc.Fields = make([]encoder.Doc, 4)
+	FeaturesConfigDoc.Fields = make([]encoder.Doc, 5)
 	FeaturesConfigDoc.Fields[0].Name = "rbac"
 	FeaturesConfigDoc.Fields[0].Type = "bool"
 	FeaturesConfigDoc.Fields[0].Note = ""
@@ -2353,6 +3126,13 @@ func init() {
 	FeaturesConfigDoc.Fields[3].Note = ""
 	FeaturesConfigDoc.Fields[3].Description = "Enable checks for extended key usage of client certificates in apid."
 	FeaturesConfigDoc.Fields[3].Comments[encoder.LineComment] = "Enable checks for extended key usage of client certificates in apid."
+	FeaturesConfigDoc.Fields[4].Name = "hostDNS"
+	FeaturesConfigDoc.Fields[4].Type = "HostDNSConfig"
+	FeaturesConfigDoc.Fields[4].Note = ""
+	FeaturesConfigDoc.Fields[4].Description = "Configure Talos host DNS caching resolver.\n\nWhen enabled, Talos runs a caching DNS forwarder on a link-local address,\nand /etc/resolv.conf (and the kubelet) is configured to use it."
+	FeaturesConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configure Talos host DNS caching resolver."
+
+	FeaturesConfigDoc.Fields[4].AddExample("", hostDNSConfigExample)
 
 	KubernetesTalosAPIAccessConfigDoc.Type = "KubernetesTalosAPIAccessConfig"
 	KubernetesTalosAPIAccessConfigDoc.Comments[encoder.LineComment] = "KubernetesTalosAPIAccessConfig describes the configuration for the Talos API access from Kubernetes pods."
@@ -2382,6 +3162,24 @@ func init() {
 	KubernetesTalosAPIAccessConfigDoc.Fields[2].Description = "The list of Kubernetes namespaces Talos API access is available from."
 	KubernetesTalosAPIAccessConfigDoc.Fields[2].Comments[encoder.LineComment] = "The list of Kubernetes namespaces Talos API access is available from."
 
+	HostDNSConfigDoc.Type = "HostDNSConfig"
+	HostDNSConfigDoc.Comments[encoder.LineComment] = "HostDNSConfig describes the configuration for the host DNS caching resolver."
+	HostDNSConfigDoc.Description = "HostDNSConfig describes the configuration for the host DNS caching resolver."
+
+	HostDNSConfigDoc.AddExample("", hostDNSConfigExample)
+	HostDNSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "hostDNS",
+		},
+	}
+	HostDNSConfigDoc.Fields = make([]encoder.Doc, 1)
+	HostDNSConfigDoc.Fields[0].Name = "enabled"
+	HostDNSConfigDoc.Fields[0].Type = "bool"
+	HostDNSConfigDoc.Fields[0].Note = ""
+	HostDNSConfigDoc.Fields[0].Description = "Enable host DNS caching resolver."
+	HostDNSConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable host DNS caching resolver."
+
 	VolumeMountConfigDoc.Type = "VolumeMountConfig"
 	VolumeMountConfigDoc.Comments[encoder.LineComment] = "VolumeMountConfig struct describes extra volume mount for the static pods."
 	VolumeMountConfigDoc.Description = "VolumeMountConfig struct describes extra volume mount for the static pods."
@@ -2632,16 +3430,18 @@ func init() {
 			FieldName: "destinations",
 		},
 	}
-	LoggingDestinationDoc.Fields = make([]encoder.Doc, 2)
+	LoggingDestinationDoc.Fields = make([]encoder.Doc, 4)
 	LoggingDestinationDoc.Fields[0].Name = "endpoint"
 	LoggingDestinationDoc.Fields[0].Type = "Endpoint"
 	LoggingDestinationDoc.Fields[0].Note = ""
-	LoggingDestinationDoc.Fields[0].Description = "Where to send logs. Supported protocols are \"tcp\" and \"udp\"."
+	LoggingDestinationDoc.Fields[0].Description = "Where to send logs. Supported protocols are \"tcp\" and \"udp\".\nThe \"otlp\" format supports only \"tcp\" (OTLP over gRPC)."
 	LoggingDestinationDoc.Fields[0].Comments[encoder.LineComment] = "Where to send logs. Supported protocols are \"tcp\" and \"udp\"."
 
 	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample1)
 
 	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample2)
+
+	LoggingDestinationDoc.Fields[0].AddExample("", loggingEndpointExample3)
 	LoggingDestinationDoc.Fields[1].Name = "format"
 	LoggingDestinationDoc.Fields[1].Type = "string"
 	LoggingDestinationDoc.Fields[1].Note = ""
@@ -2649,7 +3449,53 @@ func init() {
 	LoggingDestinationDoc.Fields[1].Comments[encoder.LineComment] = "Logs format."
 	LoggingDestinationDoc.Fields[1].Values = []string{
 		"json_lines",
+		"syslog",
+		"otlp",
+	}
+	LoggingDestinationDoc.Fields[2].Name = "tls"
+	LoggingDestinationDoc.Fields[2].Type = "LoggingTLSConfig"
+	LoggingDestinationDoc.Fields[2].Note = ""
+	LoggingDestinationDoc.Fields[2].Description = "TLS configuration for the logging endpoint.\nSupported only for \"syslog\" and \"otlp\" formats over \"tcp\"."
+	LoggingDestinationDoc.Fields[2].Comments[encoder.LineComment] = "TLS configuration for the logging endpoint."
+
+	LoggingDestinationDoc.Fields[2].AddExample("", loggingTLSExample)
+	LoggingDestinationDoc.Fields[3].Name = "spool"
+	LoggingDestinationDoc.Fields[3].Type = "LoggingSpoolConfig"
+	LoggingDestinationDoc.Fields[3].Note = ""
+	LoggingDestinationDoc.Fields[3].Description = "On-disk spool configuration for the logging endpoint.\nWhen enabled, logs which can't be delivered are stored on the EPHEMERAL partition\nand replayed in order once the endpoint is reachable again."
+	LoggingDestinationDoc.Fields[3].Comments[encoder.LineComment] = "On-disk spool configuration for the logging endpoint."
+
+	LoggingDestinationDoc.Fields[3].AddExample("", loggingSpoolExample)
+
+	LoggingTLSConfigDoc.Type = "LoggingTLSConfig"
+	LoggingTLSConfigDoc.Comments[encoder.LineComment] = "LoggingTLSConfig struct configures TLS for the logging destination."
+	LoggingTLSConfigDoc.Description = "LoggingTLSConfig struct configures TLS for the logging destination."
+
+	LoggingTLSConfigDoc.AddExample("", loggingTLSExample)
+	LoggingTLSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "tls",
+		},
 	}
+	LoggingTLSConfigDoc.Fields = make([]encoder.Doc, 3)
+	LoggingTLSConfigDoc.Fields[0].Name = "clientIdentity"
+	LoggingTLSConfigDoc.Fields[0].Type = "PEMEncodedCertificateAndKey"
+	LoggingTLSConfigDoc.Fields[0].Note = ""
+	LoggingTLSConfigDoc.Fields[0].Description = "Enable mutual TLS authentication with the logging endpoint.\nClient certificate and key should be base64-encoded."
+	LoggingTLSConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable mutual TLS authentication with the logging endpoint."
+
+	LoggingTLSConfigDoc.Fields[0].AddExample("", pemEncodedCertificateExample)
+	LoggingTLSConfigDoc.Fields[1].Name = "ca"
+	LoggingTLSConfigDoc.Fields[1].Type = "Base64Bytes"
+	LoggingTLSConfigDoc.Fields[1].Note = ""
+	LoggingTLSConfigDoc.Fields[1].Description = "CA certificate to add the list of trusted certificates.\nCertificate should be base64-encoded."
+	LoggingTLSConfigDoc.Fields[1].Comments[encoder.LineComment] = "CA certificate to add the list of trusted certificates."
+	LoggingTLSConfigDoc.Fields[2].Name = "insecureSkipVerify"
+	LoggingTLSConfigDoc.Fields[2].Type = "bool"
+	LoggingTLSConfigDoc.Fields[2].Note = ""
+	LoggingTLSConfigDoc.Fields[2].Description = "Skip TLS server certificate verification (not recommended)."
+	LoggingTLSConfigDoc.Fields[2].Comments[encoder.LineComment] = "Skip TLS server certificate verification (not recommended)."
 
 	KernelConfigDoc.Type = "KernelConfig"
 	KernelConfigDoc.Comments[encoder.LineComment] = "KernelConfig struct configures Talos Linux kernel."
@@ -2669,6 +3515,93 @@ func init() {
 	KernelConfigDoc.Fields[0].Description = "Kernel modules to load."
 	KernelConfigDoc.Fields[0].Comments[encoder.LineComment] = "Kernel modules to load."
 
+	SwapConfigDoc.Type = "SwapConfig"
+	SwapConfigDoc.Comments[encoder.LineComment] = "SwapConfig struct configures swap."
+	SwapConfigDoc.Description = "SwapConfig struct configures swap."
+
+	SwapConfigDoc.AddExample("", machineSwapExample)
+	SwapConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "swap",
+		},
+	}
+	SwapConfigDoc.Fields = make([]encoder.Doc, 3)
+	SwapConfigDoc.Fields[0].Name = "zram"
+	SwapConfigDoc.Fields[0].Type = "ZramConfig"
+	SwapConfigDoc.Fields[0].Note = ""
+	SwapConfigDoc.Fields[0].Description = "zram-backed (compressed in-memory) swap configuration."
+	SwapConfigDoc.Fields[0].Comments[encoder.LineComment] = "zram-backed (compressed in-memory) swap configuration."
+	SwapConfigDoc.Fields[1].Name = "devices"
+	SwapConfigDoc.Fields[1].Type = "[]SwapDeviceConfig"
+	SwapConfigDoc.Fields[1].Note = ""
+	SwapConfigDoc.Fields[1].Description = "Swap partitions."
+	SwapConfigDoc.Fields[1].Comments[encoder.LineComment] = "Swap partitions."
+	SwapConfigDoc.Fields[2].Name = "kubeletSwapBehavior"
+	SwapConfigDoc.Fields[2].Type = "string"
+	SwapConfigDoc.Fields[2].Note = ""
+	SwapConfigDoc.Fields[2].Description = "Kubelet swap behavior: `LimitedSwap` or `UnlimitedSwap`.\nDefaults to `LimitedSwap`."
+	SwapConfigDoc.Fields[2].Comments[encoder.LineComment] = "Kubelet swap behavior: `LimitedSwap` or `UnlimitedSwap`."
+
+	ZramConfigDoc.Type = "ZramConfig"
+	ZramConfigDoc.Comments[encoder.LineComment] = "ZramConfig struct configures zram swap device."
+	ZramConfigDoc.Description = "ZramConfig struct configures zram swap device."
+	ZramConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "SwapConfig",
+			FieldName: "zram",
+		},
+	}
+	ZramConfigDoc.Fields = make([]encoder.Doc, 3)
+	ZramConfigDoc.Fields[0].Name = "size"
+	ZramConfigDoc.Fields[0].Type = "DiskSize"
+	ZramConfigDoc.Fields[0].Note = ""
+	ZramConfigDoc.Fields[0].Description = "zram device size: either bytes or human readable representation."
+	ZramConfigDoc.Fields[0].Comments[encoder.LineComment] = "zram device size: either bytes or human readable representation."
+
+	ZramConfigDoc.Fields[0].AddExample("", DiskSize(2*1024*1024*1024))
+	ZramConfigDoc.Fields[1].Name = "algorithm"
+	ZramConfigDoc.Fields[1].Type = "string"
+	ZramConfigDoc.Fields[1].Note = ""
+	ZramConfigDoc.Fields[1].Description = "Compression algorithm, kernel default if not set."
+	ZramConfigDoc.Fields[1].Comments[encoder.LineComment] = "Compression algorithm, kernel default if not set."
+	ZramConfigDoc.Fields[1].Values = []string{
+		"lzo",
+		"lzo-rle",
+		"lz4",
+		"lz4hc",
+		"zstd",
+		"842",
+	}
+	ZramConfigDoc.Fields[2].Name = "priority"
+	ZramConfigDoc.Fields[2].Type = "int"
+	ZramConfigDoc.Fields[2].Note = ""
+	ZramConfigDoc.Fields[2].Description = "Swap priority, defaults to 100 (zram is preferred over swap partitions)."
+	ZramConfigDoc.Fields[2].Comments[encoder.LineComment] = "Swap priority, defaults to 100 (zram is preferred over swap partitions)."
+
+	SwapDeviceConfigDoc.Type = "SwapDeviceConfig"
+	SwapDeviceConfigDoc.Comments[encoder.LineComment] = "SwapDeviceConfig struct configures swap partition."
+	SwapDeviceConfigDoc.Description = "SwapDeviceConfig struct configures swap partition."
+	SwapDeviceConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "SwapConfig",
+			FieldName: "devices",
+		},
+	}
+	SwapDeviceConfigDoc.Fields = make([]encoder.Doc, 2)
+	SwapDeviceConfigDoc.Fields[0].Name = "device"
+	SwapDeviceConfigDoc.Fields[0].Type = "string"
+	SwapDeviceConfigDoc.Fields[0].Note = ""
+	SwapDeviceConfigDoc.Fields[0].Description = "Path to the swap partition.\n\nThe partition is formatted as swap if it's empty (e.g. a partition created via `.machine.disks` with `swap: true`).\nPartitions with any other data are never formatted."
+	SwapDeviceConfigDoc.Fields[0].Comments[encoder.LineComment] = "Path to the swap partition."
+
+	SwapDeviceConfigDoc.Fields[0].AddExample("", "/dev/sdb2")
+	SwapDeviceConfigDoc.Fields[1].Name = "priority"
+	SwapDeviceConfigDoc.Fields[1].Type = "int"
+	SwapDeviceConfigDoc.Fields[1].Note = ""
+	SwapDeviceConfigDoc.Fields[1].Description = "Swap priority, kernel default if not set."
+	SwapDeviceConfigDoc.Fields[1].Comments[encoder.LineComment] = "Swap priority, kernel default if not set."
+
 	KernelModuleConfigDoc.Type = "KernelModuleConfig"
 	KernelModuleConfigDoc.Comments[encoder.LineComment] = "KernelModuleConfig struct configures Linux kernel modules to load."
 	KernelModuleConfigDoc.Description = "KernelModuleConfig struct configures Linux kernel modules to load."
@@ -2795,6 +3728,18 @@ func (_ EtcdConfig) Doc() *encoder.Doc {
 	return &EtcdConfigDoc
 }
 
+func (_ EtcdBackupConfig) Doc() *encoder.Doc {
+	return &EtcdBackupConfigDoc
+}
+
+func (_ EtcdDefragmentationConfig) Doc() *encoder.Doc {
+	return &EtcdDefragmentationConfigDoc
+}
+
+func (_ EtcdBackupS3Config) Doc() *encoder.Doc {
+	return &EtcdBackupS3ConfigDoc
+}
+
 func (_ ClusterNetworkConfig) Doc() *encoder.Doc {
 	return &ClusterNetworkConfigDoc
 }
@@ -2835,6 +3780,14 @@ func (_ EncryptionKeyNodeID) Doc() *encoder.Doc {
 	return &EncryptionKeyNodeIDDoc
 }
 
+func (_ EncryptionKeyTPM) Doc() *encoder.Doc {
+	return &EncryptionKeyTPMDoc
+}
+
+func (_ EncryptionKeyKMS) Doc() *encoder.Doc {
+	return &EncryptionKeyKMSDoc
+}
+
 func (_ MachineFile) Doc() *encoder.Doc {
 	return &MachineFileDoc
 }
@@ -2843,6 +3796,10 @@ func (_ ExtraHost) Doc() *encoder.Doc {
 	return &ExtraHostDoc
 }
 
+func (_ RoutingRule) Doc() *encoder.Doc {
+	return &RoutingRuleDoc
+}
+
 func (_ Device) Doc() *encoder.Doc {
 	return &DeviceDoc
 }
@@ -2851,6 +3808,18 @@ func (_ DHCPOptions) Doc() *encoder.Doc {
 	return &DHCPOptionsDoc
 }
 
+func (_ DHCPPrefixDelegation) Doc() *encoder.Doc {
+	return &DHCPPrefixDelegationDoc
+}
+
+func (_ DHCPPrefixDelegationSubnet) Doc() *encoder.Doc {
+	return &DHCPPrefixDelegationSubnetDoc
+}
+
+func (_ RouterAdvertisement) Doc() *encoder.Doc {
+	return &RouterAdvertisementDoc
+}
+
 func (_ DeviceWireguardConfig) Doc() *encoder.Doc {
 	return &DeviceWireguardConfigDoc
 }
@@ -2871,6 +3840,18 @@ func (_ VIPHCloudConfig) Doc() *encoder.Doc {
 	return &VIPHCloudConfigDoc
 }
 
+func (_ VIPBGPConfig) Doc() *encoder.Doc {
+	return &VIPBGPConfigDoc
+}
+
+func (_ VIPBGPPeer) Doc() *encoder.Doc {
+	return &VIPBGPPeerDoc
+}
+
+func (_ VIPKubernetesLeaseConfig) Doc() *encoder.Doc {
+	return &VIPKubernetesLeaseConfigDoc
+}
+
 func (_ Bond) Doc() *encoder.Doc {
 	return &BondDoc
 }
@@ -2883,6 +3864,30 @@ func (_ Bridge) Doc() *encoder.Doc {
 	return &BridgeDoc
 }
 
+func (_ VXLAN) Doc() *encoder.Doc {
+	return &VXLANDoc
+}
+
+func (_ VRF) Doc() *encoder.Doc {
+	return &VRFDoc
+}
+
+func (_ MACVLAN) Doc() *encoder.Doc {
+	return &MACVLANDoc
+}
+
+func (_ Ethtool) Doc() *encoder.Doc {
+	return &EthtoolDoc
+}
+
+func (_ EthtoolRings) Doc() *encoder.Doc {
+	return &EthtoolRingsDoc
+}
+
+func (_ EthtoolChannels) Doc() *encoder.Doc {
+	return &EthtoolChannelsDoc
+}
+
 func (_ Vlan) Doc() *encoder.Doc {
 	return &VlanDoc
 }
@@ -2907,6 +3912,10 @@ func (_ RegistryTLSConfig) Doc() *encoder.Doc {
 	return &RegistryTLSConfigDoc
 }
 
+func (_ LoggingSpoolConfig) Doc() *encoder.Doc {
+	return &LoggingSpoolConfigDoc
+}
+
 func (_ SystemDiskEncryptionConfig) Doc() *encoder.Doc {
 	return &SystemDiskEncryptionConfigDoc
 }
@@ -2919,6 +3928,10 @@ func (_ KubernetesTalosAPIAccessConfig) Doc() *encoder.Doc {
 	return &KubernetesTalosAPIAccessConfigDoc
 }
 
+func (_ HostDNSConfig) Doc() *encoder.Doc {
+	return &HostDNSConfigDoc
+}
+
 func (_ VolumeMountConfig) Doc() *encoder.Doc {
 	return &VolumeMountConfigDoc
 }
@@ -2963,10 +3976,26 @@ func (_ LoggingDestination) Doc() *encoder.Doc {
 	return &LoggingDestinationDoc
 }
 
+func (_ LoggingTLSConfig) Doc() *encoder.Doc {
+	return &LoggingTLSConfigDoc
+}
+
 func (_ KernelConfig) Doc() *encoder.Doc {
 	return &KernelConfigDoc
 }
 
+func (_ SwapConfig) Doc() *encoder.Doc {
+	return &SwapConfigDoc
+}
+
+func (_ ZramConfig) Doc() *encoder.Doc {
+	return &ZramConfigDoc
+}
+
+func (_ SwapDeviceConfig) Doc() *encoder.Doc {
+	return &SwapDeviceConfigDoc
+}
+
 func (_ KernelModuleConfig) Doc() *encoder.Doc {
 	return &KernelModuleConfigDoc
 }
@@ -3003,6 +4032,9 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ProxyConfigDoc,
 			&SchedulerConfigDoc,
 			&EtcdConfigDoc,
+			&EtcdBackupConfigDoc,
+			&EtcdDefragmentationConfigDoc,
+			&EtcdBackupS3ConfigDoc,
 			&ClusterNetworkConfigDoc,
 			&CNIConfigDoc,
 			&ExternalCloudProviderConfigDoc,
@@ -3013,27 +4045,44 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&EncryptionKeyDoc,
 			&EncryptionKeyStaticDoc,
 			&EncryptionKeyNodeIDDoc,
+			&EncryptionKeyTPMDoc,
+			&EncryptionKeyKMSDoc,
 			&MachineFileDoc,
 			&ExtraHostDoc,
+			&RoutingRuleDoc,
 			&DeviceDoc,
 			&DHCPOptionsDoc,
+			&DHCPPrefixDelegationDoc,
+			&DHCPPrefixDelegationSubnetDoc,
+			&RouterAdvertisementDoc,
 			&DeviceWireguardConfigDoc,
 			&DeviceWireguardPeerDoc,
 			&DeviceVIPConfigDoc,
 			&VIPEquinixMetalConfigDoc,
 			&VIPHCloudConfigDoc,
+			&VIPBGPConfigDoc,
+			&VIPBGPPeerDoc,
+			&VIPKubernetesLeaseConfigDoc,
 			&BondDoc,
 			&STPDoc,
 			&BridgeDoc,
+			&VXLANDoc,
+			&VRFDoc,
+			&MACVLANDoc,
+			&EthtoolDoc,
+			&EthtoolRingsDoc,
+			&EthtoolChannelsDoc,
 			&VlanDoc,
 			&RouteDoc,
 			&RegistryMirrorConfigDoc,
 			&RegistryConfigDoc,
 			&RegistryAuthConfigDoc,
 			&RegistryTLSConfigDoc,
+			&LoggingSpoolConfigDoc,
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
 			&KubernetesTalosAPIAccessConfigDoc,
+			&HostDNSConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
 			&NetworkKubeSpanDoc,
@@ -3045,7 +4094,11 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&UdevConfigDoc,
 			&LoggingConfigDoc,
 			&LoggingDestinationDoc,
+			&LoggingTLSConfigDoc,
 			&KernelConfigDoc,
+			&SwapConfigDoc,
+			&ZramConfigDoc,
+			&SwapDeviceConfigDoc,
 			&KernelModuleConfigDoc,
 		},
 	}
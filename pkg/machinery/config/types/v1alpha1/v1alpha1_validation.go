@@ -6,15 +6,19 @@ package v1alpha1
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/talos-systems/go-debug"
@@ -124,17 +128,7 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, err)
 
 	case machine.TypeWorker:
-		for _, d := range c.Machine().Network().Devices() {
-			if d.VIPConfig() != nil {
-				result = multierror.Append(result, errors.New("virtual (shared) IP is not allowed on non-controlplane nodes"))
-			}
-
-			for _, vlan := range d.Vlans() {
-				if vlan.VIPConfig() != nil {
-					result = multierror.Append(result, errors.New("virtual (shared) IP is not allowed on non-controlplane nodes"))
-				}
-			}
-		}
+		// worker nodes don't run etcd, so virtual (shared) IP uses Kubernetes Lease election
 
 	case machine.TypeUnknown:
 		fallthrough
@@ -146,6 +140,7 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 	if c.MachineConfig.MachineNetwork != nil {
 		bondedInterfaces := map[string]string{}
 		bridgedInterfaces := map[string]string{}
+		vrfInterfaces := map[string]string{}
 
 		for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
 			if device.Bond() != nil && device.Bridge() != nil {
@@ -179,6 +174,26 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 					bridgedInterfaces[iface] = device.Interface()
 				}
 			}
+
+			if device.VRF() != nil {
+				for _, iface := range device.VRF().Interfaces() {
+					if otherIface, exists := vrfInterfaces[iface]; exists && otherIface != device.Interface() {
+						result = multierror.Append(result, fmt.Errorf("interface %q is declared as part of two VRFs: %q and %q", iface, otherIface, device.Interface()))
+					}
+
+					vrfInterfaces[iface] = device.Interface()
+				}
+			}
+		}
+
+		for iface, vrfIface := range vrfInterfaces {
+			if bondIface, exists := bondedInterfaces[iface]; exists {
+				result = multierror.Append(result, fmt.Errorf("interface %q is declared as part of a VRF and a bond: %q and %q", iface, vrfIface, bondIface))
+			}
+
+			if bridgeIface, exists := bridgedInterfaces[iface]; exists {
+				result = multierror.Append(result, fmt.Errorf("interface %q is declared as part of a VRF and a bridge: %q and %q", iface, vrfIface, bridgeIface))
+			}
 		}
 
 		for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
@@ -186,6 +201,24 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
 		}
+
+		result = multierror.Append(result, checkVIPKubernetesLease(c.MachineConfig.MachineNetwork, c.Machine().Type()))
+
+		routingRulePriorities := map[string]struct{}{}
+
+		for _, rule := range c.MachineConfig.MachineNetwork.NetworkRoutingRules {
+			result = multierror.Append(result, checkRoutingRule(rule))
+
+			for _, family := range routingRuleFamilies(rule) {
+				key := fmt.Sprintf("%s/%d", family, rule.RoutingRulePriority)
+
+				if _, exists := routingRulePriorities[key]; exists {
+					result = multierror.Append(result, fmt.Errorf("routing rule priority %d is used more than once for %s", rule.RoutingRulePriority, family))
+				}
+
+				routingRulePriorities[key] = struct{}{}
+			}
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -194,6 +227,13 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 				if pt.DiskSize == 0 && i != len(disk.DiskPartitions)-1 {
 					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
 				}
+
+				switch {
+				case pt.DiskSwap && pt.DiskMountPoint != "":
+					result = multierror.Append(result, fmt.Errorf("swap partition %d for disk %q can't have a mountpoint", i+1, disk.Device()))
+				case !pt.DiskSwap && pt.DiskMountPoint == "":
+					result = multierror.Append(result, fmt.Errorf("partition %d for disk %q should have a mountpoint or be a swap partition", i+1, disk.Device()))
+				}
 			}
 		}
 	}
@@ -204,6 +244,10 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, err)
 	}
 
+	if c.MachineConfig.MachineSwap != nil {
+		result = multierror.Append(result, c.MachineConfig.MachineSwap.Validate())
+	}
+
 	for _, label := range []string{constants.EphemeralPartitionLabel, constants.StatePartitionLabel} {
 		encryptionConfig := c.MachineConfig.SystemDiskEncryption().Get(label)
 		if encryptionConfig != nil {
@@ -219,9 +263,27 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 				slotsInUse[key.Slot()] = true
 
-				if key.NodeID() == nil && key.Static() == nil {
+				if key.NodeID() == nil && key.Static() == nil && key.TPM() == nil && key.KMS() == nil {
 					result = multierror.Append(result, fmt.Errorf("encryption key at slot %d doesn't have any settings", key.Slot()))
 				}
+
+				if key.TPM() != nil {
+					for _, pcr := range key.TPM().PCRs() {
+						if pcr < 0 || pcr > constants.MaxTPMPCR {
+							result = multierror.Append(result, fmt.Errorf("encryption key at slot %d has invalid TPM PCR %d", key.Slot(), pcr))
+						}
+					}
+				}
+
+				if key.KMS() != nil {
+					if _, _, err := net.SplitHostPort(key.KMS().Endpoint()); err != nil {
+						result = multierror.Append(result, fmt.Errorf("encryption key at slot %d has invalid KMS endpoint %q: %w", key.Slot(), key.KMS().Endpoint(), err))
+					}
+
+					if _, err := key.KMS().GetTLSConfig(); err != nil {
+						result = multierror.Append(result, fmt.Errorf("encryption key at slot %d has invalid KMS TLS config: %w", key.Slot(), err))
+					}
+				}
 			}
 		}
 	}
@@ -265,6 +327,10 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 		result = multierror.Append(result, fmt.Errorf("feature Kubernetes Talos API Access can only be enabled on control plane machines"))
 	}
 
+	if c.Machine().Type() == machine.TypeInit && c.Cluster().Etcd().Role() != constants.EtcdRoleVoter {
+		result = multierror.Append(result, fmt.Errorf("etcd role %q is not supported on init machines, as they bootstrap etcd", c.Cluster().Etcd().Role()))
+	}
+
 	if opts.Strict {
 		for _, w := range warnings {
 			result = multierror.Append(result, fmt.Errorf("warning: %s", w))
@@ -491,9 +557,193 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 		result = multierror.Append(result, checkVlans(d))
 	}
 
+	// bond and bridge sections are checked against each other at the top level
+	kinds, logicalKinds := 0, 0
+
+	for _, set := range []bool{d.DeviceVXLAN != nil, d.DeviceVRF != nil, d.DeviceMACVLAN != nil} {
+		if set {
+			kinds++
+			logicalKinds++
+		}
+	}
+
+	for _, set := range []bool{d.DeviceBond != nil, d.DeviceBridge != nil} {
+		if set {
+			kinds++
+		}
+	}
+
+	if logicalKinds > 0 && kinds > 1 {
+		result = multierror.Append(result, fmt.Errorf("[%s], [%s], [%s], [%s], [%s]: %w",
+			"networking.os.device.bond", "networking.os.device.bridge", "networking.os.device.vxlan", "networking.os.device.vrf", "networking.os.device.macvlan",
+			ErrMutuallyExclusive))
+	}
+
+	if d.DeviceVXLAN != nil {
+		result = multierror.Append(result, checkVXLAN(d.DeviceVXLAN))
+	}
+
+	if d.DeviceVRF != nil {
+		result = multierror.Append(result, checkVRF(d.DeviceVRF))
+	}
+
+	if d.DeviceMACVLAN != nil {
+		result = multierror.Append(result, checkMACVLAN(d.DeviceMACVLAN))
+	}
+
+	if d.DeviceEthtool != nil {
+		result = multierror.Append(result, checkEthtool(d.DeviceEthtool))
+	}
+
+	if d.DeviceDHCPOptions != nil {
+		result = multierror.Append(result, checkDHCPOptions("networking.os.device.dhcpOptions", d.DeviceDHCPOptions))
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
+//nolint:gocyclo
+func checkVXLAN(v *VXLAN) error {
+	var result *multierror.Error
+
+	if v.VXLANID == 0 || v.VXLANID > 1<<24-1 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: VNI should be in range 1-%d", "networking.os.device.vxlan.vni", v.VXLANID, 1<<24-1))
+	}
+
+	var local, remote netip.Addr
+
+	if v.VXLANLocal != "" {
+		var err error
+
+		if local, err = netip.ParseAddr(v.VXLANLocal); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vxlan.local", v.VXLANLocal, ErrInvalidAddress))
+		}
+	}
+
+	if v.VXLANRemote != "" {
+		var err error
+
+		if remote, err = netip.ParseAddr(v.VXLANRemote); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vxlan.remote", v.VXLANRemote, ErrInvalidAddress))
+		}
+	}
+
+	if local.IsValid() && remote.IsValid() && local.Is4() != remote.Is4() {
+		result = multierror.Append(result, fmt.Errorf("[%s], [%s]: local and remote addresses should be of the same address family", "networking.os.device.vxlan.local", "networking.os.device.vxlan.remote"))
+	}
+
+	if remote.IsMulticast() && v.VXLANLink == "" {
+		result = multierror.Append(result, fmt.Errorf("[%s]: link is required for the multicast remote address %q", "networking.os.device.vxlan.link", v.VXLANRemote))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkVRF(v *VRF) error {
+	if v.VRFTable == 0 {
+		return fmt.Errorf("[%s]: %w", "networking.os.device.vrf.table", ErrRequiredSection)
+	}
+
+	return nil
+}
+
+func checkMACVLAN(m *MACVLAN) error {
+	var result *multierror.Error
+
+	if m.MACVLANLink == "" {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.os.device.macvlan.link", ErrRequiredSection))
+	}
+
+	if _, err := nethelpers.MACVLANModeByName(m.MACVLANMode); err != nil {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.os.device.macvlan.mode", err))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkEthtool(e *Ethtool) error {
+	var result *multierror.Error
+
+	switch e.EthtoolDuplex {
+	case "", "full", "half":
+	default:
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: duplex should be either %q or %q", "networking.os.device.ethtool.duplex", e.EthtoolDuplex, "full", "half"))
+	}
+
+	if e.EthtoolDuplex != "" && e.EthtoolSpeed == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s]: duplex can be set only with the speed", "networking.os.device.ethtool.duplex"))
+	}
+
+	for feature := range e.EthtoolFeatures {
+		if feature == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: feature name should not be empty", "networking.os.device.ethtool.features"))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkDHCPOptions(path string, o *DHCPOptions) error {
+	var result *multierror.Error
+
+	if o.DHCPClientIdentifier != "" {
+		if _, err := hex.DecodeString(o.DHCPClientIdentifier); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: client identifier should be a hex string: %w", path+".clientIdentifier", o.DHCPClientIdentifier, err))
+		}
+	}
+
+	for _, userClass := range o.DHCPUserClasses {
+		if userClass == "" || len(userClass) > 255 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: user class should be 1-255 bytes long", path+".userClasses", userClass))
+		}
+	}
+
+	for _, code := range o.DHCPRequestOptions {
+		// 0 (pad) and 255 (end) are not real options
+		if code < 1 || code > 254 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: option code should be in range 1-254", path+".requestOptions", code))
+		}
+	}
+
+	if o.DHCPPrefixDelegation != nil {
+		result = multierror.Append(result, checkPrefixDelegation(path+".prefixDelegation", o.DHCPPrefixDelegation))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkPrefixDelegation(path string, pd *DHCPPrefixDelegation) error {
+	var result *multierror.Error
+
+	// subnets are always /64
+	const subnetBits = 64
+
+	if pd.PrefixDelegationPrefixLength < 0 || pd.PrefixDelegationPrefixLength > subnetBits {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: prefix length should be in range 1-%d", path+".prefixLength", pd.PrefixDelegationPrefixLength, subnetBits))
+	}
+
+	subnetIDs := map[uint32]struct{}{}
+
+	for _, subnet := range pd.PrefixDelegationSubnets {
+		if subnet.SubnetInterface == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: interface name should not be empty", path+".subnets.interface"))
+		}
+
+		if _, exists := subnetIDs[subnet.SubnetID]; exists {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: duplicate subnet ID", path+".subnets.subnetID", subnet.SubnetID))
+		}
+
+		subnetIDs[subnet.SubnetID] = struct{}{}
+
+		if pd.PrefixDelegationPrefixLength > 0 && pd.PrefixDelegationPrefixLength <= subnetBits &&
+			uint64(subnet.SubnetID) >= uint64(1)<<(subnetBits-pd.PrefixDelegationPrefixLength) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: subnet ID doesn't fit into the /%d prefix", path+".subnets.subnetID", subnet.SubnetID, pd.PrefixDelegationPrefixLength))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 //nolint:gocyclo,cyclop
 func checkBond(b *Bond) error {
 	var result *multierror.Error
@@ -661,6 +911,10 @@ func checkVlans(d *Device) error {
 				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.addresses", d.DeviceInterface, vlan.VlanID, err))
 			}
 		}
+
+		if vlan.VlanDHCPOptions != nil {
+			result = multierror.Append(result, checkDHCPOptions("networking.os.device.vlan.dhcpOptions", vlan.VlanDHCPOptions))
+		}
 	}
 
 	return result.ErrorOrNil()
@@ -725,11 +979,170 @@ func CheckDeviceAddressing(d *Device, bondedInterfaces map[string]string) ([]str
 		if ip := net.ParseIP(d.DeviceVIPConfig.IP()); ip == nil {
 			result = multierror.Append(result, fmt.Errorf("[%s] failed to parse %q as IP address", "networking.os.device.vip", d.DeviceVIPConfig.IP()))
 		}
+
+		if d.DeviceVIPConfig.BGPConfig != nil {
+			result = multierror.Append(result, checkVIPBGP(d.DeviceVIPConfig))
+		}
 	}
 
 	return warnings, result.ErrorOrNil()
 }
 
+// checkVIPKubernetesLease ensures that the virtual (shared) IPs elected via Kubernetes Lease have the service account token.
+//
+// Worker nodes always use Kubernetes Lease, and kubelet credentials are not allowed to manage leases.
+func checkVIPKubernetesLease(network *NetworkConfig, machineType machine.Type) error {
+	if network == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	check := func(iface string, vip *DeviceVIPConfig) {
+		if vip == nil {
+			return
+		}
+
+		if vip.KubernetesLeaseConfig == nil && machineType != machine.TypeWorker {
+			return
+		}
+
+		if vip.KubernetesLeaseConfig == nil || vip.KubernetesLeaseConfig.LeaseServiceAccountToken == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: service account token is required", "networking.os.device.vip.kubernetesLease", iface))
+		}
+	}
+
+	for _, device := range network.NetworkInterfaces {
+		check(device.DeviceInterface, device.DeviceVIPConfig)
+
+		for _, vlan := range device.DeviceVlans {
+			check(fmt.Sprintf("%s.%d", device.DeviceInterface, vlan.VlanID), vlan.VlanVIP)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkVIPBGP ensures that the BGP VIP settings are valid.
+//
+//nolint:gocyclo
+func checkVIPBGP(vip *DeviceVIPConfig) error {
+	var result *multierror.Error
+
+	bgp := vip.BGPConfig
+
+	if vip.EquinixMetalConfig != nil || vip.HCloudConfig != nil {
+		result = multierror.Append(result, fmt.Errorf("[%s] BGP can't be used together with cloud API settings", "networking.os.device.vip.bgp"))
+	}
+
+	if bgp.BGPLocalASN == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] local ASN is required", "networking.os.device.vip.bgp"))
+	}
+
+	if bgp.BGPHoldTime != 0 && (bgp.BGPHoldTime < 3*time.Second || bgp.BGPHoldTime > math.MaxUint16*time.Second) {
+		result = multierror.Append(result, fmt.Errorf("[%s] hold time should be zero or in range [3s, %ds]: %s", "networking.os.device.vip.bgp", math.MaxUint16, bgp.BGPHoldTime))
+	}
+
+	sharedIP, vipErr := netip.ParseAddr(vip.SharedIP)
+
+	if bgp.BGPRouterID != "" {
+		if routerID, err := netip.ParseAddr(bgp.BGPRouterID); err != nil || !routerID.Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] router ID should be an IPv4 address: %q", "networking.os.device.vip.bgp", bgp.BGPRouterID))
+		}
+	} else if vipErr == nil && sharedIP.Is6() {
+		result = multierror.Append(result, fmt.Errorf("[%s] router ID is required for IPv6 VIP", "networking.os.device.vip.bgp"))
+	}
+
+	if len(bgp.BGPPeers) == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] at least one peer is required", "networking.os.device.vip.bgp"))
+	}
+
+	for _, peer := range bgp.BGPPeers {
+		addr, err := netip.ParseAddr(peer.BGPPeerAddress)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] failed to parse %q as IP address", "networking.os.device.vip.bgp.peers", peer.BGPPeerAddress))
+
+			continue
+		}
+
+		if vipErr == nil && addr.Is4() != sharedIP.Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] peer %q address family doesn't match VIP %q", "networking.os.device.vip.bgp.peers", peer.BGPPeerAddress, vip.SharedIP))
+		}
+
+		if peer.BGPPeerASN == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] peer %q ASN is required", "networking.os.device.vip.bgp.peers", peer.BGPPeerAddress))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// routingRuleFamilies returns address families the rule applies to.
+//
+// Rules without source and destination apply to both IPv4 and IPv6.
+func routingRuleFamilies(r *RoutingRule) []string {
+	for _, prefix := range []string{r.RoutingRuleSource, r.RoutingRuleDestination} {
+		if prefix == "" {
+			continue
+		}
+
+		parsed, err := netip.ParsePrefix(prefix)
+		if err != nil {
+			return nil
+		}
+
+		if parsed.Addr().Is4() {
+			return []string{"IPv4"}
+		}
+
+		return []string{"IPv6"}
+	}
+
+	return []string{"IPv4", "IPv6"}
+}
+
+//nolint:gocyclo
+func checkRoutingRule(r *RoutingRule) error {
+	var result *multierror.Error
+
+	if r.RoutingRulePriority == 0 || r.RoutingRulePriority > 32765 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: priority should be in range 1-32765", "networking.routingRules.priority", r.RoutingRulePriority))
+	}
+
+	if r.RoutingRuleTable == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.routingRules.table", ErrRequiredSection))
+	}
+
+	var source, destination netip.Prefix
+
+	if r.RoutingRuleSource != "" {
+		var err error
+
+		if source, err = netip.ParsePrefix(r.RoutingRuleSource); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.routingRules.source", r.RoutingRuleSource, ErrInvalidAddress))
+		}
+	}
+
+	if r.RoutingRuleDestination != "" {
+		var err error
+
+		if destination, err = netip.ParsePrefix(r.RoutingRuleDestination); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.routingRules.destination", r.RoutingRuleDestination, ErrInvalidAddress))
+		}
+	}
+
+	if source.IsValid() && destination.IsValid() && source.Addr().Is4() != destination.Addr().Is4() {
+		result = multierror.Append(result, fmt.Errorf("[%s], [%s]: source and destination should be of the same address family",
+			"networking.routingRules.source", "networking.routingRules.destination"))
+	}
+
+	if r.RoutingRuleFwMask != 0 && r.RoutingRuleFwMark == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s]: fwMask requires fwMark to be set", "networking.routingRules.fwMask"))
+	}
+
+	return result.ErrorOrNil()
+}
+
 // CheckDeviceRoutes ensures that the specified routes are valid.
 //
 //nolint:gocyclo
@@ -791,9 +1204,76 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		}
 	}
 
+	if k.KubeletCredentialProviderConfig.Object != nil {
+		if err := kubelet.ValidateCredentialProviderConfig(k.KubeletCredentialProviderConfig.Object); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
+// Validate swap configuration.
+func (s *SwapConfig) Validate() error {
+	var result *multierror.Error
+
+	validatePriority := func(name string, priority *int) {
+		if priority != nil && (*priority < 0 || *priority > maxSwapPriority) {
+			result = multierror.Append(result, fmt.Errorf("%s swap priority should be in range [0, %d]: %d", name, maxSwapPriority, *priority))
+		}
+	}
+
+	if s.SwapZram != nil {
+		if s.SwapZram.ZramSize == 0 {
+			result = multierror.Append(result, fmt.Errorf("zram size is required"))
+		}
+
+		if s.SwapZram.ZramAlgorithm != "" {
+			if _, ok := zramAlgorithms[s.SwapZram.ZramAlgorithm]; !ok {
+				result = multierror.Append(result, fmt.Errorf("zram compression algorithm %q is not supported", s.SwapZram.ZramAlgorithm))
+			}
+		}
+
+		validatePriority("zram", s.SwapZram.ZramPriority)
+	}
+
+	devices := map[string]struct{}{}
+
+	for _, device := range s.SwapDevices {
+		if !strings.HasPrefix(device.SwapDevice, "/dev/") {
+			result = multierror.Append(result, fmt.Errorf("swap device %q should be a path in /dev", device.SwapDevice))
+		}
+
+		if _, exists := devices[device.SwapDevice]; exists {
+			result = multierror.Append(result, fmt.Errorf("swap device %q is duplicated", device.SwapDevice))
+		}
+
+		devices[device.SwapDevice] = struct{}{}
+
+		validatePriority(device.SwapDevice, device.SwapPriority)
+	}
+
+	switch s.SwapKubeletBehavior {
+	case "", "LimitedSwap", "UnlimitedSwap":
+	default:
+		result = multierror.Append(result, fmt.Errorf("kubelet swap behavior %q is not supported", s.SwapKubeletBehavior))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// maxSwapPriority is the maximum swap priority (SWAP_FLAG_PRIO_MASK).
+const maxSwapPriority = 32767
+
+var zramAlgorithms = map[string]struct{}{
+	"lzo":     {},
+	"lzo-rle": {},
+	"lz4":     {},
+	"lz4hc":   {},
+	"zstd":    {},
+	"842":     {},
+}
+
 // Validate etcd configuration.
 func (e *EtcdConfig) Validate() error {
 	var result *multierror.Error
@@ -822,5 +1302,97 @@ func (e *EtcdConfig) Validate() error {
 		}
 	}
 
+	switch e.Role() {
+	case constants.EtcdRoleVoter:
+		if len(e.EtcdExternalEndpoints) > 0 {
+			result = multierror.Append(result, fmt.Errorf("etcd external endpoints can't be set with etcd role %q", constants.EtcdRoleVoter))
+		}
+	case constants.EtcdRoleLearner, constants.EtcdRoleNone:
+		if len(e.EtcdExternalEndpoints) == 0 {
+			result = multierror.Append(result, fmt.Errorf("etcd external endpoints are required with etcd role %q", e.Role()))
+		}
+
+		for _, endpoint := range e.EtcdExternalEndpoints {
+			if err := talosnet.ValidateEndpointURI(endpoint); err != nil {
+				result = multierror.Append(result, fmt.Errorf("etcd external endpoint is not valid: %w", err))
+			}
+		}
+	default:
+		result = multierror.Append(result, fmt.Errorf("etcd role should be one of [%q, %q, %q]", constants.EtcdRoleVoter, constants.EtcdRoleLearner, constants.EtcdRoleNone))
+	}
+
+	if e.EtcdBackupConfig != nil {
+		result = multierror.Append(result, e.EtcdBackupConfig.Validate())
+	}
+
+	if e.EtcdDefragmentationConfig != nil {
+		result = multierror.Append(result, e.EtcdDefragmentationConfig.Validate())
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate etcd defragmentation configuration.
+func (d *EtcdDefragmentationConfig) Validate() error {
+	var result *multierror.Error
+
+	if d.DefragInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd defragmentation interval should be positive: %s", d.DefragInterval))
+	}
+
+	if d.DefragThreshold < 0 || d.DefragThreshold >= 100 {
+		result = multierror.Append(result, fmt.Errorf("etcd defragmentation threshold should be between 1 and 99 percent: %d", d.DefragThreshold))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate etcd backup configuration.
+func (b *EtcdBackupConfig) Validate() error {
+	var result *multierror.Error
+
+	if b.BackupInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd backup interval should be positive: %s", b.BackupInterval))
+	}
+
+	if b.BackupRetentionCount < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd backup retention count should be positive: %d", b.BackupRetentionCount))
+	}
+
+	if b.BackupRetentionMaxAge < 0 {
+		result = multierror.Append(result, fmt.Errorf("etcd backup retention max age should be positive: %s", b.BackupRetentionMaxAge))
+	}
+
+	if b.BackupEncryptionKey != "" {
+		key, err := base64.StdEncoding.DecodeString(b.BackupEncryptionKey)
+
+		switch {
+		case err != nil:
+			result = multierror.Append(result, fmt.Errorf("etcd backup encryption key is not valid base64: %w", err))
+		case len(key) != 32:
+			result = multierror.Append(result, fmt.Errorf("etcd backup encryption key should be 32 bytes long, got %d", len(key)))
+		}
+	}
+
+	if s3 := b.BackupS3; s3 != nil {
+		if s3.S3Bucket == "" {
+			result = multierror.Append(result, fmt.Errorf("etcd backup S3 bucket is required"))
+		}
+
+		if s3.S3Region == "" {
+			result = multierror.Append(result, fmt.Errorf("etcd backup S3 region is required"))
+		}
+
+		if s3.S3Endpoint != "" {
+			if u, err := url.Parse(s3.S3Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+				result = multierror.Append(result, fmt.Errorf("etcd backup S3 endpoint is not a valid URL: %q", s3.S3Endpoint))
+			}
+		}
+
+		if s3.S3AccessKeyID == "" || s3.S3SecretAccessKey == "" {
+			result = multierror.Append(result, fmt.Errorf("etcd backup S3 credentials are required"))
+		}
+	}
+
 	return result.ErrorOrNil()
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
@@ -349,6 +350,186 @@ func TestValidate(t *testing.T) {
 			expectedError:    "1 error occurred:\n\t* [networking.os.device.CIDR] \"eth0\": failed to parse IP address \"10.3.x\"\n\n",
 			expectedWarnings: []string{"\"eth0\": machine.network.interface.cidr is deprecated, please use machine.network.interface.addresses"},
 		},
+		{
+			name: "DeviceVIPBGP",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.1",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPLocalASN: 65000,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												BGPPeerAddress: "10.0.0.1",
+												BGPPeerASN:     65001,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DeviceVIPWorker",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.1",
+									KubernetesLeaseConfig: &v1alpha1.VIPKubernetesLeaseConfig{
+										LeaseServiceAccountToken: "token",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DeviceVIPWorkerNoToken",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.1",
+								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 25,
+										VlanVIP: &v1alpha1.DeviceVIPConfig{
+											SharedIP:              "10.5.1.1",
+											KubernetesLeaseConfig: &v1alpha1.VIPKubernetesLeaseConfig{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* [networking.os.device.vip.kubernetesLease] \"eth0\": service account token is required\n" +
+				"\t* [networking.os.device.vip.kubernetesLease] \"eth0.25\": service account token is required\n\n",
+		},
+		{
+			name: "DeviceVIPKubernetesLeaseNoToken",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.1",
+									KubernetesLeaseConfig: &v1alpha1.VIPKubernetesLeaseConfig{
+										LeaseNamespace: "kube-system",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* [networking.os.device.vip.kubernetesLease] \"eth0\": service account token is required\n\n",
+		},
+		{
+			name: "DeviceVIPBGPInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "2001:db8::1",
+									HCloudConfig: &v1alpha1.VIPHCloudConfig{
+										HCloudAPIToken: "token",
+									},
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPHoldTime: time.Second,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												BGPPeerAddress: "10.0.0.1",
+											},
+											{
+												BGPPeerAddress: "router",
+												BGPPeerASN:     65001,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "7 errors occurred:\n" +
+				"\t* [networking.os.device.vip.bgp] BGP can't be used together with cloud API settings\n" +
+				"\t* [networking.os.device.vip.bgp] local ASN is required\n" +
+				"\t* [networking.os.device.vip.bgp] hold time should be zero or in range [3s, 65535s]: 1s\n" +
+				"\t* [networking.os.device.vip.bgp] router ID is required for IPv6 VIP\n" +
+				"\t* [networking.os.device.vip.bgp.peers] peer \"10.0.0.1\" address family doesn't match VIP \"2001:db8::1\"\n" +
+				"\t* [networking.os.device.vip.bgp.peers] peer \"10.0.0.1\" ASN is required\n" +
+				"\t* [networking.os.device.vip.bgp.peers] failed to parse \"router\" as IP address\n\n",
+		},
 		{
 			name: "DeviceAddressInvalid",
 			config: &v1alpha1.Config{
@@ -773,7 +954,7 @@ func TestValidate(t *testing.T) {
 			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of an interface and a bond: \"br0\" and \"bond0\"\n\n",
 		},
 		{
-			name: "Wireguard",
+			name: "VXLANVRFMACVLAN",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -781,27 +962,26 @@ func TestValidate(t *testing.T) {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
-								DeviceInterface: "wireguard0",
-								DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
-									WireguardPrivateKey: "ONtS+jU1Q+ZHLgs7DbYvnF5Iyj+koxBtvknDigFsdG8=",
-									WireguardPeers: []*v1alpha1.DeviceWireguardPeer{
-										{},
-										{
-											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1A=",
-											WireguardEndpoint:  "example.com:1234",
-											WireguardAllowedIPs: []string{
-												"10.2.0.5/31",
-												"2.4.5.3/32",
-											},
-										},
-										{
-											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==",
-											WireguardEndpoint:  "12.3.4.5",
-											WireguardAllowedIPs: []string{
-												"10.2.0",
-											},
-										},
-									},
+								DeviceInterface: "vxlan42",
+								DeviceVXLAN: &v1alpha1.VXLAN{
+									VXLANID:     42,
+									VXLANLink:   "eth0",
+									VXLANLocal:  "10.0.0.2",
+									VXLANRemote: "239.1.1.1",
+								},
+							},
+							{
+								DeviceInterface: "vrf-blue",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFTable:      100,
+									VRFInterfaces: []string{"eth1"},
+								},
+							},
+							{
+								DeviceInterface: "macvlan0",
+								DeviceMACVLAN: &v1alpha1.MACVLAN{
+									MACVLANLink: "eth0",
+									MACVLANMode: "vepa",
 								},
 							},
 						},
@@ -815,11 +995,10 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "4 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
-				"\t* peer endpoint \"12.3.4.5\" is invalid\n\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
+			expectedError: "",
 		},
 		{
-			name: "StaticRoutes",
+			name: "VXLANInvalid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -827,38 +1006,11 @@ func TestValidate(t *testing.T) {
 					MachineNetwork: &v1alpha1.NetworkConfig{
 						NetworkInterfaces: []*v1alpha1.Device{
 							{
-								DeviceInterface: "eth0",
-								DeviceRoutes: []*v1alpha1.Route{
-									{
-										RouteGateway: "172.0.0.1",
-									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
-									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
-										RouteSource:  "10.0.0.5",
-									},
-									{
-										RouteGateway: "172.0.0.x",
-									},
-									{
-										RouteNetwork: "10.0.0.0",
-										RouteGateway: "10.0.0.1",
-									},
-									{
-										RouteNetwork: "10.0.0.0/24",
-										RouteGateway: "10.0.0.1",
-										RouteSource:  "10.0.0.3/32",
-									},
-									{
-										RouteNetwork: "169.254.254.254/32",
-									},
-									{
-										RouteSource: "10.0.0.3",
-									},
+								DeviceInterface: "vxlan0",
+								DeviceVXLAN: &v1alpha1.VXLAN{
+									VXLANID:     1 << 24,
+									VXLANLocal:  "10.0.0.2",
+									VXLANRemote: "ff02::1",
 								},
 							},
 						},
@@ -872,20 +1024,25 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "4 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
-				"\t* [networking.os.device.route[4].network] \"10.0.0.0\": invalid network address\n" +
-				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
-				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.vxlan.vni] 16777216: VNI should be in range 1-16777215\n" +
+				"\t* [networking.os.device.vxlan.local], [networking.os.device.vxlan.remote]: local and remote addresses should be of the same address family\n" +
+				"\t* [networking.os.device.vxlan.link]: link is required for the multicast remote address \"ff02::1\"\n\n",
 		},
 		{
-			name: "KubeSpanNoDiscovery",
+			name: "VRFAndMACVLANInvalid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "controlplane",
 					MachineNetwork: &v1alpha1.NetworkConfig{
-						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
-							KubeSpanEnabled: pointer.To(true),
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "vrf0",
+								DeviceVRF:       &v1alpha1.VRF{},
+								DeviceMACVLAN: &v1alpha1.MACVLAN{
+									MACVLANMode: "foo",
+								},
+							},
 						},
 					},
 				},
@@ -897,43 +1054,64 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "3 errors occurred:\n\t* .cluster.discovery should be enabled when .machine.network.kubespan is enabled\n" +
-				"\t* .cluster.id should be set when .machine.network.kubespan is enabled\n" +
-				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.bond], [networking.os.device.bridge], [networking.os.device.vxlan], " +
+				"[networking.os.device.vrf], [networking.os.device.macvlan]: config sections are mutually exclusive\n" +
+				"\t* [networking.os.device.vrf.table]: required config section\n" +
+				"\t* [networking.os.device.macvlan.link]: required config section\n" +
+				"\t* [networking.os.device.macvlan.mode]: invalid MACVLAN mode: foo\n\n",
 		},
 		{
-			name: "DiscoveryServiceEndpoint",
+			name: "Ethtool",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceEthtool: &v1alpha1.Ethtool{
+									EthtoolRings: &v1alpha1.EthtoolRings{
+										RingsRX: 4096,
+									},
+									EthtoolFeatures: map[string]bool{
+										"rx-gro": false,
+									},
+									EthtoolSpeed:  1000,
+									EthtoolDuplex: "half",
+								},
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
-					ClusterID:     "foo",
-					ClusterSecret: "bar",
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
 						Endpoint: &v1alpha1.Endpoint{
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
-							RegistryService: v1alpha1.RegistryServiceConfig{
-								RegistryEndpoint: "foo",
-							},
-						},
-					},
 				},
 			},
-			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
 		},
 		{
-			name: "DiscoveryServiceClusterIDSecret",
+			name: "EthtoolInvalid",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceEthtool: &v1alpha1.Ethtool{
+									EthtoolFeatures: map[string]bool{
+										"": true,
+									},
+									EthtoolDuplex: "auto",
+								},
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -941,19 +1119,33 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
-						DiscoveryEnabled: pointer.To(true),
-					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n\n",
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.ethtool.duplex] \"auto\": duplex should be either \"full\" or \"half\"\n" +
+				"\t* [networking.os.device.ethtool.duplex]: duplex can be set only with the speed\n" +
+				"\t* [networking.os.device.ethtool.features]: feature name should not be empty\n\n",
 		},
 		{
-			name: "GoodEtcdSubnet",
+			name: "DHCPOptions",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
 					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPHostname:         "worker-1",
+									DHCPVendorClass:      "talos",
+									DHCPClientIdentifier: "01aabbccddeeff",
+									DHCPUserClasses:      []string{"rack-1"},
+									DHCPRequestOptions:   []int{42, 119},
+								},
+							},
+						},
+					},
 				},
 				ClusterConfig: &v1alpha1.ClusterConfig{
 					ControlPlane: &v1alpha1.ControlPlaneConfig{
@@ -961,7 +1153,455 @@ func TestValidate(t *testing.T) {
 							endpointURL,
 						},
 					},
-					EtcdConfig: &v1alpha1.EtcdConfig{
+				},
+			},
+		},
+		{
+			name: "DHCPOptionsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPClientIdentifier: "xyz",
+									DHCPRequestOptions:   []int{255},
+								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID:   25,
+										VlanDHCP: pointer.To(true),
+										VlanDHCPOptions: &v1alpha1.DHCPOptions{
+											DHCPUserClasses: []string{""},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.vlan.dhcpOptions.userClasses] \"\": user class should be 1-255 bytes long\n" +
+				"\t* [networking.os.device.dhcpOptions.clientIdentifier] \"xyz\": client identifier should be a hex string: encoding/hex: invalid byte: U+0078 'x'\n" +
+				"\t* [networking.os.device.dhcpOptions.requestOptions] 255: option code should be in range 1-254\n\n",
+		},
+		{
+			name: "PrefixDelegation",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPIPv6: pointer.To(true),
+									DHCPPrefixDelegation: &v1alpha1.DHCPPrefixDelegation{
+										PrefixDelegationPrefixLength: 56,
+										PrefixDelegationSubnets: []*v1alpha1.DHCPPrefixDelegationSubnet{
+											{
+												SubnetInterface: "eth1",
+												SubnetID:        1,
+											},
+											{
+												SubnetInterface: "eth2",
+												SubnetID:        255,
+											},
+										},
+									},
+								},
+								DeviceRouterAdvertisement: &v1alpha1.RouterAdvertisement{
+									RASLAAC: pointer.To(false),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PrefixDelegationInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceDHCP:      pointer.To(true),
+								DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+									DHCPIPv6: pointer.To(true),
+									DHCPPrefixDelegation: &v1alpha1.DHCPPrefixDelegation{
+										PrefixDelegationPrefixLength: 60,
+										PrefixDelegationSubnets: []*v1alpha1.DHCPPrefixDelegationSubnet{
+											{
+												SubnetID: 1,
+											},
+											{
+												SubnetInterface: "eth2",
+												SubnetID:        1,
+											},
+											{
+												SubnetInterface: "eth3",
+												SubnetID:        16,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* [networking.os.device.dhcpOptions.prefixDelegation.subnets.interface]: interface name should not be empty\n" +
+				"\t* [networking.os.device.dhcpOptions.prefixDelegation.subnets.subnetID] 1: duplicate subnet ID\n" +
+				"\t* [networking.os.device.dhcpOptions.prefixDelegation.subnets.subnetID] 16: subnet ID doesn't fit into the /60 prefix\n\n",
+		},
+		{
+			name: "InterfacePartOfVRFAndBond",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "vrf0",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFTable:      100,
+									VRFInterfaces: []string{"eth1"},
+								},
+							},
+							{
+								DeviceInterface: "vrf1",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFTable:      101,
+									VRFInterfaces: []string{"eth1", "eth2"},
+								},
+							},
+							{
+								DeviceInterface: "bond0",
+								DeviceBond: &v1alpha1.Bond{
+									BondInterfaces: []string{"eth2"},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* interface \"eth1\" is declared as part of two VRFs: \"vrf0\" and \"vrf1\"\n" +
+				"\t* interface \"eth2\" is declared as part of a VRF and a bond: \"vrf1\" and \"bond0\"\n\n",
+		},
+		{
+			name: "RoutingRules",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkRoutingRules: []*v1alpha1.RoutingRule{
+							{
+								RoutingRulePriority: 1000,
+								RoutingRuleSource:   "10.0.0.0/24",
+								RoutingRuleTable:    100,
+							},
+							{
+								RoutingRulePriority: 1000,
+								RoutingRuleSource:   "fd00::/64",
+								RoutingRuleTable:    100,
+							},
+							{
+								RoutingRulePriority: 1001,
+								RoutingRuleIIF:      "eth1",
+								RoutingRuleFwMark:   0x10,
+								RoutingRuleFwMask:   0xff,
+								RoutingRuleTable:    101,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "RoutingRulesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkRoutingRules: []*v1alpha1.RoutingRule{
+							{
+								RoutingRulePriority:    32766,
+								RoutingRuleSource:      "10.0.0.0/24",
+								RoutingRuleDestination: "fd00::/64",
+								RoutingRuleFwMask:      0xff,
+							},
+							{
+								RoutingRulePriority: 1000,
+								RoutingRuleSource:   "10.0.0.1",
+								RoutingRuleTable:    100,
+							},
+							{
+								RoutingRulePriority: 1001,
+								RoutingRuleTable:    100,
+							},
+							{
+								RoutingRulePriority:    1001,
+								RoutingRuleDestination: "fd00::/64",
+								RoutingRuleTable:       100,
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n" +
+				"\t* [networking.routingRules.priority] 32766: priority should be in range 1-32765\n" +
+				"\t* [networking.routingRules.table]: required config section\n" +
+				"\t* [networking.routingRules.source], [networking.routingRules.destination]: source and destination should be of the same address family\n" +
+				"\t* [networking.routingRules.fwMask]: fwMask requires fwMark to be set\n" +
+				"\t* [networking.routingRules.source] \"10.0.0.1\": invalid network address\n" +
+				"\t* routing rule priority 1001 is used more than once for IPv6\n\n",
+		},
+		{
+			name: "Wireguard",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "wireguard0",
+								DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
+									WireguardPrivateKey: "ONtS+jU1Q+ZHLgs7DbYvnF5Iyj+koxBtvknDigFsdG8=",
+									WireguardPeers: []*v1alpha1.DeviceWireguardPeer{
+										{},
+										{
+											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1A=",
+											WireguardEndpoint:  "example.com:1234",
+											WireguardAllowedIPs: []string{
+												"10.2.0.5/31",
+												"2.4.5.3/32",
+											},
+										},
+										{
+											WireguardPublicKey: "4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==",
+											WireguardEndpoint:  "12.3.4.5",
+											WireguardAllowedIPs: []string{
+												"10.2.0",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* public key invalid: wrong key \"\" length: 0\n\t* public key invalid: wrong key \"4A3rogGVHuVjeZz5cbqryWXGkGBdIGC0E6+5mX2Iz1==\" length: 31\n" +
+				"\t* peer endpoint \"12.3.4.5\" is invalid\n\t* peer allowed IP \"10.2.0\" is invalid: invalid CIDR address: 10.2.0\n\n",
+		},
+		{
+			name: "StaticRoutes",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteGateway: "172.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+										RouteSource:  "10.0.0.5",
+									},
+									{
+										RouteGateway: "172.0.0.x",
+									},
+									{
+										RouteNetwork: "10.0.0.0",
+										RouteGateway: "10.0.0.1",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteGateway: "10.0.0.1",
+										RouteSource:  "10.0.0.3/32",
+									},
+									{
+										RouteNetwork: "169.254.254.254/32",
+									},
+									{
+										RouteSource: "10.0.0.3",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
+				"\t* [networking.os.device.route[4].network] \"10.0.0.0\": invalid network address\n" +
+				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
+				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
+		},
+		{
+			name: "KubeSpanNoDiscovery",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
+							KubeSpanEnabled: pointer.To(true),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* .cluster.discovery should be enabled when .machine.network.kubespan is enabled\n" +
+				"\t* .cluster.id should be set when .machine.network.kubespan is enabled\n" +
+				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
+		},
+		{
+			name: "DiscoveryServiceEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ClusterID:     "foo",
+					ClusterSecret: "bar",
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+						DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+							RegistryService: v1alpha1.RegistryServiceConfig{
+								RegistryEndpoint: "foo",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cluster discovery service registry endpoint is invalid: parse \"foo\": invalid URI for request\n\n",
+		},
+		{
+			name: "DiscoveryServiceClusterIDSecret",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+						DiscoveryEnabled: pointer.To(true),
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* cluster discovery service requires .cluster.id\n\t* cluster discovery service requires .cluster.secret\n\n",
+		},
+		{
+			name: "GoodEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
 						RootCA: &x509.PEMEncodedCertificateAndKey{},
 						EtcdAdvertisedSubnets: []string{
 							"10.0.0.0/8",
@@ -974,10 +1614,207 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "",
+			expectedError: "",
+		},
+		{
+			name: "BadEtcdSubnet",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdAdvertisedSubnets: []string{
+							"1234:",
+						},
+						EtcdListenSubnets: []string{
+							"10",
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
+		},
+		{
+			name: "GoodEtcdBackup",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdBackupConfig: &v1alpha1.EtcdBackupConfig{
+							BackupEnabled:       pointer.To(true),
+							BackupInterval:      30 * time.Minute,
+							BackupEncryptionKey: "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=",
+							BackupS3: &v1alpha1.EtcdBackupS3Config{
+								S3Endpoint:        "https://minio.example.com",
+								S3Region:          "us-east-1",
+								S3Bucket:          "etcd-backups",
+								S3AccessKeyID:     "access",
+								S3SecretAccessKey: "secret",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "BadEtcdBackup",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdBackupConfig: &v1alpha1.EtcdBackupConfig{
+							BackupRetentionCount: -1,
+							BackupEncryptionKey:  "Zm9v",
+							BackupS3: &v1alpha1.EtcdBackupS3Config{
+								S3Endpoint: "minio",
+								S3Region:   "us-east-1",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* etcd backup retention count should be positive: -1\n\t* etcd backup encryption key should be 32 bytes long, got 3\n\t* etcd backup S3 bucket is required\n\t* etcd backup S3 endpoint is not a valid URL: \"minio\"\n\t* etcd backup S3 credentials are required\n\n",
+		},
+		{
+			name: "BadEtcdDefragmentation",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA: &x509.PEMEncodedCertificateAndKey{},
+						EtcdDefragmentationConfig: &v1alpha1.EtcdDefragmentationConfig{
+							DefragEnabled:   pointer.To(true),
+							DefragInterval:  -time.Minute,
+							DefragThreshold: 100,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* etcd defragmentation interval should be positive: -1m0s\n\t* etcd defragmentation threshold should be between 1 and 99 percent: 100\n\n",
+		},
+		{
+			name: "GoodEtcdRoleNone",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "none",
+						EtcdExternalEndpoints: []string{
+							"https://10.0.0.1:2379",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BadEtcdRole",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "observer",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* etcd role should be one of [\"voter\", \"learner\", \"none\"]\n\n",
+		},
+		{
+			name: "BadEtcdRoleNoneWithoutEndpoints",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "none",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* etcd external endpoints are required with etcd role \"none\"\n\n",
+		},
+		{
+			name: "BadEtcdRoleLearnerWithoutEndpoints",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "learner",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* etcd external endpoints are required with etcd role \"learner\"\n\n",
 		},
 		{
-			name: "BadEtcdSubnet",
+			name: "BadEtcdExternalEndpointsWithVoter",
 			config: &v1alpha1.Config{
 				ConfigVersion: "v1alpha1",
 				MachineConfig: &v1alpha1.MachineConfig{
@@ -990,17 +1827,102 @@ func TestValidate(t *testing.T) {
 						},
 					},
 					EtcdConfig: &v1alpha1.EtcdConfig{
-						RootCA: &x509.PEMEncodedCertificateAndKey{},
-						EtcdAdvertisedSubnets: []string{
-							"1234:",
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "voter",
+						EtcdExternalEndpoints: []string{
+							"https://10.0.0.1:2379",
 						},
-						EtcdListenSubnets: []string{
-							"10",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* etcd external endpoints can't be set with etcd role \"voter\"\n\n",
+		},
+		{
+			name: "BadEtcdRoleInit",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "init",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					EtcdConfig: &v1alpha1.EtcdConfig{
+						RootCA:   &x509.PEMEncodedCertificateAndKey{},
+						EtcdRole: "learner",
+						EtcdExternalEndpoints: []string{
+							"https://10.0.0.1:2379",
 						},
 					},
 				},
 			},
-			expectedError: "2 errors occurred:\n\t* etcd advertised subnet is not valid: \"1234:\"\n\t* etcd listen subnet is not valid: \"10\"\n\n",
+			expectedError: "1 error occurred:\n\t* etcd role \"learner\" is not supported on init machines, as they bootstrap etcd\n\n",
+		},
+		{
+			name: "MachineDiskSwapPartition",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskSize:       v1alpha1.DiskSize(1024 * 1024 * 1024),
+									DiskMountPoint: "/var/mnt/extra",
+								},
+								{
+									DiskSwap: true,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "MachineDiskBadPartitions",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskPartitions: []*v1alpha1.DiskPartition{
+								{
+									DiskSize: v1alpha1.DiskSize(1024 * 1024 * 1024),
+								},
+								{
+									DiskMountPoint: "/var/mnt/extra",
+									DiskSwap:       true,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* partition 1 for disk \"/dev/sdb\" should have a mountpoint or be a swap partition\n" +
+				"\t* swap partition 2 for disk \"/dev/sdb\" can't have a mountpoint\n\n",
 		},
 		{
 			name: "GoodKubeletSubnet",
@@ -1080,6 +2002,151 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* kubelet configuration field \"port\" can't be overridden\n\n",
 		},
+		{
+			name: "Swap",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineSwap: &v1alpha1.SwapConfig{
+						SwapZram: &v1alpha1.ZramConfig{
+							ZramSize:      v1alpha1.DiskSize(1024 * 1024 * 1024),
+							ZramAlgorithm: "zstd",
+						},
+						SwapDevices: []*v1alpha1.SwapDeviceConfig{
+							{
+								SwapDevice:   "/dev/sdb2",
+								SwapPriority: pointer.To(10),
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BadSwap",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineSwap: &v1alpha1.SwapConfig{
+						SwapZram: &v1alpha1.ZramConfig{
+							ZramAlgorithm: "gzip",
+						},
+						SwapDevices: []*v1alpha1.SwapDeviceConfig{
+							{
+								SwapDevice:   "sdb2",
+								SwapPriority: pointer.To(-1),
+							},
+							{
+								SwapDevice: "sdb2",
+							},
+						},
+						SwapKubeletBehavior: "NoSwap",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "7 errors occurred:\n" +
+				"\t* zram size is required\n" +
+				"\t* zram compression algorithm \"gzip\" is not supported\n" +
+				"\t* swap device \"sdb2\" should be a path in /dev\n" +
+				"\t* sdb2 swap priority should be in range [0, 32767]: -1\n" +
+				"\t* swap device \"sdb2\" should be a path in /dev\n" +
+				"\t* swap device \"sdb2\" is duplicated\n" +
+				"\t* kubelet swap behavior \"NoSwap\" is not supported\n\n",
+		},
+		{
+			name: "BadKubeletCredentialProviderConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"apiVersion": "v1",
+								"kind":       "KubeletConfiguration",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* kubelet credential provider config kind should be \"CredentialProviderConfig\", got \"KubeletConfiguration\"\n" +
+				"\t* kubelet credential provider config apiVersion \"v1\" is not supported\n" +
+				"\t* kubelet credential provider config should have at least one provider\n\n",
+		},
+		{
+			name: "BadKubeletCredentialProviderConfigProviders",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletCredentialProviderConfig: v1alpha1.Unstructured{
+							Object: map[string]interface{}{
+								"apiVersion": "kubelet.config.k8s.io/v1",
+								"kind":       "CredentialProviderConfig",
+								"providers": []interface{}{
+									map[string]interface{}{
+										"name":                 "../ecr-credential-provider",
+										"matchImages":          []interface{}{},
+										"defaultCacheDuration": "forever",
+										"apiVersion":           "credentialprovider.kubelet.k8s.io/v1",
+										"extra":                true,
+										"env": []interface{}{
+											map[string]interface{}{
+												"name": "AWS_PROFILE",
+											},
+										},
+									},
+									map[string]interface{}{
+										"matchImages": []interface{}{"*.dkr.ecr.*.amazonaws.com"},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "9 errors occurred:\n" +
+				"\t* kubelet credential provider config apiVersion \"kubelet.config.k8s.io/v1\" is not supported\n" +
+				"\t* kubelet credential provider config providers[0]: field \"extra\" is not supported\n" +
+				"\t* kubelet credential provider config providers[0]: name \"../ecr-credential-provider\" should be a file name in \"/usr/local/lib/kubelet/credentialproviders\"\n" +
+				"\t* kubelet credential provider config providers[0]: matchImages should be a non-empty list of strings\n" +
+				"\t* kubelet credential provider config providers[0]: defaultCacheDuration \"forever\" is not valid: time: invalid duration \"forever\"\n" +
+				"\t* kubelet credential provider config providers[0].env[0]: value is required\n" +
+				"\t* kubelet credential provider config providers[1]: name is required\n" +
+				"\t* kubelet credential provider config providers[1]: defaultCacheDuration is required\n" +
+				"\t* kubelet credential provider config providers[1]: apiVersion is required\n\n",
+		},
 		{
 			name: "DeviceInterfaceInvalid",
 			config: &v1alpha1.Config{
@@ -1198,6 +2265,133 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* feature Kubernetes Talos API Access can only be enabled on control plane machines\n\n",
 		},
+		{
+			name: "LoggingSyslogTLS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{
+									URL: &url.URL{Scheme: "tcp", Host: "syslog.example.com:6514"},
+								},
+								LoggingFormat: constants.LoggingFormatSyslog,
+								LoggingTLS: &v1alpha1.LoggingTLSConfig{
+									TLSInsecureSkipVerify: pointer.To(true),
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LoggingInvalidTLS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineLogging: &v1alpha1.LoggingConfig{
+						LoggingDestinations: []v1alpha1.LoggingDestination{
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{
+									URL: &url.URL{Scheme: "udp", Host: "otel.example.com:4317"},
+								},
+								LoggingFormat: constants.LoggingFormatOTLP,
+							},
+							{
+								LoggingEndpoint: &v1alpha1.Endpoint{
+									URL: &url.URL{Scheme: "tcp", Host: "127.0.0.1:12345"},
+								},
+								LoggingFormat: constants.LoggingFormatJSONLines,
+								LoggingTLS:    &v1alpha1.LoggingTLSConfig{},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* logging format \"otlp\" requires \"tcp\" endpoint scheme\n" +
+				"\t* logging format \"json_lines\" doesn't support TLS\n\n",
+		},
+		{
+			name: "EncryptionKeyTPM",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						EphemeralPartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeyTPM:  &v1alpha1.EncryptionKeyTPM{},
+									KeySlot: 0,
+								},
+								{
+									KeyTPM: &v1alpha1.EncryptionKeyTPM{
+										TPMPCRs: []int{0, 7},
+									},
+									KeySlot: 1,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EncryptionKeyInvalidTPMPCR",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						StatePartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeyTPM: &v1alpha1.EncryptionKeyTPM{
+										TPMPCRs: []int{7, 24},
+									},
+									KeySlot: 0,
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* encryption key at slot 0 has invalid TPM PCR 24\n\n",
+		},
 	} {
 		test := test
 
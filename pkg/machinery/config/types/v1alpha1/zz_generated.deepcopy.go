@@ -547,6 +547,21 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DHCPUserClasses != nil {
+		in, out := &in.DHCPUserClasses, &out.DHCPUserClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPRequestOptions != nil {
+		in, out := &in.DHCPRequestOptions, &out.DHCPRequestOptions
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPPrefixDelegation != nil {
+		in, out := &in.DHCPPrefixDelegation, &out.DHCPPrefixDelegation
+		*out = new(DHCPPrefixDelegation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -560,6 +575,49 @@ func (in *DHCPOptions) DeepCopy() *DHCPOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPPrefixDelegation) DeepCopyInto(out *DHCPPrefixDelegation) {
+	*out = *in
+	if in.PrefixDelegationSubnets != nil {
+		in, out := &in.PrefixDelegationSubnets, &out.PrefixDelegationSubnets
+		*out = make([]*DHCPPrefixDelegationSubnet, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DHCPPrefixDelegationSubnet)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPPrefixDelegation.
+func (in *DHCPPrefixDelegation) DeepCopy() *DHCPPrefixDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPPrefixDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPPrefixDelegationSubnet) DeepCopyInto(out *DHCPPrefixDelegationSubnet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPPrefixDelegationSubnet.
+func (in *DHCPPrefixDelegationSubnet) DeepCopy() *DHCPPrefixDelegationSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPPrefixDelegationSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Device) DeepCopyInto(out *Device) {
 	*out = *in
@@ -594,6 +652,21 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(Bridge)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceVXLAN != nil {
+		in, out := &in.DeviceVXLAN, &out.DeviceVXLAN
+		*out = new(VXLAN)
+		**out = **in
+	}
+	if in.DeviceVRF != nil {
+		in, out := &in.DeviceVRF, &out.DeviceVRF
+		*out = new(VRF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceMACVLAN != nil {
+		in, out := &in.DeviceMACVLAN, &out.DeviceMACVLAN
+		*out = new(MACVLAN)
+		**out = **in
+	}
 	if in.DeviceVlans != nil {
 		in, out := &in.DeviceVlans, &out.DeviceVlans
 		*out = make([]*Vlan, len(*in))
@@ -635,6 +708,16 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(DeviceVIPConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceEthtool != nil {
+		in, out := &in.DeviceEthtool, &out.DeviceEthtool
+		*out = new(Ethtool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceRouterAdvertisement != nil {
+		in, out := &in.DeviceRouterAdvertisement, &out.DeviceRouterAdvertisement
+		*out = new(RouterAdvertisement)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -661,6 +744,16 @@ func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 		*out = new(VIPHCloudConfig)
 		**out = **in
 	}
+	if in.BGPConfig != nil {
+		in, out := &in.BGPConfig, &out.BGPConfig
+		*out = new(VIPBGPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesLeaseConfig != nil {
+		in, out := &in.KubernetesLeaseConfig, &out.KubernetesLeaseConfig
+		*out = new(VIPKubernetesLeaseConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -801,6 +894,16 @@ func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
 		*out = new(EncryptionKeyNodeID)
 		**out = **in
 	}
+	if in.KeyTPM != nil {
+		in, out := &in.KeyTPM, &out.KeyTPM
+		*out = new(EncryptionKeyTPM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyKMS != nil {
+		in, out := &in.KeyKMS, &out.KeyKMS
+		*out = new(EncryptionKeyKMS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -814,6 +917,31 @@ func (in *EncryptionKey) DeepCopy() *EncryptionKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyKMS) DeepCopyInto(out *EncryptionKeyKMS) {
+	*out = *in
+	if in.KMSClientIdentity != nil {
+		in, out := &in.KMSClientIdentity, &out.KMSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.KMSCA != nil {
+		in, out := &in.KMSCA, &out.KMSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyKMS.
+func (in *EncryptionKeyKMS) DeepCopy() *EncryptionKeyKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionKeyNodeID) DeepCopyInto(out *EncryptionKeyNodeID) {
 	*out = *in
@@ -846,6 +974,69 @@ func (in *EncryptionKeyStatic) DeepCopy() *EncryptionKeyStatic {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyTPM) DeepCopyInto(out *EncryptionKeyTPM) {
+	*out = *in
+	if in.TPMPCRs != nil {
+		in, out := &in.TPMPCRs, &out.TPMPCRs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyTPM.
+func (in *EncryptionKeyTPM) DeepCopy() *EncryptionKeyTPM {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyTPM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupConfig) DeepCopyInto(out *EtcdBackupConfig) {
+	*out = *in
+	if in.BackupEnabled != nil {
+		in, out := &in.BackupEnabled, &out.BackupEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackupS3 != nil {
+		in, out := &in.BackupS3, &out.BackupS3
+		*out = new(EtcdBackupS3Config)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupConfig.
+func (in *EtcdBackupConfig) DeepCopy() *EtcdBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupS3Config) DeepCopyInto(out *EtcdBackupS3Config) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupS3Config.
+func (in *EtcdBackupS3Config) DeepCopy() *EtcdBackupS3Config {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupS3Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -870,6 +1061,21 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EtcdBackupConfig != nil {
+		in, out := &in.EtcdBackupConfig, &out.EtcdBackupConfig
+		*out = new(EtcdBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdDefragmentationConfig != nil {
+		in, out := &in.EtcdDefragmentationConfig, &out.EtcdDefragmentationConfig
+		*out = new(EtcdDefragmentationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdExternalEndpoints != nil {
+		in, out := &in.EtcdExternalEndpoints, &out.EtcdExternalEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -883,6 +1089,92 @@ func (in *EtcdConfig) DeepCopy() *EtcdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdDefragmentationConfig) DeepCopyInto(out *EtcdDefragmentationConfig) {
+	*out = *in
+	if in.DefragEnabled != nil {
+		in, out := &in.DefragEnabled, &out.DefragEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdDefragmentationConfig.
+func (in *EtcdDefragmentationConfig) DeepCopy() *EtcdDefragmentationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdDefragmentationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ethtool) DeepCopyInto(out *Ethtool) {
+	*out = *in
+	if in.EthtoolRings != nil {
+		in, out := &in.EthtoolRings, &out.EthtoolRings
+		*out = new(EthtoolRings)
+		**out = **in
+	}
+	if in.EthtoolChannels != nil {
+		in, out := &in.EthtoolChannels, &out.EthtoolChannels
+		*out = new(EthtoolChannels)
+		**out = **in
+	}
+	if in.EthtoolFeatures != nil {
+		in, out := &in.EthtoolFeatures, &out.EthtoolFeatures
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ethtool.
+func (in *Ethtool) DeepCopy() *Ethtool {
+	if in == nil {
+		return nil
+	}
+	out := new(Ethtool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolChannels) DeepCopyInto(out *EthtoolChannels) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolChannels.
+func (in *EthtoolChannels) DeepCopy() *EthtoolChannels {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolChannels)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolRings) DeepCopyInto(out *EthtoolRings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolRings.
+func (in *EthtoolRings) DeepCopy() *EthtoolRings {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolRings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalCloudProviderConfig) DeepCopyInto(out *ExternalCloudProviderConfig) {
 	*out = *in
@@ -953,6 +1245,11 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HostDNSSupport != nil {
+		in, out := &in.HostDNSSupport, &out.HostDNSSupport
+		*out = new(HostDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -966,6 +1263,27 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSConfig) DeepCopyInto(out *HostDNSConfig) {
+	*out = *in
+	if in.HostDNSEnabled != nil {
+		in, out := &in.HostDNSEnabled, &out.HostDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSConfig.
+func (in *HostDNSConfig) DeepCopy() *HostDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 	*out = *in
@@ -1153,6 +1471,7 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		}
 	}
 	in.KubeletExtraConfig.DeepCopyInto(&out.KubeletExtraConfig)
+	in.KubeletCredentialProviderConfig.DeepCopyInto(&out.KubeletCredentialProviderConfig)
 	if in.KubeletDefaultRuntimeSeccompProfileEnabled != nil {
 		in, out := &in.KubeletDefaultRuntimeSeccompProfileEnabled, &out.KubeletDefaultRuntimeSeccompProfileEnabled
 		*out = new(bool)
@@ -1268,6 +1587,16 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 		in, out := &in.LoggingEndpoint, &out.LoggingEndpoint
 		*out = (*in).DeepCopy()
 	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingSpool != nil {
+		in, out := &in.LoggingSpool, &out.LoggingSpool
+		*out = new(LoggingSpoolConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1281,6 +1610,73 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSpoolConfig) DeepCopyInto(out *LoggingSpoolConfig) {
+	*out = *in
+	if in.SpoolEnabled != nil {
+		in, out := &in.SpoolEnabled, &out.SpoolEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpoolConfig.
+func (in *LoggingSpoolConfig) DeepCopy() *LoggingSpoolConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSpoolConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSClientIdentity != nil {
+		in, out := &in.TLSClientIdentity, &out.TLSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSInsecureSkipVerify != nil {
+		in, out := &in.TLSInsecureSkipVerify, &out.TLSInsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACVLAN) DeepCopyInto(out *MACVLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACVLAN.
+func (in *MACVLAN) DeepCopy() *MACVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(MACVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1405,6 +1801,11 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineSwap != nil {
+		in, out := &in.MachineSwap, &out.MachineSwap
+		*out = new(SwapConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1586,6 +1987,17 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkRoutingRules != nil {
+		in, out := &in.NetworkRoutingRules, &out.NetworkRoutingRules
+		*out = make([]*RoutingRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RoutingRule)
+				**out = **in
+			}
+		}
+	}
 	return
 }
 
@@ -1913,6 +2325,48 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterAdvertisement) DeepCopyInto(out *RouterAdvertisement) {
+	*out = *in
+	if in.RAAccept != nil {
+		in, out := &in.RAAccept, &out.RAAccept
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RASLAAC != nil {
+		in, out := &in.RASLAAC, &out.RASLAAC
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAdvertisement.
+func (in *RouterAdvertisement) DeepCopy() *RouterAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *STP) DeepCopyInto(out *STP) {
 	*out = *in
@@ -1969,6 +2423,59 @@ func (in *SchedulerConfig) DeepCopy() *SchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwapConfig) DeepCopyInto(out *SwapConfig) {
+	*out = *in
+	if in.SwapZram != nil {
+		in, out := &in.SwapZram, &out.SwapZram
+		*out = new(ZramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SwapDevices != nil {
+		in, out := &in.SwapDevices, &out.SwapDevices
+		*out = make([]*SwapDeviceConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SwapDeviceConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwapConfig.
+func (in *SwapConfig) DeepCopy() *SwapConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SwapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwapDeviceConfig) DeepCopyInto(out *SwapDeviceConfig) {
+	*out = *in
+	if in.SwapPriority != nil {
+		in, out := &in.SwapPriority, &out.SwapPriority
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwapDeviceConfig.
+func (in *SwapDeviceConfig) DeepCopy() *SwapDeviceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SwapDeviceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemDiskEncryptionConfig) DeepCopyInto(out *SystemDiskEncryptionConfig) {
 	*out = *in
@@ -2049,6 +2556,49 @@ func (in *Unstructured) DeepCopyInto(out *Unstructured) {
 	return
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPConfig) DeepCopyInto(out *VIPBGPConfig) {
+	*out = *in
+	if in.BGPPeers != nil {
+		in, out := &in.BGPPeers, &out.BGPPeers
+		*out = make([]*VIPBGPPeer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VIPBGPPeer)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPConfig.
+func (in *VIPBGPConfig) DeepCopy() *VIPBGPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPPeer) DeepCopyInto(out *VIPBGPPeer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPPeer.
+func (in *VIPBGPPeer) DeepCopy() *VIPBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VIPEquinixMetalConfig) DeepCopyInto(out *VIPEquinixMetalConfig) {
 	*out = *in
@@ -2081,6 +2631,59 @@ func (in *VIPHCloudConfig) DeepCopy() *VIPHCloudConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPKubernetesLeaseConfig) DeepCopyInto(out *VIPKubernetesLeaseConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPKubernetesLeaseConfig.
+func (in *VIPKubernetesLeaseConfig) DeepCopy() *VIPKubernetesLeaseConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPKubernetesLeaseConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRF) DeepCopyInto(out *VRF) {
+	*out = *in
+	if in.VRFInterfaces != nil {
+		in, out := &in.VRFInterfaces, &out.VRFInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRF.
+func (in *VRF) DeepCopy() *VRF {
+	if in == nil {
+		return nil
+	}
+	out := new(VRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VXLAN) DeepCopyInto(out *VXLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VXLAN.
+func (in *VXLAN) DeepCopy() *VXLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(VXLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Vlan) DeepCopyInto(out *Vlan) {
 	*out = *in
@@ -2143,3 +2746,24 @@ func (in *VolumeMountConfig) DeepCopy() *VolumeMountConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZramConfig) DeepCopyInto(out *ZramConfig) {
+	*out = *in
+	if in.ZramPriority != nil {
+		in, out := &in.ZramPriority, &out.ZramPriority
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZramConfig.
+func (in *ZramConfig) DeepCopy() *ZramConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZramConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -152,6 +152,12 @@ const (
 	// the data path.
 	EphemeralMountPoint = "/var"
 
+	// DefaultEncryptionTPMPCR is the default PCR the TPM-sealed encryption key is bound to (Secure Boot state).
+	DefaultEncryptionTPMPCR = 7
+
+	// MaxTPMPCR is the maximum PCR index of the TPM 2.0 device.
+	MaxTPMPCR = 23
+
 	// RootMountPoint is the label of the partition to use for mounting at
 	// the root path.
 	RootMountPoint = "/"
@@ -278,6 +284,12 @@ const (
 	// SystemKubeletPKIDir is the path to the directory where Talos copies kubelet issued certificates and keys.
 	SystemKubeletPKIDir = "/system/secrets/kubelet"
 
+	// KubeletDefaultSwapBehavior is the default kubelet swap behavior when swap is enabled.
+	KubeletDefaultSwapBehavior = "LimitedSwap"
+
+	// ZramDefaultSwapPriority is the default priority of zram swap device.
+	ZramDefaultSwapPriority = 100
+
 	// KubeletShutdownGracePeriod is the kubelet shutdown grace period.
 	KubeletShutdownGracePeriod = 30 * time.Second
 
@@ -334,6 +346,12 @@ const (
 	// KubeletKubeconfig is the generated kubeconfig for kubelet.
 	KubeletKubeconfig = "/etc/kubernetes/kubeconfig-kubelet"
 
+	// KubeletCredentialProviderConfig is the path to the kubelet credential provider configuration.
+	KubeletCredentialProviderConfig = "/etc/kubernetes/kubelet-credentialproviderconfig.yaml"
+
+	// KubeletCredentialProviderBinDir is the path to the directory with kubelet credential provider binaries (installed by system extensions).
+	KubeletCredentialProviderBinDir = "/usr/local/lib/kubelet/credentialproviders"
+
 	// KubeletSystemReservedCPU cpu system reservation value for kubelet kubeconfig.
 	KubeletSystemReservedCPU = "50m"
 
@@ -358,6 +376,9 @@ const (
 	// EtcdTalosManifestApplyMutex is the etcd mutex prefix used by manifest apply controller.
 	EtcdTalosManifestApplyMutex = EtcdRootTalosKey + ":manifestApplyMutex"
 
+	// EtcdTalosDefragMutex is the etcd mutex prefix used by etcd defragmentation controller.
+	EtcdTalosDefragMutex = EtcdRootTalosKey + ":defragMutex"
+
 	// EtcdTalosServiceAccountCRDControllerMutex is the etcd mutex prefix used by Talos ServiceAccount crd controller.
 	EtcdTalosServiceAccountCRDControllerMutex = EtcdRootTalosKey + ":serviceAccountCRDController"
 
@@ -373,6 +394,36 @@ const (
 	// EtcdRecoverySnapshotPath is the path where etcd snapshot is uploaded for recovery.
 	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
 
+	// EtcdRoleVoter is the etcd role of the control plane node running a voting etcd member (default).
+	EtcdRoleVoter = "voter"
+
+	// EtcdRoleLearner is the etcd role of the control plane node running a non-voting etcd member.
+	EtcdRoleLearner = "learner"
+
+	// EtcdRoleNone is the etcd role of the control plane node which doesn't run etcd, and uses external etcd endpoints.
+	EtcdRoleNone = "none"
+
+	// EtcdBackupPath is the directory where scheduled etcd snapshots are stored.
+	EtcdBackupPath = "/var/lib/etcd-backup"
+
+	// EtcdBackupDefaultInterval is the default interval between scheduled etcd snapshots.
+	EtcdBackupDefaultInterval = time.Hour
+
+	// EtcdBackupDefaultRetentionCount is the default number of scheduled etcd snapshots to keep.
+	EtcdBackupDefaultRetentionCount = 24
+
+	// EtcdDefragDefaultInterval is the default interval between etcd fragmentation checks.
+	EtcdDefragDefaultInterval = time.Hour
+
+	// EtcdDefragDefaultThreshold is the default percentage of the etcd database file not in use which triggers defragmentation.
+	EtcdDefragDefaultThreshold = 50
+
+	// EtcdDefragDefaultMinDBSize is the default minimum size of the etcd database to be defragmented.
+	EtcdDefragDefaultMinDBSize = 100 * 1000 * 1000
+
+	// EtcdDefaultQuotaBackendBytes is the default etcd backend quota (etcd's own default), overridden with `quota-backend-bytes` extra arg.
+	EtcdDefaultQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
 	// EtcdUserID is the user ID for the etcd process.
 	EtcdUserID = 60
 
@@ -382,6 +433,14 @@ const (
 	// ConfigTryTimeout is the timeout of the config apply in try mode.
 	ConfigTryTimeout = time.Minute
 
+	// ConfigTryConfirmGracePeriod is the minimum time after the config apply in try mode before the confirmation is accepted.
+	//
+	// Network configuration should also stay unchanged for this period before the confirmation is accepted.
+	ConfigTryConfirmGracePeriod = 10 * time.Second
+
+	// ConfigTryRollbackPath is the path to the previous config kept until the config applied in 'try' mode is confirmed.
+	ConfigTryRollbackPath = StateMountPoint + "/config.yaml.rollback"
+
 	// MetalConfigISOLabel is the volume label for ISO based configuration.
 	MetalConfigISOLabel = "metal-iso"
 
@@ -548,6 +607,9 @@ const (
 	// FlannelCNI is the string to use Tanos-managed Flannel CNI (default).
 	FlannelCNI = "flannel"
 
+	// FlannelVXLANPort is the UDP port Flannel uses for VXLAN traffic.
+	FlannelVXLANPort = 4789
+
 	// CustomCNI is the string to use custom CNI managed by Tanos with extra manifests.
 	CustomCNI = "custom"
 
@@ -611,6 +673,14 @@ const (
 	// DefaultSecondaryResolver is the default secondary DNS server.
 	DefaultSecondaryResolver = "8.8.8.8"
 
+	// HostDNSAddress is the link-local address the host DNS caching resolver listens on.
+	//
+	// The address is reachable both from the host and from the pods.
+	HostDNSAddress = "169.254.116.108"
+
+	// HostDNSPort is the port the host DNS caching resolver listens on.
+	HostDNSPort = 53
+
 	// DefaultClusterIDSize is the default size in bytes for the cluster ID token.
 	DefaultClusterIDSize = 32
 
@@ -626,6 +696,9 @@ const (
 	// DefaultDiscoveryServiceEndpoint is the default endpoint for Talos discovery service.
 	DefaultDiscoveryServiceEndpoint = "https://discovery.talos.dev/"
 
+	// DefaultVXLANPort is the default VXLAN UDP port (IANA-assigned).
+	DefaultVXLANPort = 4789
+
 	// KubeSpanIdentityFilename is the filename to cache KubeSpan identity across reboots.
 	KubeSpanIdentityFilename = "kubespan-identity.yaml"
 
@@ -682,6 +755,18 @@ const (
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatSyslog represents RFC 5424 syslog logging format.
+	LoggingFormatSyslog = "syslog"
+
+	// LoggingFormatOTLP represents OpenTelemetry (OTLP/gRPC) logging format.
+	LoggingFormatOTLP = "otlp"
+
+	// LoggingSpoolPath is the path to the directory with on-disk spools of the logging destinations.
+	LoggingSpoolPath = "/var/log/spool"
+
+	// LoggingSpoolDefaultMaxSize is the default maximum size of the logging destination spool.
+	LoggingSpoolDefaultMaxSize = 64 * 1024 * 1024
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -691,6 +776,12 @@ const (
 	// PlatformNetworkConfigFilename is the filename to cache platform network configuration reboots.
 	PlatformNetworkConfigFilename = "platform-network.yaml"
 
+	// DHCP4LeaseFilenameFormat is the filename format to persist DHCPv4 leases across reboots (formatted with the link name).
+	DHCP4LeaseFilenameFormat = "dhcp4-%s.lease"
+
+	// NetNSPath is the path where named network namespaces (e.g. created by CNI) are bind-mounted.
+	NetNSPath = "/var/run/netns"
+
 	// FirmwarePath is the path to the standard Linux firmware location.
 	FirmwarePath = "/lib/firmware"
 
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubelet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+var (
+	credentialProviderConfigFields = map[string]struct{}{
+		"apiVersion": {},
+		"kind":       {},
+		"providers":  {},
+	}
+
+	credentialProviderFields = map[string]struct{}{
+		"name":                 {},
+		"matchImages":          {},
+		"defaultCacheDuration": {},
+		"apiVersion":           {},
+		"args":                 {},
+		"env":                  {},
+	}
+
+	credentialProviderEnvFields = map[string]struct{}{
+		"name":  {},
+		"value": {},
+	}
+)
+
+// ValidateCredentialProviderConfig validates the kubelet credential provider configuration.
+//
+// The configuration is validated against the kubelet CredentialProviderConfig API type:
+// unknown fields are rejected, and the fields required by the kubelet should be set.
+//
+//nolint:gocyclo,cyclop
+func ValidateCredentialProviderConfig(config map[string]interface{}) error {
+	var result *multierror.Error
+
+	appendErr := func(format string, args ...interface{}) {
+		result = multierror.Append(result, fmt.Errorf("kubelet credential provider config "+format, args...))
+	}
+
+	if kind, _ := config["kind"].(string); kind != CredentialProviderConfigKind { //nolint:errcheck
+		appendErr("kind should be %q, got %q", CredentialProviderConfigKind, kind)
+	}
+
+	if apiVersion, _ := config["apiVersion"].(string); !IsSupportedCredentialProviderConfigAPIVersion(apiVersion) { //nolint:errcheck
+		appendErr("apiVersion %q is not supported", apiVersion)
+	}
+
+	for _, field := range unknownFields(config, credentialProviderConfigFields) {
+		appendErr("field %q is not supported", field)
+	}
+
+	providers, ok := config["providers"].([]interface{})
+	if !ok || len(providers) == 0 {
+		appendErr("should have at least one provider")
+	}
+
+	for i, p := range providers {
+		provider, ok := p.(map[string]interface{})
+		if !ok {
+			appendErr("providers[%d] should be an object", i)
+
+			continue
+		}
+
+		for _, field := range unknownFields(provider, credentialProviderFields) {
+			appendErr("providers[%d]: field %q is not supported", i, field)
+		}
+
+		name, _ := provider["name"].(string) //nolint:errcheck
+
+		switch {
+		case name == "":
+			appendErr("providers[%d]: name is required", i)
+		case strings.Contains(name, "/") || name == "." || name == "..":
+			appendErr("providers[%d]: name %q should be a file name in %q", i, name, constants.KubeletCredentialProviderBinDir)
+		}
+
+		if matchImages, ok := provider["matchImages"].([]interface{}); !ok || len(matchImages) == 0 || !isStringList(matchImages) {
+			appendErr("providers[%d]: matchImages should be a non-empty list of strings", i)
+		}
+
+		if duration, ok := provider["defaultCacheDuration"].(string); !ok {
+			appendErr("providers[%d]: defaultCacheDuration is required", i)
+		} else if _, err := time.ParseDuration(duration); err != nil {
+			appendErr("providers[%d]: defaultCacheDuration %q is not valid: %s", i, duration, err)
+		}
+
+		if apiVersion, _ := provider["apiVersion"].(string); apiVersion == "" { //nolint:errcheck
+			appendErr("providers[%d]: apiVersion is required", i)
+		}
+
+		if args, ok := provider["args"]; ok && !isStringList(args) {
+			appendErr("providers[%d]: args should be a list of strings", i)
+		}
+
+		env, ok := provider["env"]
+		if !ok {
+			continue
+		}
+
+		envList, ok := env.([]interface{})
+		if !ok {
+			appendErr("providers[%d]: env should be a list", i)
+
+			continue
+		}
+
+		for j, e := range envList {
+			envVar, ok := e.(map[string]interface{})
+			if !ok {
+				appendErr("providers[%d].env[%d] should be an object", i, j)
+
+				continue
+			}
+
+			for _, field := range unknownFields(envVar, credentialProviderEnvFields) {
+				appendErr("providers[%d].env[%d]: field %q is not supported", i, j, field)
+			}
+
+			if name, _ := envVar["name"].(string); name == "" { //nolint:errcheck
+				appendErr("providers[%d].env[%d]: name is required", i, j)
+			}
+
+			if _, ok := envVar["value"].(string); !ok {
+				appendErr("providers[%d].env[%d]: value is required", i, j)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func unknownFields(obj map[string]interface{}, known map[string]struct{}) []string {
+	var fields []string
+
+	for field := range obj {
+		if _, ok := known[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+func isStringList(v interface{}) bool {
+	list, ok := v.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, item := range list {
+		if _, ok := item.(string); !ok {
+			return false
+		}
+	}
+
+	return true
+}
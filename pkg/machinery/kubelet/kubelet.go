@@ -22,3 +22,23 @@ var ProtectedConfigurationFields = []string{
 	"staticPodPath",
 	"seccompDefault",
 }
+
+// CredentialProviderConfigKind is the kind of the kubelet credential provider configuration.
+const CredentialProviderConfigKind = "CredentialProviderConfig"
+
+// CredentialProviderConfigAPIVersions is a list of supported kubelet credential provider configuration API versions.
+var CredentialProviderConfigAPIVersions = []string{
+	"kubelet.config.k8s.io/v1alpha1",
+	"kubelet.config.k8s.io/v1beta1",
+}
+
+// IsSupportedCredentialProviderConfigAPIVersion checks whether the credential provider configuration API version is supported.
+func IsSupportedCredentialProviderConfigAPIVersion(apiVersion string) bool {
+	for _, supported := range CredentialProviderConfigAPIVersions {
+		if apiVersion == supported {
+			return true
+		}
+	}
+
+	return false
+}
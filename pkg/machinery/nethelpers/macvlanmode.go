@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import "fmt"
+
+//go:generate enumer -type=MACVLANMode -linecomment -text
+
+// MACVLANMode is a MACVLAN mode.
+type MACVLANMode uint32
+
+// MACVLANMode constants.
+//
+// See linux/if_link.h.
+const (
+	MACVLANModePrivate  MACVLANMode = 1  // private
+	MACVLANModeVEPA     MACVLANMode = 2  // vepa
+	MACVLANModeBridge   MACVLANMode = 4  // bridge
+	MACVLANModePassthru MACVLANMode = 8  // passthru
+	MACVLANModeSource   MACVLANMode = 16 // source
+)
+
+// MACVLANModeByName converts string MACVLAN mode into a constant.
+func MACVLANModeByName(mode string) (MACVLANMode, error) {
+	switch mode {
+	case "", "bridge":
+		return MACVLANModeBridge, nil
+	case "private":
+		return MACVLANModePrivate, nil
+	case "vepa":
+		return MACVLANModeVEPA, nil
+	case "passthru":
+		return MACVLANModePassthru, nil
+	case "source":
+		return MACVLANModeSource, nil
+	default:
+		return 0, fmt.Errorf("invalid MACVLAN mode: %v", mode)
+	}
+}
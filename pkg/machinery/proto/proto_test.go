@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/protoenc"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	clusterpb "github.com/talos-systems/talos/pkg/machinery/api/resource/definitions/cluster"
@@ -166,6 +168,22 @@ func ExampleVIPOperatorSpec_outputProtoMarshal() {
 			NetworkId: 4,
 			ApiToken:  "d",
 		},
+		Bgp: &networkpb.VIPBGPSpec{
+			LocalAsn: 65000,
+			RouterId: &common.NetIP{Ip: try(netip.MustParseAddr("192.168.1.2").MarshalBinary())},
+			HoldTime: durationpb.New(90 * time.Second),
+			Peers: []*networkpb.VIPBGPPeerSpec{
+				{
+					Address: &common.NetIP{Ip: try(netip.MustParseAddr("192.168.1.254").MarshalBinary())},
+					Asn:     65001,
+					Port:    179,
+				},
+			},
+		},
+		KubernetesLease: &networkpb.VIPKubernetesLeaseSpec{
+			Namespace:           "kube-system",
+			ServiceAccountToken: "e",
+		},
 	}
 
 	result := try(proto.Marshal(spec))
@@ -175,9 +193,13 @@ func ExampleVIPOperatorSpec_outputProtoMarshal() {
 
 	// Output:
 	// 00000000  0a 06 0a 04 c0 a8 01 01  10 01 1a 09 0a 01 61 12  |..............a.|
-	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64        |.b..c".......d|
+	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64 2a 21  |.b..c".......d*!|
+	// 00000020  08 e8 fb 03 12 06 0a 04  c0 a8 01 02 1a 02 08 5a  |...............Z|
+	// 00000030  22 0f 0a 06 0a 04 c0 a8  01 fe 10 e9 fb 03 18 b3  |"...............|
+	// 00000040  01 32 10 0a 0b 6b 75 62  65 2d 73 79 73 74 65 6d  |.2...kube-system|
+	// 00000050  12 01 65                                          |..e|
 	//
-	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a0164
+	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a01642a2108e8fb0312060a04c0a801021a02085a220f0a060a04c0a801fe10e9fb0318b30132100a0b6b7562652d73797374656d120165
 }
 
 func ExampleVIPOperatorSpec_outputProtoencMarshal() {
@@ -194,6 +216,22 @@ func ExampleVIPOperatorSpec_outputProtoencMarshal() {
 			NetworkID: 4,
 			APIToken:  "d",
 		},
+		BGP: network.VIPBGPSpec{
+			LocalASN: 65000,
+			RouterID: netip.MustParseAddr("192.168.1.2"),
+			HoldTime: 90 * time.Second,
+			Peers: []network.VIPBGPPeerSpec{
+				{
+					Address: netip.MustParseAddr("192.168.1.254"),
+					ASN:     65001,
+					Port:    179,
+				},
+			},
+		},
+		KubernetesLease: network.VIPKubernetesLeaseSpec{
+			Namespace:           "kube-system",
+			ServiceAccountToken: "e",
+		},
 	}
 
 	result := try(protoenc.Marshal(spec))
@@ -203,9 +241,13 @@ func ExampleVIPOperatorSpec_outputProtoencMarshal() {
 
 	// Output:
 	// 00000000  0a 06 0a 04 c0 a8 01 01  10 01 1a 09 0a 01 61 12  |..............a.|
-	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64        |.b..c".......d|
+	// 00000010  01 62 1a 01 63 22 07 08  03 10 04 1a 01 64 2a 21  |.b..c".......d*!|
+	// 00000020  08 e8 fb 03 12 06 0a 04  c0 a8 01 02 1a 02 08 5a  |...............Z|
+	// 00000030  22 0f 0a 06 0a 04 c0 a8  01 fe 10 e9 fb 03 18 b3  |"...............|
+	// 00000040  01 32 10 0a 0b 6b 75 62  65 2d 73 79 73 74 65 6d  |.2...kube-system|
+	// 00000050  12 01 65                                          |..e|
 	//
-	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a0164
+	// 0a060a04c0a8010110011a090a01611201621a01632207080310041a01642a2108e8fb0312060a04c0a801021a02085a220f0a060a04c0a801fe10e9fb0318b30132100a0b6b7562652d73797374656d120165
 }
 
 func TestMemberSpec(t *testing.T) {
@@ -235,6 +277,22 @@ func TestVIPOperatorSpec(t *testing.T) {
 			NetworkID: 4,
 			APIToken:  "d",
 		},
+		BGP: network.VIPBGPSpec{
+			LocalASN: 65000,
+			RouterID: netip.MustParseAddr("192.168.1.2"),
+			HoldTime: 90 * time.Second,
+			Peers: []network.VIPBGPPeerSpec{
+				{
+					Address: netip.MustParseAddr("192.168.1.254"),
+					ASN:     65001,
+					Port:    179,
+				},
+			},
+		},
+		KubernetesLease: network.VIPKubernetesLeaseSpec{
+			Namespace:           "kube-system",
+			ServiceAccountToken: "e",
+		},
 	}
 
 	runTestPipe[networkpb.VIPOperatorSpec](t, spec)
@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// BackupStatusType is type of BackupStatus resource.
+const BackupStatusType = resource.Type("EtcdBackupStatuses.etcd.talos.dev")
+
+// BackupStatusID is resource ID for BackupStatus resource for etcd.
+const BackupStatusID = resource.ID("etcd")
+
+// BackupStatus resource holds status of scheduled etcd snapshots.
+type BackupStatus = typed.Resource[BackupStatusSpec, BackupStatusRD]
+
+// BackupStatusSpec describes status of scheduled etcd snapshots.
+//
+//gotagsrewrite:gen
+type BackupStatusSpec struct {
+	LastAttempt time.Time `yaml:"lastAttempt" protobuf:"1"`
+	LastSuccess time.Time `yaml:"lastSuccess" protobuf:"2"`
+	LastError   string    `yaml:"lastError,omitempty" protobuf:"3"`
+	// Name of the last successful snapshot.
+	Snapshot  string `yaml:"snapshot,omitempty" protobuf:"4"`
+	Size      uint64 `yaml:"size" protobuf:"5"`
+	Encrypted bool   `yaml:"encrypted" protobuf:"6"`
+	// Set if the last successful snapshot was uploaded by this member.
+	Uploaded    bool   `yaml:"uploaded" protobuf:"7"`
+	UploadError string `yaml:"uploadError,omitempty" protobuf:"8"`
+}
+
+// NewBackupStatus initializes a BackupStatus resource.
+func NewBackupStatus(namespace resource.Namespace, id resource.ID) *BackupStatus {
+	return typed.NewResource[BackupStatusSpec, BackupStatusRD](
+		resource.NewMetadata(namespace, BackupStatusType, id, resource.VersionUndefined),
+		BackupStatusSpec{},
+	)
+}
+
+// BackupStatusRD provides auxiliary methods for BackupStatus.
+type BackupStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (BackupStatusRD) ResourceDefinition(resource.Metadata, BackupStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BackupStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Last Success",
+				JSONPath: "{.lastSuccess}",
+			},
+			{
+				Name:     "Snapshot",
+				JSONPath: "{.snapshot}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+			{
+				Name:     "Last Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BackupStatusSpec](BackupStatusType, &BackupStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
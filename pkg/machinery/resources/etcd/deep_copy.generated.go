@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BackupStatusSpec -type ConfigSpec -type DefragStatusSpec -type PKIStatusSpec -type SpecSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package etcd
 
@@ -10,6 +10,12 @@ import (
 	"net/netip"
 )
 
+// DeepCopy generates a deep copy of BackupStatusSpec.
+func (o BackupStatusSpec) DeepCopy() BackupStatusSpec {
+	var cp BackupStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of ConfigSpec.
 func (o ConfigSpec) DeepCopy() ConfigSpec {
 	var cp ConfigSpec = o
@@ -38,6 +44,12 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DefragStatusSpec.
+func (o DefragStatusSpec) DeepCopy() DefragStatusSpec {
+	var cp DefragStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of PKIStatusSpec.
 func (o PKIStatusSpec) DeepCopy() PKIStatusSpec {
 	var cp PKIStatusSpec = o
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DefragStatusType is type of DefragStatus resource.
+const DefragStatusType = resource.Type("EtcdDefragStatuses.etcd.talos.dev")
+
+// DefragStatusID is resource ID for DefragStatus resource for etcd.
+const DefragStatusID = resource.ID("etcd")
+
+// DefragStatus resource holds status of etcd automatic defragmentation.
+type DefragStatus = typed.Resource[DefragStatusSpec, DefragStatusRD]
+
+// DefragStatusSpec describes status of etcd automatic defragmentation.
+//
+//gotagsrewrite:gen
+type DefragStatusSpec struct {
+	// The last fragmentation check and its outcome.
+	LastCheck   time.Time `yaml:"lastCheck" protobuf:"1"`
+	DBSize      int64     `yaml:"dbSize" protobuf:"2"`
+	DBSizeInUse int64     `yaml:"dbSizeInUse" protobuf:"3"`
+	Decision    string    `yaml:"decision" protobuf:"4"`
+	// The last successful defragmentation.
+	LastDefragmentation time.Time `yaml:"lastDefragmentation" protobuf:"5"`
+	DBSizeBefore        int64     `yaml:"dbSizeBefore" protobuf:"6"`
+	DBSizeAfter         int64     `yaml:"dbSizeAfter" protobuf:"7"`
+	// Set if the leadership was transferred before the defragmentation.
+	LeadershipTransferredTo string `yaml:"leadershipTransferredTo,omitempty" protobuf:"8"`
+	LastError               string `yaml:"lastError,omitempty" protobuf:"9"`
+}
+
+// NewDefragStatus initializes a DefragStatus resource.
+func NewDefragStatus(namespace resource.Namespace, id resource.ID) *DefragStatus {
+	return typed.NewResource[DefragStatusSpec, DefragStatusRD](
+		resource.NewMetadata(namespace, DefragStatusType, id, resource.VersionUndefined),
+		DefragStatusSpec{},
+	)
+}
+
+// DefragStatusRD provides auxiliary methods for DefragStatus.
+type DefragStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DefragStatusRD) ResourceDefinition(resource.Metadata, DefragStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DefragStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Last Check",
+				JSONPath: "{.lastCheck}",
+			},
+			{
+				Name:     "Decision",
+				JSONPath: "{.decision}",
+			},
+			{
+				Name:     "Last Defragmentation",
+				JSONPath: "{.lastDefragmentation}",
+			},
+			{
+				Name:     "Last Error",
+				JSONPath: "{.lastError}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DefragStatusSpec](DefragStatusType, &DefragStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -25,6 +25,8 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []resource.Resource{
+		&etcd.BackupStatus{},
+		&etcd.DefragStatus{},
 		&etcd.PKIStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
@@ -165,6 +165,12 @@ func (o KubeletSpecSpec) DeepCopy() KubeletSpecSpec {
 			cp.Config[k2] = v2
 		}
 	}
+	if o.CredentialProviderConfig != nil {
+		cp.CredentialProviderConfig = make(map[string]interface{}, len(o.CredentialProviderConfig))
+		for k2, v2 := range o.CredentialProviderConfig {
+			cp.CredentialProviderConfig[k2] = v2
+		}
+	}
 	return cp
 }
 
@@ -239,6 +245,12 @@ func (o KubeletConfigSpec) DeepCopy() KubeletConfigSpec {
 			cp.ExtraConfig[k2] = v2
 		}
 	}
+	if o.CredentialProviderConfig != nil {
+		cp.CredentialProviderConfig = make(map[string]interface{}, len(o.CredentialProviderConfig))
+		for k2, v2 := range o.CredentialProviderConfig {
+			cp.CredentialProviderConfig[k2] = v2
+		}
+	}
 	return cp
 }
 
@@ -36,6 +36,8 @@ type KubeletConfigSpec struct {
 	CloudProviderExternal        bool                   `yaml:"cloudProviderExternal" protobuf:"7"`
 	DefaultRuntimeSeccompEnabled bool                   `yaml:"defaultRuntimeSeccompEnabled" protobuf:"8"`
 	SkipNodeRegistration         bool                   `yaml:"skipNodeRegistration" protobuf:"9"`
+	CredentialProviderConfig     map[string]interface{} `yaml:"credentialProviderConfig,omitempty" protobuf:"10"`
+	SwapBehavior                 string                 `yaml:"swapBehavior,omitempty" protobuf:"11"`
 }
 
 // NewKubeletConfig initializes an empty KubeletConfig resource.
@@ -29,6 +29,8 @@ type KubeletSpecSpec struct {
 	ExtraMounts      []specs.Mount          `yaml:"extraMounts,omitempty" protobuf:"3"`
 	ExpectedNodename string                 `yaml:"expectedNodename,omitempty" protobuf:"4"`
 	Config           map[string]interface{} `yaml:"config" protobuf:"5"`
+
+	CredentialProviderConfig map[string]interface{} `yaml:"credentialProviderConfig,omitempty" protobuf:"6"`
 }
 
 // NewKubeletSpec initializes an empty KubeletSpec resource.
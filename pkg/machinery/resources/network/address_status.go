@@ -36,6 +36,8 @@ type AddressStatusSpec struct {
 	Family    nethelpers.Family       `yaml:"family" protobuf:"8"`
 	Scope     nethelpers.Scope        `yaml:"scope" protobuf:"9"`
 	Flags     nethelpers.AddressFlags `yaml:"flags" protobuf:"10"`
+	// SLAAC is set for IPv6 addresses autoconfigured by the kernel from router advertisements.
+	SLAAC bool `yaml:"slaac,omitempty" protobuf:"11"`
 }
 
 // NewAddressStatus initializes a AddressStatus resource.
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSUpstreamSpec -type DelegatedPrefixSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NetworkDefaultActionSpecSpec -type NetworkRuleSpecSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -22,6 +22,18 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DNSUpstreamSpec.
+func (o DNSUpstreamSpec) DeepCopy() DNSUpstreamSpec {
+	var cp DNSUpstreamSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DelegatedPrefixSpec.
+func (o DelegatedPrefixSpec) DeepCopy() DelegatedPrefixSpec {
+	var cp DelegatedPrefixSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of HardwareAddrSpec.
 func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	var cp HardwareAddrSpec = o
@@ -32,6 +44,12 @@ func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of HostDNSConfigSpec.
+func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
+	var cp HostDNSConfigSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of HostnameSpecSpec.
 func (o HostnameSpecSpec) DeepCopy() HostnameSpecSpec {
 	var cp HostnameSpecSpec = o
@@ -63,6 +81,12 @@ func (o LinkSpecSpec) DeepCopy() LinkSpecSpec {
 			}
 		}
 	}
+	if o.Ethtool.Features != nil {
+		cp.Ethtool.Features = make(map[string]bool, len(o.Ethtool.Features))
+		for k3, v3 := range o.Ethtool.Features {
+			cp.Ethtool.Features[k3] = v3
+		}
+	}
 	return cp
 }
 
@@ -91,6 +115,32 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 			}
 		}
 	}
+	if o.Ethtool.Features != nil {
+		cp.Ethtool.Features = make(map[string]bool, len(o.Ethtool.Features))
+		for k3, v3 := range o.Ethtool.Features {
+			cp.Ethtool.Features[k3] = v3
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of NetworkDefaultActionSpecSpec.
+func (o NetworkDefaultActionSpecSpec) DeepCopy() NetworkDefaultActionSpecSpec {
+	var cp NetworkDefaultActionSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of NetworkRuleSpecSpec.
+func (o NetworkRuleSpecSpec) DeepCopy() NetworkRuleSpecSpec {
+	var cp NetworkRuleSpecSpec = o
+	if o.PortSelector.Ports != nil {
+		cp.PortSelector.Ports = make([]PortRange, len(o.PortSelector.Ports))
+		copy(cp.PortSelector.Ports, o.PortSelector.Ports)
+	}
+	if o.Ingress != nil {
+		cp.Ingress = make([]IngressRule, len(o.Ingress))
+		copy(cp.Ingress, o.Ingress)
+	}
 	return cp
 }
 
@@ -121,6 +171,22 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.UserClasses != nil {
+		cp.DHCP4.UserClasses = make([]string, len(o.DHCP4.UserClasses))
+		copy(cp.DHCP4.UserClasses, o.DHCP4.UserClasses)
+	}
+	if o.DHCP4.RequestOptions != nil {
+		cp.DHCP4.RequestOptions = make([]uint32, len(o.DHCP4.RequestOptions))
+		copy(cp.DHCP4.RequestOptions, o.DHCP4.RequestOptions)
+	}
+	if o.DHCP6.DelegatedSubnets != nil {
+		cp.DHCP6.DelegatedSubnets = make([]DHCP6DelegatedSubnetSpec, len(o.DHCP6.DelegatedSubnets))
+		copy(cp.DHCP6.DelegatedSubnets, o.DHCP6.DelegatedSubnets)
+	}
+	if o.VIP.BGP.Peers != nil {
+		cp.VIP.BGP.Peers = make([]VIPBGPPeerSpec, len(o.VIP.BGP.Peers))
+		copy(cp.VIP.BGP.Peers, o.VIP.BGP.Peers)
+	}
 	return cp
 }
 
@@ -156,6 +222,18 @@ func (o RouteStatusSpec) DeepCopy() RouteStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RoutingRuleSpecSpec.
+func (o RoutingRuleSpecSpec) DeepCopy() RoutingRuleSpecSpec {
+	var cp RoutingRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RoutingRuleStatusSpec.
+func (o RoutingRuleStatusSpec) DeepCopy() RoutingRuleStatusSpec {
+	var cp RoutingRuleStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DelegatedPrefixType is type of DelegatedPrefix resource.
+const DelegatedPrefixType = resource.Type("DelegatedPrefixes.net.talos.dev")
+
+// DelegatedPrefix resource holds the IPv6 prefix delegated to the node via DHCPv6.
+type DelegatedPrefix = typed.Resource[DelegatedPrefixSpec, DelegatedPrefixRD]
+
+// DelegatedPrefixSpec describes the delegated prefix.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixSpec struct {
+	// LinkName is the upstream link the prefix was acquired on.
+	LinkName string       `yaml:"linkName" protobuf:"1"`
+	Prefix   netip.Prefix `yaml:"prefix" protobuf:"2"`
+
+	PreferredLifetime time.Duration `yaml:"preferredLifetime" protobuf:"3"`
+	ValidLifetime     time.Duration `yaml:"validLifetime" protobuf:"4"`
+}
+
+// NewDelegatedPrefix initializes a DelegatedPrefix resource.
+func NewDelegatedPrefix(namespace resource.Namespace, id resource.ID) *DelegatedPrefix {
+	return typed.NewResource[DelegatedPrefixSpec, DelegatedPrefixRD](
+		resource.NewMetadata(namespace, DelegatedPrefixType, id, resource.VersionUndefined),
+		DelegatedPrefixSpec{},
+	)
+}
+
+// DelegatedPrefixRD provides auxiliary methods for DelegatedPrefix.
+type DelegatedPrefixRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DelegatedPrefixRD) ResourceDefinition(resource.Metadata, DelegatedPrefixSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DelegatedPrefixType,
+		Aliases:          []resource.Type{"delegatedprefix", "delegatedprefixes"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Prefix",
+				JSONPath: `{.prefix}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DelegatedPrefixSpec](DelegatedPrefixType, &DelegatedPrefix{})
+	if err != nil {
+		panic(err)
+	}
+}
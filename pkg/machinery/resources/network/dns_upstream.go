@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// DNSUpstreamType is type of DNSUpstream resource.
+const DNSUpstreamType = resource.Type("DNSUpstreams.net.talos.dev")
+
+// DNSUpstream resource holds the status of the host DNS caching resolver upstream.
+type DNSUpstream = typed.Resource[DNSUpstreamSpec, DNSUpstreamRD]
+
+// DNSUpstreamSpec describes the host DNS caching resolver upstream.
+//
+//gotagsrewrite:gen
+type DNSUpstreamSpec struct {
+	Address netip.AddrPort `yaml:"address" protobuf:"1"`
+	Healthy bool           `yaml:"healthy" protobuf:"2"`
+}
+
+// NewDNSUpstream initializes a DNSUpstream resource.
+func NewDNSUpstream(namespace resource.Namespace, id resource.ID) *DNSUpstream {
+	return typed.NewResource[DNSUpstreamSpec, DNSUpstreamRD](
+		resource.NewMetadata(namespace, DNSUpstreamType, id, resource.VersionUndefined),
+		DNSUpstreamSpec{},
+	)
+}
+
+// DNSUpstreamRD provides auxiliary methods for DNSUpstream.
+type DNSUpstreamRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (DNSUpstreamRD) ResourceDefinition(resource.Metadata, DNSUpstreamSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DNSUpstreamType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Healthy",
+				JSONPath: "{.healthy}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DNSUpstreamSpec](DNSUpstreamType, &DNSUpstream{})
+	if err != nil {
+		panic(err)
+	}
+}
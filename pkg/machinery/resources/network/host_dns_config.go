@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// HostDNSConfigType is type of HostDNSConfig resource.
+const HostDNSConfigType = resource.Type("HostDNSConfigs.net.talos.dev")
+
+// HostDNSConfig resource holds the host DNS caching resolver configuration.
+type HostDNSConfig = typed.Resource[HostDNSConfigSpec, HostDNSConfigRD]
+
+// HostDNSConfigID is the ID of the singleton instance.
+const HostDNSConfigID resource.ID = "config"
+
+// HostDNSConfigSpec describes the host DNS caching resolver configuration.
+//
+//gotagsrewrite:gen
+type HostDNSConfigSpec struct {
+	Enabled       bool           `yaml:"enabled" protobuf:"1"`
+	ListenAddress netip.AddrPort `yaml:"listenAddress" protobuf:"2"`
+}
+
+// NewHostDNSConfig initializes a HostDNSConfig resource.
+func NewHostDNSConfig(namespace resource.Namespace, id resource.ID) *HostDNSConfig {
+	return typed.NewResource[HostDNSConfigSpec, HostDNSConfigRD](
+		resource.NewMetadata(namespace, HostDNSConfigType, id, resource.VersionUndefined),
+		HostDNSConfigSpec{},
+	)
+}
+
+// HostDNSConfigRD provides auxiliary methods for HostDNSConfig.
+type HostDNSConfigRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (HostDNSConfigRD) ResourceDefinition(resource.Metadata, HostDNSConfigSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             HostDNSConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Enabled",
+				JSONPath: "{.enabled}",
+			},
+			{
+				Name:     "Listen Address",
+				JSONPath: "{.listenAddress}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[HostDNSConfigSpec](HostDNSConfigType, &HostDNSConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -91,6 +91,125 @@ type WireguardPeer struct {
 	AllowedIPs                  []netip.Prefix `yaml:"allowedIPs" protobuf:"5"`
 }
 
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+//
+//gotagsrewrite:gen
+type VXLANSpec struct {
+	// VNI is the VXLAN Network Identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+
+	// Local is the source address of the outgoing packets.
+	Local netip.Addr `yaml:"local,omitempty" protobuf:"2"`
+
+	// Remote is the remote VTEP address or the multicast group address.
+	Remote netip.Addr `yaml:"remote,omitempty" protobuf:"3"`
+
+	// Port is the UDP destination port.
+	Port uint16 `yaml:"port" protobuf:"4"`
+
+	// TTL of the outgoing packets, 0 means inherit.
+	TTL uint8 `yaml:"ttl,omitempty" protobuf:"5"`
+}
+
+// VRFMasterSpec describes VRF settings if Kind == "vrf".
+//
+//gotagsrewrite:gen
+type VRFMasterSpec struct {
+	Table nethelpers.RoutingTable `yaml:"table" protobuf:"1"`
+}
+
+// MACVLANSpec describes MACVLAN settings if Kind == "macvlan".
+//
+//gotagsrewrite:gen
+type MACVLANSpec struct {
+	Mode nethelpers.MACVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// EthtoolSpec describes ethtool settings of the link.
+//
+// Zero values mean that the setting is left unchanged.
+//
+//gotagsrewrite:gen
+type EthtoolSpec struct {
+	RXRingSize uint32 `yaml:"rxRingSize,omitempty" protobuf:"1"`
+	TXRingSize uint32 `yaml:"txRingSize,omitempty" protobuf:"2"`
+
+	RXChannels       uint32 `yaml:"rxChannels,omitempty" protobuf:"3"`
+	TXChannels       uint32 `yaml:"txChannels,omitempty" protobuf:"4"`
+	CombinedChannels uint32 `yaml:"combinedChannels,omitempty" protobuf:"5"`
+
+	// Features to enable or disable, kernel feature names are used as keys.
+	Features map[string]bool `yaml:"features,omitempty" protobuf:"6"`
+
+	// Speed (in Mbit/s) and duplex to force, disabling autonegotiation.
+	//
+	// Duplex is used only if the Speed is set.
+	Speed  uint32            `yaml:"speed,omitempty" protobuf:"7"`
+	Duplex nethelpers.Duplex `yaml:"duplex,omitempty" protobuf:"8"`
+}
+
+// RouterAdvertisementSpec describes IPv6 router advertisement settings of the link.
+//
+// Settings are applied only if Configured is set, otherwise kernel defaults are used.
+//
+//gotagsrewrite:gen
+type RouterAdvertisementSpec struct {
+	Configured bool `yaml:"configured" protobuf:"1"`
+	Accept     bool `yaml:"accept" protobuf:"2"`
+	SLAAC      bool `yaml:"slaac" protobuf:"3"`
+}
+
+// EthtoolStatus describes current ethtool settings of the link.
+//
+//gotagsrewrite:gen
+type EthtoolStatus struct {
+	RXRingSize    uint32 `yaml:"rxRingSize,omitempty" protobuf:"1"`
+	RXRingSizeMax uint32 `yaml:"rxRingSizeMax,omitempty" protobuf:"2"`
+	TXRingSize    uint32 `yaml:"txRingSize,omitempty" protobuf:"3"`
+	TXRingSizeMax uint32 `yaml:"txRingSizeMax,omitempty" protobuf:"4"`
+
+	RXChannels       uint32 `yaml:"rxChannels,omitempty" protobuf:"5"`
+	TXChannels       uint32 `yaml:"txChannels,omitempty" protobuf:"6"`
+	CombinedChannels uint32 `yaml:"combinedChannels,omitempty" protobuf:"7"`
+
+	Features map[string]bool `yaml:"features,omitempty" protobuf:"8"`
+}
+
+// IsZero checks if the EthtoolSpec is zero value.
+func (spec *EthtoolSpec) IsZero() bool {
+	return spec.RXRingSize == 0 && spec.TXRingSize == 0 &&
+		spec.RXChannels == 0 && spec.TXChannels == 0 && spec.CombinedChannels == 0 &&
+		len(spec.Features) == 0 && spec.Speed == 0
+}
+
+// Merge with other ethtool spec overwriting non-zero values.
+func (spec *EthtoolSpec) Merge(other EthtoolSpec) {
+	updateIfNotZero(&spec.RXRingSize, other.RXRingSize)
+	updateIfNotZero(&spec.TXRingSize, other.TXRingSize)
+	updateIfNotZero(&spec.RXChannels, other.RXChannels)
+	updateIfNotZero(&spec.TXChannels, other.TXChannels)
+	updateIfNotZero(&spec.CombinedChannels, other.CombinedChannels)
+
+	if other.Speed != 0 {
+		spec.Speed = other.Speed
+		spec.Duplex = other.Duplex
+	}
+
+	if len(other.Features) > 0 {
+		features := make(map[string]bool, len(spec.Features)+len(other.Features))
+
+		for k, v := range spec.Features {
+			features[k] = v
+		}
+
+		for k, v := range other.Features {
+			features[k] = v
+		}
+
+		spec.Features = features
+	}
+}
+
 // ID Returns the VID for type VLANSpec.
 func (vlan VLANSpec) ID() uint16 {
 	return vlan.VID
@@ -42,7 +42,7 @@ type LinkSpecSpec struct {
 	Kind string              `yaml:"kind" protobuf:"5"`
 	Type nethelpers.LinkType `yaml:"type" protobuf:"6"`
 
-	// ParentName indicates link parent for VLAN interfaces.
+	// ParentName indicates link parent for VLAN, VXLAN and MACVLAN interfaces.
 	ParentName string `yaml:"parentName,omitempty" protobuf:"7"`
 
 	// MasterName indicates master link for enslaved bonded interfaces.
@@ -59,6 +59,19 @@ type LinkSpecSpec struct {
 
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"14"`
+
+	VXLAN     VXLANSpec     `yaml:"vxlan,omitempty" protobuf:"15"`
+	VRFMaster VRFMasterSpec `yaml:"vrfMaster,omitempty" protobuf:"16"`
+	MACVLAN   MACVLANSpec   `yaml:"macvlan,omitempty" protobuf:"17"`
+
+	// VRFSlave indicates master link for interfaces enslaved to the VRF.
+	VRFSlave VRFSlave `yaml:"vrfSlave,omitempty" protobuf:"18"`
+
+	// Ethtool settings of the link.
+	Ethtool EthtoolSpec `yaml:"ethtool,omitempty" protobuf:"19"`
+
+	// IPv6 router advertisement settings of the link.
+	RouterAdvertisement RouterAdvertisementSpec `yaml:"routerAdvertisement,omitempty" protobuf:"20"`
 }
 
 // BondSlave contains a bond's master name and slave index.
@@ -80,6 +93,14 @@ type BridgeSlave struct {
 	MasterName string `yaml:"masterName,omitempty" protobuf:"1"`
 }
 
+// VRFSlave contains a VRF master name.
+//
+//gotagsrewrite:gen
+type VRFSlave struct {
+	// MasterName indicates master link for interfaces enslaved to the VRF.
+	MasterName string `yaml:"masterName,omitempty" protobuf:"1"`
+}
+
 // Merge with other, overwriting fields from other if set.
 //
 //nolint:gocyclo
@@ -96,6 +117,11 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.BondMaster, other.BondMaster)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
 	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+	updateIfNotZero(&spec.VXLAN, other.VXLAN)
+	updateIfNotZero(&spec.VRFMaster, other.VRFMaster)
+	updateIfNotZero(&spec.MACVLAN, other.MACVLAN)
+	updateIfNotZero(&spec.VRFSlave, other.VRFSlave)
+	updateIfNotZero(&spec.RouterAdvertisement, other.RouterAdvertisement)
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
@@ -107,6 +133,10 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 		}
 	}
 
+	if !other.Ethtool.IsZero() {
+		spec.Ethtool.Merge(other.Ethtool)
+	}
+
 	spec.ConfigLayer = other.ConfigLayer
 
 	return nil
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
 )
 
@@ -216,3 +217,64 @@ func TestWireguardSpecMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestEthtoolSpecMerge(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		spec  network.EthtoolSpec
+		other network.EthtoolSpec
+
+		expected network.EthtoolSpec
+	}{
+		{
+			name: "zero",
+		},
+		{
+			name: "speczero",
+			other: network.EthtoolSpec{
+				RXRingSize: 4096,
+				Features:   map[string]bool{"rx-gro": true},
+			},
+
+			expected: network.EthtoolSpec{
+				RXRingSize: 4096,
+				Features:   map[string]bool{"rx-gro": true},
+			},
+		},
+		{
+			name: "merge",
+			spec: network.EthtoolSpec{
+				RXRingSize:       1024,
+				TXRingSize:       1024,
+				CombinedChannels: 4,
+				Features:         map[string]bool{"rx-gro": true, "rx-lro": false},
+				Speed:            10000,
+				Duplex:           nethelpers.Full,
+			},
+			other: network.EthtoolSpec{
+				RXRingSize: 4096,
+				Features:   map[string]bool{"rx-gro": false},
+				Speed:      1000,
+				Duplex:     nethelpers.Half,
+			},
+
+			expected: network.EthtoolSpec{
+				RXRingSize:       4096,
+				TXRingSize:       1024,
+				CombinedChannels: 4,
+				Features:         map[string]bool{"rx-gro": false, "rx-lro": false},
+				Speed:            1000,
+				Duplex:           nethelpers.Half,
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			spec.Merge(tt.other)
+
+			assert.Equal(t, tt.expected, spec)
+		})
+	}
+}
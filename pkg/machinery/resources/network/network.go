@@ -46,6 +46,11 @@ func RouteID(table nethelpers.RoutingTable, family nethelpers.Family, destinatio
 	return fmt.Sprintf("%s%s/%s/%s/%d", tablePrefix, family, string(gw), string(dst), priority)
 }
 
+// RoutingRuleID builds ID (primary key) for the routing rules.
+func RoutingRuleID(family nethelpers.Family, priority uint32) string {
+	return fmt.Sprintf("%s/%05d", family, priority)
+}
+
 // OperatorID builds ID (primary key) for the operators.
 func OperatorID(operator Operator, linkName string) string {
 	return fmt.Sprintf("%s/%s", operator, linkName)
@@ -62,4 +67,7 @@ const (
 	LinkKindBond      = "bond"
 	LinkKindBridge    = "bridge"
 	LinkKindWireguard = "wireguard"
+	LinkKindVXLAN     = "vxlan"
+	LinkKindVRF       = "vrf"
+	LinkKindMACVLAN   = "macvlan"
 )
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// NetworkDefaultActionSpecType is type of NetworkDefaultActionSpec resource.
+const NetworkDefaultActionSpecType = resource.Type("NetworkDefaultActionSpecs.net.talos.dev")
+
+// NetworkDefaultActionSpec resource holds the default host firewall action.
+//
+// Host firewall is enabled only if the resource exists.
+type NetworkDefaultActionSpec = typed.Resource[NetworkDefaultActionSpecSpec, NetworkDefaultActionSpecRD]
+
+// NetworkDefaultActionID is the ID of the singleton instance.
+const NetworkDefaultActionID resource.ID = "default-action"
+
+// NetworkDefaultActionSpecSpec describes the default host firewall action.
+//
+//gotagsrewrite:gen
+type NetworkDefaultActionSpecSpec struct {
+	Ingress nethelpers.DefaultAction `yaml:"ingress" protobuf:"1"`
+}
+
+// NewNetworkDefaultActionSpec initializes a NetworkDefaultActionSpec resource.
+func NewNetworkDefaultActionSpec(namespace resource.Namespace, id resource.ID) *NetworkDefaultActionSpec {
+	return typed.NewResource[NetworkDefaultActionSpecSpec, NetworkDefaultActionSpecRD](
+		resource.NewMetadata(namespace, NetworkDefaultActionSpecType, id, resource.VersionUndefined),
+		NetworkDefaultActionSpecSpec{},
+	)
+}
+
+// NetworkDefaultActionSpecRD provides auxiliary methods for NetworkDefaultActionSpec.
+type NetworkDefaultActionSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NetworkDefaultActionSpecRD) ResourceDefinition(resource.Metadata, NetworkDefaultActionSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NetworkDefaultActionSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Ingress",
+				JSONPath: "{.ingress}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NetworkDefaultActionSpecSpec](NetworkDefaultActionSpecType, &NetworkDefaultActionSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// NetworkRuleSpecType is type of NetworkRuleSpec resource.
+const NetworkRuleSpecType = resource.Type("NetworkRuleSpecs.net.talos.dev")
+
+// NetworkRuleSpec resource holds host firewall ingress rule.
+type NetworkRuleSpec = typed.Resource[NetworkRuleSpecSpec, NetworkRuleSpecRD]
+
+// NetworkRuleSpecSpec describes host firewall ingress rule.
+//
+// Traffic to the selected ports is accepted only from the listed subnets.
+//
+//gotagsrewrite:gen
+type NetworkRuleSpecSpec struct {
+	Name         string           `yaml:"name" protobuf:"1"`
+	PortSelector RulePortSelector `yaml:"portSelector" protobuf:"2"`
+	Ingress      []IngressRule    `yaml:"ingress" protobuf:"3"`
+}
+
+// RulePortSelector selects the ports the rule applies to.
+//
+//gotagsrewrite:gen
+type RulePortSelector struct {
+	Ports    []PortRange         `yaml:"ports" protobuf:"1"`
+	Protocol nethelpers.Protocol `yaml:"protocol" protobuf:"2"`
+}
+
+// PortRange is a range of ports (inclusive).
+//
+//gotagsrewrite:gen
+type PortRange struct {
+	Lo uint16 `yaml:"lo" protobuf:"1"`
+	Hi uint16 `yaml:"hi" protobuf:"2"`
+}
+
+// IngressRule describes the source subnet of the rule.
+//
+//gotagsrewrite:gen
+type IngressRule struct {
+	Subnet netip.Prefix `yaml:"subnet" protobuf:"1"`
+	Except netip.Prefix `yaml:"except,omitempty" protobuf:"2"`
+}
+
+// NewNetworkRuleSpec initializes a NetworkRuleSpec resource.
+func NewNetworkRuleSpec(namespace resource.Namespace, id resource.ID) *NetworkRuleSpec {
+	return typed.NewResource[NetworkRuleSpecSpec, NetworkRuleSpecRD](
+		resource.NewMetadata(namespace, NetworkRuleSpecType, id, resource.VersionUndefined),
+		NetworkRuleSpecSpec{},
+	)
+}
+
+// NetworkRuleSpecRD provides auxiliary methods for NetworkRuleSpec.
+type NetworkRuleSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NetworkRuleSpecRD) ResourceDefinition(resource.Metadata, NetworkRuleSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NetworkRuleSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Protocol",
+				JSONPath: "{.portSelector.protocol}",
+			},
+			{
+				Name:     "Ports",
+				JSONPath: "{.portSelector.ports}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NetworkRuleSpecSpec](NetworkRuleSpecType, &NetworkRuleSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -40,8 +41,13 @@ type OperatorSpecSpec struct {
 //
 //gotagsrewrite:gen
 type DHCP4OperatorSpec struct {
-	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"1"`
-	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	RouteMetric         uint32   `yaml:"routeMetric" protobuf:"1"`
+	SkipHostnameRequest bool     `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	Hostname            string   `yaml:"hostname,omitempty" protobuf:"3"`
+	VendorClass         string   `yaml:"vendorClass,omitempty" protobuf:"4"`
+	ClientIdentifier    string   `yaml:"clientIdentifier,omitempty" protobuf:"5"`
+	UserClasses         []string `yaml:"userClasses,omitempty" protobuf:"6"`
+	RequestOptions      []uint32 `yaml:"requestOptions,omitempty" protobuf:"7"`
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
@@ -51,6 +57,19 @@ type DHCP6OperatorSpec struct {
 	DUID                string `yaml:"DUID,omitempty" protobuf:"1"`
 	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"2"`
 	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
+
+	// PrefixDelegation enables requesting a prefix (IA_PD), PrefixLength is a hint for the server.
+	PrefixDelegation bool                       `yaml:"prefixDelegation,omitempty" protobuf:"4"`
+	PrefixLength     uint32                     `yaml:"prefixLength,omitempty" protobuf:"5"`
+	DelegatedSubnets []DHCP6DelegatedSubnetSpec `yaml:"delegatedSubnets,omitempty" protobuf:"6"`
+}
+
+// DHCP6DelegatedSubnetSpec describes a /64 subnet of the delegated prefix assigned to the downstream link.
+//
+//gotagsrewrite:gen
+type DHCP6DelegatedSubnetSpec struct {
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	SubnetID uint32 `yaml:"subnetID" protobuf:"2"`
 }
 
 // VIPOperatorSpec describes virtual IP operator options.
@@ -62,6 +81,9 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+	BGP          VIPBGPSpec          `yaml:"bgp,omitempty" protobuf:"5"`
+
+	KubernetesLease VIPKubernetesLeaseSpec `yaml:"kubernetesLease,omitempty" protobuf:"6"`
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -82,6 +104,35 @@ type VIPHCloudSpec struct {
 	APIToken  string `yaml:"apiToken" protobuf:"3"`
 }
 
+// VIPBGPSpec describes virtual IP settings for BGP announcement.
+//
+//gotagsrewrite:gen
+type VIPBGPSpec struct {
+	LocalASN uint32           `yaml:"localASN" protobuf:"1"`
+	RouterID netip.Addr       `yaml:"routerID,omitempty" protobuf:"2"`
+	HoldTime time.Duration    `yaml:"holdTime,omitempty" protobuf:"3"`
+	Peers    []VIPBGPPeerSpec `yaml:"peers" protobuf:"4"`
+}
+
+// VIPBGPPeerSpec describes the BGP peer.
+//
+//gotagsrewrite:gen
+type VIPBGPPeerSpec struct {
+	Address netip.Addr `yaml:"address" protobuf:"1"`
+	ASN     uint32     `yaml:"asn" protobuf:"2"`
+	Port    uint32     `yaml:"port,omitempty" protobuf:"3"`
+}
+
+// VIPKubernetesLeaseSpec describes virtual IP leader election via Kubernetes Lease.
+//
+// If Namespace is empty, the election is performed via etcd.
+//
+//gotagsrewrite:gen
+type VIPKubernetesLeaseSpec struct {
+	Namespace           string `yaml:"namespace" protobuf:"1"`
+	ServiceAccountToken string `yaml:"serviceAccountToken,omitempty" protobuf:"2"`
+}
+
 // NewOperatorSpec initializes a OperatorSpec resource.
 func NewOperatorSpec(namespace resource.Namespace, id resource.ID) *OperatorSpec {
 	return typed.NewResource[OperatorSpecSpec, OperatorSpecRD](
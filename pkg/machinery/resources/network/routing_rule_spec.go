@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// RoutingRuleSpecType is type of RoutingRuleSpec resource.
+const RoutingRuleSpecType = resource.Type("RoutingRuleSpecs.net.talos.dev")
+
+// RoutingRuleSpec resource holds routing rule (policy routing) specification to be applied to the kernel.
+type RoutingRuleSpec = typed.Resource[RoutingRuleSpecSpec, RoutingRuleSpecRD]
+
+// RoutingRuleSpecSpec describes the routing rule.
+//
+//gotagsrewrite:gen
+type RoutingRuleSpecSpec struct {
+	Family      nethelpers.Family        `yaml:"family" protobuf:"1"`
+	Source      netip.Prefix             `yaml:"src,omitempty" protobuf:"2"`
+	Destination netip.Prefix             `yaml:"dst,omitempty" protobuf:"3"`
+	IIFName     string                   `yaml:"iifName,omitempty" protobuf:"4"`
+	FwMark      uint32                   `yaml:"fwMark,omitempty" protobuf:"5"`
+	FwMask      uint32                   `yaml:"fwMask,omitempty" protobuf:"6"`
+	Table       nethelpers.RoutingTable  `yaml:"table" protobuf:"7"`
+	Priority    uint32                   `yaml:"priority" protobuf:"8"`
+	Protocol    nethelpers.RouteProtocol `yaml:"protocol" protobuf:"9"`
+	ConfigLayer ConfigLayer              `yaml:"layer" protobuf:"10"`
+}
+
+// NewRoutingRuleSpec initializes a RoutingRuleSpec resource.
+func NewRoutingRuleSpec(namespace resource.Namespace, id resource.ID) *RoutingRuleSpec {
+	return typed.NewResource[RoutingRuleSpecSpec, RoutingRuleSpecRD](
+		resource.NewMetadata(namespace, RoutingRuleSpecType, id, resource.VersionUndefined),
+		RoutingRuleSpecSpec{},
+	)
+}
+
+// RoutingRuleSpecRD provides auxiliary methods for RoutingRuleSpec.
+type RoutingRuleSpecRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (RoutingRuleSpecRD) ResourceDefinition(resource.Metadata, RoutingRuleSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RoutingRuleSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RoutingRuleSpecSpec](RoutingRuleSpecType, &RoutingRuleSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// RoutingRuleStatusType is type of RoutingRuleStatus resource.
+const RoutingRuleStatusType = resource.Type("RoutingRuleStatuses.net.talos.dev")
+
+// RoutingRuleStatus resource holds the routing rule status as observed in the kernel.
+type RoutingRuleStatus = typed.Resource[RoutingRuleStatusSpec, RoutingRuleStatusRD]
+
+// RoutingRuleStatusSpec describes the routing rule status.
+//
+//gotagsrewrite:gen
+type RoutingRuleStatusSpec struct {
+	Family      nethelpers.Family        `yaml:"family" protobuf:"1"`
+	Source      netip.Prefix             `yaml:"src,omitempty" protobuf:"2"`
+	Destination netip.Prefix             `yaml:"dst,omitempty" protobuf:"3"`
+	IIFName     string                   `yaml:"iifName,omitempty" protobuf:"4"`
+	FwMark      uint32                   `yaml:"fwMark,omitempty" protobuf:"5"`
+	FwMask      uint32                   `yaml:"fwMask,omitempty" protobuf:"6"`
+	Table       nethelpers.RoutingTable  `yaml:"table" protobuf:"7"`
+	Priority    uint32                   `yaml:"priority" protobuf:"8"`
+	Protocol    nethelpers.RouteProtocol `yaml:"protocol" protobuf:"9"`
+}
+
+// NewRoutingRuleStatus initializes a RoutingRuleStatus resource.
+func NewRoutingRuleStatus(namespace resource.Namespace, id resource.ID) *RoutingRuleStatus {
+	return typed.NewResource[RoutingRuleStatusSpec, RoutingRuleStatusRD](
+		resource.NewMetadata(namespace, RoutingRuleStatusType, id, resource.VersionUndefined),
+		RoutingRuleStatusSpec{},
+	)
+}
+
+// RoutingRuleStatusRD provides auxiliary methods for RoutingRuleStatus.
+type RoutingRuleStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (RoutingRuleStatusRD) ResourceDefinition(resource.Metadata, RoutingRuleStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RoutingRuleStatusType,
+		Aliases:          []resource.Type{"routingrule", "routingrules"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Priority",
+				JSONPath: `{.priority}`,
+			},
+			{
+				Name:     "Source",
+				JSONPath: `{.src}`,
+			},
+			{
+				Name:     "Destination",
+				JSONPath: `{.dst}`,
+			},
+			{
+				Name:     "Table",
+				JSONPath: `{.table}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RoutingRuleStatusSpec](RoutingRuleStatusType, &RoutingRuleStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
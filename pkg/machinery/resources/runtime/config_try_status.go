@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// ConfigTryStatusType is type of ConfigTryStatus resource.
+const ConfigTryStatusType = resource.Type("ConfigTryStatuses.runtime.talos.dev")
+
+// ConfigTryStatusID is singleton ConfigTryStatus resource ID.
+const ConfigTryStatusID = resource.ID("config-try")
+
+// ConfigTryStatus resource holds the state of the last configuration applied in 'try' mode.
+type ConfigTryStatus = typed.Resource[ConfigTryStatusSpec, ConfigTryStatusRD]
+
+// ConfigTryStatusSpec describes the state of the configuration applied in 'try' mode.
+//
+//gotagsrewrite:gen
+type ConfigTryStatusSpec struct {
+	State ConfigTryState `yaml:"state" protobuf:"1"`
+	// Configuration is rolled back if it's not confirmed by the deadline.
+	Deadline time.Time `yaml:"deadline" protobuf:"2"`
+	// ConfirmationRequired is set if the configuration is persisted and kept only if confirmed.
+	ConfirmationRequired bool   `yaml:"confirmationRequired" protobuf:"3"`
+	Message              string `yaml:"message,omitempty" protobuf:"4"`
+}
+
+// NewConfigTryStatus initializes a ConfigTryStatus resource.
+func NewConfigTryStatus() *ConfigTryStatus {
+	return typed.NewResource[ConfigTryStatusSpec, ConfigTryStatusRD](
+		resource.NewMetadata(NamespaceName, ConfigTryStatusType, ConfigTryStatusID, resource.VersionUndefined),
+		ConfigTryStatusSpec{},
+	)
+}
+
+// ConfigTryStatusRD is auxiliary resource data for ConfigTryStatus.
+type ConfigTryStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ConfigTryStatusRD) ResourceDefinition(resource.Metadata, ConfigTryStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigTryStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+			{
+				Name:     "Deadline",
+				JSONPath: `{.deadline}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ConfigTryStatusSpec](ConfigTryStatusType, &ConfigTryStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
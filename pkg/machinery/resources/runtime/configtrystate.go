@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//go:generate enumer -type=ConfigTryState -linecomment -text
+
+// ConfigTryState describes the state of the configuration applied in 'try' mode.
+type ConfigTryState int
+
+// Config try states.
+const (
+	ConfigTryStatePending    ConfigTryState = iota // pending
+	ConfigTryStateConfirmed                        // confirmed
+	ConfigTryStateRolledBack                       // rolled back
+	ConfigTryStateCancelled                        // cancelled
+)
@@ -2,10 +2,38 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MachineStatusSpec -type MountStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ConfigTryStatusSpec -type EncryptionKeySyncStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type LogDeliveryStatusSpec -type MachineStatusSpec -type MountStatusSpec -type SwapSpecSpec -type SwapStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
+// DeepCopy generates a deep copy of ConfigTryStatusSpec.
+func (o ConfigTryStatusSpec) DeepCopy() ConfigTryStatusSpec {
+	var cp ConfigTryStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of EncryptionKeySyncStatusSpec.
+func (o EncryptionKeySyncStatusSpec) DeepCopy() EncryptionKeySyncStatusSpec {
+	var cp EncryptionKeySyncStatusSpec = o
+	if o.Keyslots != nil {
+		cp.Keyslots = make([]int, len(o.Keyslots))
+		copy(cp.Keyslots, o.Keyslots)
+	}
+	if o.Added != nil {
+		cp.Added = make([]int, len(o.Added))
+		copy(cp.Added, o.Added)
+	}
+	if o.Updated != nil {
+		cp.Updated = make([]int, len(o.Updated))
+		copy(cp.Updated, o.Updated)
+	}
+	if o.Removed != nil {
+		cp.Removed = make([]int, len(o.Removed))
+		copy(cp.Removed, o.Removed)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of KernelModuleSpecSpec.
 func (o KernelModuleSpecSpec) DeepCopy() KernelModuleSpecSpec {
 	var cp KernelModuleSpecSpec = o
@@ -28,6 +56,12 @@ func (o KernelParamStatusSpec) DeepCopy() KernelParamStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LogDeliveryStatusSpec.
+func (o LogDeliveryStatusSpec) DeepCopy() LogDeliveryStatusSpec {
+	var cp LogDeliveryStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of MachineStatusSpec.
 func (o MachineStatusSpec) DeepCopy() MachineStatusSpec {
 	var cp MachineStatusSpec = o
@@ -47,3 +81,15 @@ func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of SwapSpecSpec.
+func (o SwapSpecSpec) DeepCopy() SwapSpecSpec {
+	var cp SwapSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of SwapStatusSpec.
+func (o SwapStatusSpec) DeepCopy() SwapStatusSpec {
+	var cp SwapStatusSpec = o
+	return cp
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// EncryptionKeySyncStatusType is type of EncryptionKeySyncStatus resource.
+const EncryptionKeySyncStatusType = resource.Type("EncryptionKeySyncStatuses.runtime.talos.dev")
+
+// EncryptionKeySyncStatus resource holds the status of the encryption keys sync for the system partition.
+//
+// Resource ID is the partition label.
+type EncryptionKeySyncStatus = typed.Resource[EncryptionKeySyncStatusSpec, EncryptionKeySyncStatusRD]
+
+// Encryption key sync phases.
+const (
+	EncryptionKeySyncPhaseSyncing = "syncing"
+	EncryptionKeySyncPhaseSynced  = "synced"
+	EncryptionKeySyncPhaseFailed  = "failed"
+)
+
+// EncryptionKeySyncStatusSpec describes the status of the encryption keys sync.
+//
+//gotagsrewrite:gen
+type EncryptionKeySyncStatusSpec struct {
+	Phase         string `yaml:"phase" protobuf:"1"`
+	ConfigVersion string `yaml:"configVersion" protobuf:"2"`
+	Keyslots      []int  `yaml:"keyslots" protobuf:"3"`
+	Added         []int  `yaml:"added" protobuf:"4"`
+	Updated       []int  `yaml:"updated" protobuf:"5"`
+	Removed       []int  `yaml:"removed" protobuf:"6"`
+	Error         string `yaml:"error,omitempty" protobuf:"7"`
+}
+
+// NewEncryptionKeySyncStatus initializes a EncryptionKeySyncStatus resource.
+func NewEncryptionKeySyncStatus(namespace resource.Namespace, id resource.ID) *EncryptionKeySyncStatus {
+	return typed.NewResource[EncryptionKeySyncStatusSpec, EncryptionKeySyncStatusRD](
+		resource.NewMetadata(namespace, EncryptionKeySyncStatusType, id, resource.VersionUndefined),
+		EncryptionKeySyncStatusSpec{},
+	)
+}
+
+// EncryptionKeySyncStatusRD is auxiliary resource data for EncryptionKeySyncStatus.
+type EncryptionKeySyncStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (EncryptionKeySyncStatusRD) ResourceDefinition(resource.Metadata, EncryptionKeySyncStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EncryptionKeySyncStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "Keyslots",
+				JSONPath: `{.keyslots}`,
+			},
+			{
+				Name:     "Error",
+				JSONPath: `{.error}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EncryptionKeySyncStatusSpec](EncryptionKeySyncStatusType, &EncryptionKeySyncStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
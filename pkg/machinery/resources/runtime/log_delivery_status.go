@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// LogDeliveryStatusType is type of LogDeliveryStatus resource.
+const LogDeliveryStatusType = resource.Type("LogDeliveryStatuses.runtime.talos.dev")
+
+// LogDeliveryStatus resource holds delivery counters for a remote logging destination.
+type LogDeliveryStatus = typed.Resource[LogDeliveryStatusSpec, LogDeliveryStatusRD]
+
+// LogDeliveryStatusSpec describes delivery status of a remote logging destination.
+//
+//gotagsrewrite:gen
+type LogDeliveryStatusSpec struct {
+	Endpoint  string `yaml:"endpoint" protobuf:"1"`
+	Format    string `yaml:"format" protobuf:"2"`
+	Sent      uint64 `yaml:"sent" protobuf:"3"`
+	Dropped   uint64 `yaml:"dropped" protobuf:"4"`
+	Spooled   uint64 `yaml:"spooled" protobuf:"5"`
+	SpoolSize uint64 `yaml:"spoolSize" protobuf:"6"`
+}
+
+// NewLogDeliveryStatus initializes a LogDeliveryStatus resource.
+func NewLogDeliveryStatus(namespace resource.Namespace, id resource.ID) *LogDeliveryStatus {
+	return typed.NewResource[LogDeliveryStatusSpec, LogDeliveryStatusRD](
+		resource.NewMetadata(namespace, LogDeliveryStatusType, id, resource.VersionUndefined),
+		LogDeliveryStatusSpec{},
+	)
+}
+
+// LogDeliveryStatusRD is auxiliary resource data for LogDeliveryStatus.
+type LogDeliveryStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (LogDeliveryStatusRD) ResourceDefinition(resource.Metadata, LogDeliveryStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LogDeliveryStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Endpoint",
+				JSONPath: `{.endpoint}`,
+			},
+			{
+				Name:     "Format",
+				JSONPath: `{.format}`,
+			},
+			{
+				Name:     "Sent",
+				JSONPath: `{.sent}`,
+			},
+			{
+				Name:     "Dropped",
+				JSONPath: `{.dropped}`,
+			},
+			{
+				Name:     "Spooled",
+				JSONPath: `{.spooled}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LogDeliveryStatusSpec](LogDeliveryStatusType, &LogDeliveryStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
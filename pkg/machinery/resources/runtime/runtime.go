@@ -5,4 +5,4 @@
 package runtime
 
 //nolint:lll
-//go:generate deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MachineStatusSpec -type MountStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type ConfigTryStatusSpec -type EncryptionKeySyncStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type LogDeliveryStatusSpec -type MachineStatusSpec -type MountStatusSpec -type SwapSpecSpec -type SwapStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -25,12 +25,17 @@ func TestRegisterResource(t *testing.T) {
 	resourceRegistry := registry.NewResourceRegistry(resources)
 
 	for _, resource := range []resource.Resource{
+		&runtime.ConfigTryStatus{},
+		&runtime.EncryptionKeySyncStatus{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamStatus{},
+		&runtime.LogDeliveryStatus{},
 		&runtime.MachineStatus{},
 		&runtime.MountStatus{},
+		&runtime.SwapSpec{},
+		&runtime.SwapStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
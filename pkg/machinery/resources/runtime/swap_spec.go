@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// SwapSpecType is type of SwapSpec resource.
+const SwapSpecType = resource.Type("SwapSpecs.runtime.talos.dev")
+
+// SwapSpec resource holds information about swap area to enable.
+//
+// Resource ID is the name of the zram device (e.g. zram0) or the path to the swap block device.
+type SwapSpec = typed.Resource[SwapSpecSpec, SwapSpecRD]
+
+// Swap area types.
+const (
+	SwapTypeZram   = "zram"
+	SwapTypeDevice = "device"
+)
+
+// SwapSpecSpec describes swap area to enable.
+//
+//gotagsrewrite:gen
+type SwapSpecSpec struct {
+	Type   string `yaml:"type" protobuf:"1"`
+	Device string `yaml:"device" protobuf:"2"`
+	// Priority of the swap area, negative value means kernel default.
+	Priority      int    `yaml:"priority" protobuf:"3"`
+	ZramSizeBytes uint64 `yaml:"zramSizeBytes,omitempty" protobuf:"4"`
+	ZramAlgorithm string `yaml:"zramAlgorithm,omitempty" protobuf:"5"`
+}
+
+// NewSwapSpec initializes a SwapSpec resource.
+func NewSwapSpec(namespace resource.Namespace, id resource.ID) *SwapSpec {
+	return typed.NewResource[SwapSpecSpec, SwapSpecRD](
+		resource.NewMetadata(namespace, SwapSpecType, id, resource.VersionUndefined),
+		SwapSpecSpec{},
+	)
+}
+
+// SwapSpecRD is auxiliary resource data for SwapSpec.
+type SwapSpecRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SwapSpecRD) ResourceDefinition(resource.Metadata, SwapSpecSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SwapSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Device",
+				JSONPath: `{.device}`,
+			},
+			{
+				Name:     "Priority",
+				JSONPath: `{.priority}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SwapSpecSpec](SwapSpecType, &SwapSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
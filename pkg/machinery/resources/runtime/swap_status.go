@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+)
+
+// SwapStatusType is type of SwapStatus resource.
+const SwapStatusType = resource.Type("SwapStatuses.runtime.talos.dev")
+
+// SwapStatus resource holds the status of the active swap area.
+//
+// Resource ID is the swap area path as reported by the kernel.
+type SwapStatus = typed.Resource[SwapStatusSpec, SwapStatusRD]
+
+// SwapStatusSpec describes the status of the active swap area.
+//
+//gotagsrewrite:gen
+type SwapStatusSpec struct {
+	Device    string `yaml:"device" protobuf:"1"`
+	Type      string `yaml:"type" protobuf:"2"`
+	SizeBytes uint64 `yaml:"sizeBytes" protobuf:"3"`
+	UsedBytes uint64 `yaml:"usedBytes" protobuf:"4"`
+	Priority  int    `yaml:"priority" protobuf:"5"`
+}
+
+// NewSwapStatus initializes a SwapStatus resource.
+func NewSwapStatus(namespace resource.Namespace, id resource.ID) *SwapStatus {
+	return typed.NewResource[SwapStatusSpec, SwapStatusRD](
+		resource.NewMetadata(namespace, SwapStatusType, id, resource.VersionUndefined),
+		SwapStatusSpec{},
+	)
+}
+
+// SwapStatusRD is auxiliary resource data for SwapStatus.
+type SwapStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SwapStatusRD) ResourceDefinition(resource.Metadata, SwapStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SwapStatusType,
+		Aliases:          []resource.Type{"swaps"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.sizeBytes}`,
+			},
+			{
+				Name:     "Used",
+				JSONPath: `{.usedBytes}`,
+			},
+			{
+				Name:     "Priority",
+				JSONPath: `{.priority}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SwapStatusSpec](SwapStatusType, &SwapStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -38,6 +38,12 @@ type StatusSpec struct {
 
 	// SyncDisabled indicates if time sync is disabled.
 	SyncDisabled bool `yaml:"syncDisabled" protobuf:"3"`
+
+	// SyncServer is the time server used for the last successful sync.
+	SyncServer string `yaml:"syncServer,omitempty" protobuf:"4"`
+
+	// Authenticated indicates whether the last sync was authenticated with NTS.
+	Authenticated bool `yaml:"authenticated" protobuf:"5"`
 }
 
 // NewStatus initializes a TimeSync resource.
@@ -62,6 +68,14 @@ func (r StatusRD) ResourceDefinition(resource.Metadata, StatusSpec) meta.Resourc
 				Name:     "Synced",
 				JSONPath: "{.synced}",
 			},
+			{
+				Name:     "Server",
+				JSONPath: "{.syncServer}",
+			},
+			{
+				Name:     "Authenticated",
+				JSONPath: "{.authenticated}",
+			},
 		},
 	}
 }
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// FilesystemTypeSwap is the filesystem type for swap.
+	FilesystemTypeSwap = "swap"
+
+	// SwapSignature is the magic at the end of the first page of the swap device.
+	SwapSignature = "SWAPSPACE2"
+
+	swapVersion        = 1
+	swapHeaderOffset   = 1024
+	swapLabelLength    = 16
+	swapMinPages       = 10
+	swapSignatureRange = 10
+)
+
+// Swap creates a swap area on the specified partition (equivalent of mkswap).
+func Swap(partname string, setters ...Option) error {
+	if partname == "" {
+		return fmt.Errorf("missing path to disk")
+	}
+
+	opts := NewDefaultOptions(setters...)
+
+	if len(opts.Label) > swapLabelLength {
+		return fmt.Errorf("swap label %q is too long", opts.Label)
+	}
+
+	f, err := os.OpenFile(partname, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	pageSize := os.Getpagesize()
+	pages := size / int64(pageSize)
+
+	if pages < swapMinPages {
+		return fmt.Errorf("swap area %q is too small: %d bytes", partname, size)
+	}
+
+	if !opts.Force {
+		formatted, err := isSwap(f, pageSize)
+		if err != nil {
+			return err
+		}
+
+		if formatted {
+			return fmt.Errorf("%q is already formatted as swap", partname)
+		}
+	}
+
+	// first page: boot bits (zeroes), header, signature
+	page := make([]byte, pageSize)
+
+	header := page[swapHeaderOffset:]
+	binary.LittleEndian.PutUint32(header[0:], swapVersion)
+	binary.LittleEndian.PutUint32(header[4:], uint32(pages-1)) // last page
+	binary.LittleEndian.PutUint32(header[8:], 0)               // number of bad pages
+
+	// UUID v4
+	uuid := header[12:28]
+	if _, err = io.ReadFull(rand.Reader, uuid); err != nil {
+		return err
+	}
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	copy(header[28:28+swapLabelLength], opts.Label)
+
+	copy(page[pageSize-swapSignatureRange:], SwapSignature)
+
+	if _, err = f.WriteAt(page, 0); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// IsSwap checks whether the partition is formatted as swap.
+func IsSwap(partname string) (bool, error) {
+	f, err := os.Open(partname)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return isSwap(f, os.Getpagesize())
+}
+
+func isSwap(r io.ReaderAt, pageSize int) (bool, error) {
+	signature := make([]byte, swapSignatureRange)
+
+	if _, err := r.ReadAt(signature, int64(pageSize-swapSignatureRange)); err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return bytes.Equal(signature, []byte(SwapSignature)), nil
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs_test
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/makefs"
+)
+
+func TestSwap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swap")
+	pageSize := os.Getpagesize()
+
+	require.NoError(t, os.WriteFile(path, make([]byte, 64*pageSize), 0o600))
+
+	formatted, err := makefs.IsSwap(path)
+	require.NoError(t, err)
+	assert.False(t, formatted)
+
+	require.NoError(t, makefs.Swap(path, makefs.WithLabel("swap0")))
+
+	formatted, err = makefs.IsSwap(path)
+	require.NoError(t, err)
+	assert.True(t, formatted)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1, binary.LittleEndian.Uint32(contents[1024:]))
+	assert.EqualValues(t, 63, binary.LittleEndian.Uint32(contents[1028:]))
+	assert.Equal(t, "swap0", string(contents[1024+28:1024+33]))
+	assert.Equal(t, makefs.SwapSignature, string(contents[pageSize-10:pageSize]))
+
+	// without force, existing swap is not overwritten
+	require.Error(t, makefs.Swap(path))
+	require.NoError(t, makefs.Swap(path, makefs.WithForce(true)))
+}
+
+func TestSwapTooSmall(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "swap")
+
+	require.NoError(t, os.WriteFile(path, make([]byte, os.Getpagesize()), 0o600))
+
+	require.Error(t, makefs.Swap(path))
+}